@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authtoken
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+)
+
+func TestPresign(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	credentialsProvider := credentials.NewStaticCredentialsProvider("AKID", "SECRET", "SESSION")
+	signingTime := time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := map[string]struct {
+		credentialsProvider aws.CredentialsProvider
+		request             Request
+		expectedPrefix      string
+		expectedQuery       map[string]string
+		expectError         bool
+	}{
+		"rds": {
+			credentialsProvider: credentialsProvider,
+			request: Request{
+				Scheme:    "https",
+				Host:      "db.example.us-west-2.rds.amazonaws.com:5432",
+				Query:     url.Values{"Action": {"connect"}, "DBUser": {"app"}},
+				Service:   "rds-db",
+				Region:    "us-west-2",
+				ExpiresIn: 15 * time.Minute,
+			},
+			expectedPrefix: "db.example.us-west-2.rds.amazonaws.com:5432/?",
+			expectedQuery: map[string]string{
+				"Action":               "connect",
+				"DBUser":               "app",
+				"X-Amz-Algorithm":      "AWS4-HMAC-SHA256",
+				"X-Amz-Credential":     "AKID/20250102/us-west-2/rds-db/aws4_request",
+				"X-Amz-Date":           "20250102T030405Z",
+				"X-Amz-Expires":        "900",
+				"X-Amz-Security-Token": "SESSION",
+				"X-Amz-SignedHeaders":  "host",
+			},
+		},
+		"elasticache": {
+			credentialsProvider: credentialsProvider,
+			request: Request{
+				Scheme:    "http",
+				Host:      "my-cache",
+				Query:     url.Values{"Action": {"connect"}, "User": {"app"}},
+				Service:   "elasticache",
+				Region:    "eu-west-1",
+				ExpiresIn: 15 * time.Minute,
+			},
+			expectedPrefix: "my-cache/?",
+			expectedQuery: map[string]string{
+				"Action":           "connect",
+				"User":             "app",
+				"X-Amz-Credential": "AKID/20250102/eu-west-1/elasticache/aws4_request",
+			},
+		},
+		"no credentials": {
+			request: Request{
+				Scheme:  "https",
+				Host:    "example.com",
+				Service: "dsql",
+				Region:  "us-east-1",
+			},
+			expectError: true,
+		},
+		"no host": {
+			credentialsProvider: credentialsProvider,
+			request: Request{
+				Scheme:  "https",
+				Service: "dsql",
+				Region:  "us-east-1",
+			},
+			expectError: true,
+		},
+		"no region": {
+			credentialsProvider: credentialsProvider,
+			request: Request{
+				Scheme:  "https",
+				Host:    "example.com",
+				Service: "dsql",
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := presign(ctx, testCase.credentialsProvider, testCase.request, signingTime)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("presign() err = %v, expectError = %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := token.Expiration, signingTime.Add(testCase.request.ExpiresIn); !got.Equal(want) {
+				t.Errorf("Expiration = %s, want %s", got, want)
+			}
+
+			if !strings.HasPrefix(token.Value, testCase.expectedPrefix) {
+				t.Fatalf("Value = %q, want prefix %q", token.Value, testCase.expectedPrefix)
+			}
+
+			query, err := url.ParseQuery(strings.TrimPrefix(token.Value, testCase.expectedPrefix))
+			if err != nil {
+				t.Fatalf("parsing query: %s", err)
+			}
+
+			for k, want := range testCase.expectedQuery {
+				if got := query.Get(k); got != want {
+					t.Errorf("query %s = %q, want %q", k, got, want)
+				}
+			}
+
+			if query.Get("X-Amz-Signature") == "" {
+				t.Error("missing X-Amz-Signature")
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package conns
 
 import (
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
 
@@ -27,3 +28,32 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// newRetryer returns a Retryer factory for the specified retry mode and maximum number of attempts.
+// The Retryers are configured in the same way as those configured by aws-sdk-go-base for the provider.
+func newRetryer(mode aws.RetryMode, maxAttempts, tokenBucketRateLimiterCapacity int) func() aws.Retryer {
+	standardOptions := func(o *retry.StandardOptions) {
+		o.Backoff = &v1CompatibleBackoff{maxRetryDelay: maxBackoff}
+		if maxAttempts > 0 {
+			o.MaxAttempts = maxAttempts
+		}
+		o.MaxBackoff = maxBackoff
+		if tokenBucketRateLimiterCapacity > 0 {
+			o.RateLimiter = ratelimit.NewTokenRateLimit(uint(tokenBucketRateLimiterCapacity))
+		} else {
+			o.RateLimiter = ratelimit.None
+		}
+	}
+
+	return func() aws.Retryer {
+		// Ensure that each invocation of this function returns an independent Retryer.
+		switch mode {
+		case aws.RetryModeAdaptive:
+			return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+				o.StandardOptions = append(o.StandardOptions, standardOptions)
+			})
+		default:
+			return retry.NewStandard(standardOptions)
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -24,25 +25,37 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/sync/singleflight"
 )
 
 type AWSClient struct {
-	accountID                 string
-	awsConfig                 *aws.Config
-	clients                   map[string]map[string]any // Region -> service package name -> API client.
-	defaultTagsConfig         *tftags.DefaultConfig
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	ignoreTagsConfig          *tftags.IgnoreConfig
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	partition                 endpoints.Partition
-	servicePackages           map[string]ServicePackage
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
-	terraformVersion          string // From provider configuration.
+	accountID                      string
+	awsConfig                      *aws.Config
+	clients                        map[string]map[string]any // Region -> service package name (and any retry overrides) -> API client.
+	defaultTagsConfig              *tftags.DefaultConfig
+	endpoints                      map[string]string // From provider configuration.
+	httpClient                     *http.Client
+	iamPreflight                   bool            // From provider configuration.
+	iamPreflightDecisions          map[string]bool // Lower-cased IAM action -> whether or not the action is allowed.
+	iamPreflightGroup              singleflight.Group
+	iamPreflightLock               sync.Mutex
+	iamPreflightPrincipalARN       string
+	iamPreflightUnmapped           map[string]struct{} // Resource types without declared IAM actions that have been reported.
+	iamPreflightWarnUnchecked      bool                // From provider configuration.
+	ignoreTagsConfig               *tftags.IgnoreConfig
+	lock                           sync.Mutex
+	logger                         baselogging.Logger
+	maxRetries                     int // From provider configuration.
+	partition                      endpoints.Partition
+	readOnly                       bool                    // From provider configuration.
+	resourceDefaultsConfig         *ResourceDefaultsConfig // From provider configuration.
+	servicePackages                map[string]ServicePackage
+	s3ExpressClient                *s3.Client
+	s3UsePathStyle                 bool   // From provider configuration.
+	s3USEast1RegionalEndpoint      string // From provider configuration.
+	stsRegion                      string // From provider configuration.
+	terraformVersion               string // From provider configuration.
+	tokenBucketRateLimiterCapacity int    // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -191,6 +204,11 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	return c.s3ExpressClient
 }
 
+// ResourceDefaults returns the provider-level defaults for the specified service package name and Terraform type name.
+func (c *AWSClient) ResourceDefaults(_ context.Context, servicePackageName, typeName string) ResourceDefaults {
+	return c.resourceDefaultsConfig.Get(servicePackageName, typeName)
+}
+
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
 func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
@@ -338,6 +356,16 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
 	}
+	if retryDefaults := c.apiClientRetryDefaults(ctx, servicePackageName); c.readOnly || retryDefaults.hasRetryOverrides() {
+		cfg := c.awsConfig.Copy()
+		if c.readOnly {
+			cfg.APIOptions = append(cfg.APIOptions, addReadOnlyMiddleware(servicePackageName))
+		}
+		if retryDefaults.hasRetryOverrides() {
+			cfg.Retryer = c.retryerWithOverrides(cfg.Retryer, retryDefaults)
+		}
+		m["aws_sdkv2_config"] = &cfg
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -354,6 +382,38 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// apiClientRetryDefaults returns any provider-level retry defaults for the specified service's API client.
+// Service defaults apply to all callers. Resource type defaults apply only when called by the resource in Context.
+func (c *AWSClient) apiClientRetryDefaults(ctx context.Context, servicePackageName string) ResourceDefaults {
+	var typeName string
+	if inContext, ok := FromContext(ctx); ok {
+		typeName = inContext.TypeName()
+	}
+
+	v := c.ResourceDefaults(ctx, servicePackageName, typeName)
+
+	return ResourceDefaults{
+		MaxRetries: v.MaxRetries,
+		RetryMode:  v.RetryMode,
+	}
+}
+
+// retryerWithOverrides returns a Retryer factory which applies any retry overrides.
+func (c *AWSClient) retryerWithOverrides(retryer func() aws.Retryer, defaults ResourceDefaults) func() aws.Retryer {
+	if defaults.RetryMode == "" {
+		return func() aws.Retryer {
+			return retry.AddWithMaxAttempts(retryer(), defaults.MaxRetries)
+		}
+	}
+
+	maxAttempts := c.maxRetries
+	if defaults.MaxRetries > 0 {
+		maxAttempts = defaults.MaxRetries
+	}
+
+	return newRetryer(defaults.RetryMode, maxAttempts, c.tokenBucketRateLimiterCapacity)
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
 // The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
@@ -361,6 +421,12 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 	region := c.Region(ctx)
 
+	// API clients with retry overrides are cached separately.
+	key := servicePackageName
+	if v := c.apiClientRetryDefaults(ctx, servicePackageName); v.hasRetryOverrides() {
+		key = fmt.Sprintf("%s/%d/%s", servicePackageName, v.MaxRetries, v.RetryMode)
+	}
+
 	isDefault := len(extra) == 0
 	// Default service client is cached.
 	if isDefault {
@@ -368,7 +434,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
 		if v, ok := c.clients[region]; ok {
-			if raw, ok := v[servicePackageName]; ok {
+			if raw, ok := v[key]; ok {
 				if client, ok := raw.(T); ok {
 					return client, nil
 				} else {
@@ -407,7 +473,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		if _, ok := c.clients[region]; !ok {
 			c.clients[region] = make(map[string]any, 0)
 		}
-		c.clients[region][servicePackageName] = client
+		c.clients[region][key] = client
 	}
 
 	return client, nil
@@ -25,6 +25,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
+const (
+	maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
+)
+
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
@@ -39,12 +43,16 @@ type Config struct {
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
+	IAMPreflight                   bool
+	IAMPreflightWarnUnchecked      bool
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	ReadOnly                       bool
 	Region                         string
+	ResourceDefaultsConfig         *ResourceDefaultsConfig
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
@@ -69,9 +77,6 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
-	const (
-		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
-	)
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -200,10 +205,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.awsConfig = &cfg
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.iamPreflight = c.IAMPreflight
+	client.iamPreflightWarnUnchecked = c.IAMPreflightWarnUnchecked
 	client.logger = logger
+	client.maxRetries = c.MaxRetries
+	client.readOnly = c.ReadOnly
+	client.resourceDefaultsConfig = c.ResourceDefaultsConfig
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	client.tokenBucketRateLimiterCapacity = c.TokenBucketRateLimiterCapacity
 
 	return client, diags
 }
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// IAMPreflight returns whether or not plan-time IAM permission preflight checks are enabled.
+func (c *AWSClient) IAMPreflight(context.Context) bool {
+	return c.iamPreflight
+}
+
+// ReportIAMPreflightUnmapped records that planned changes to the specified resource type, which does not declare
+// the IAM actions used by its operations, can't be checked.
+// It returns true only if reporting unchecked resource types is enabled and the first time a resource type is recorded,
+// so that it is reported once per provider run.
+func (c *AWSClient) ReportIAMPreflightUnmapped(_ context.Context, typeName string) bool {
+	if !c.iamPreflightWarnUnchecked {
+		return false
+	}
+
+	c.iamPreflightLock.Lock()
+	defer c.iamPreflightLock.Unlock()
+
+	if c.iamPreflightUnmapped == nil {
+		c.iamPreflightUnmapped = make(map[string]struct{})
+	}
+
+	if _, ok := c.iamPreflightUnmapped[typeName]; ok {
+		return false
+	}
+
+	c.iamPreflightUnmapped[typeName] = struct{}{}
+
+	return true
+}
+
+// DeniedIAMActions returns those of the specified IAM actions that the caller is not allowed to perform,
+// as evaluated by the IAM policy simulator for the caller's principal against all resources.
+// Evaluation results are cached for the lifetime of the client.
+// IAM API calls are made without holding the client's lock and concurrent evaluations of the same actions are shared.
+func (c *AWSClient) DeniedIAMActions(ctx context.Context, actions []string) ([]string, error) {
+	if unevaluated := c.unevaluatedIAMActions(actions); len(unevaluated) > 0 {
+		key := "actions:" + strings.ToLower(strings.Join(unevaluated, ","))
+		_, err, _ := c.iamPreflightGroup.Do(key, func() (any, error) {
+			decisions, err := c.simulateIAMActions(ctx, unevaluated)
+			if err != nil {
+				return nil, err
+			}
+
+			c.iamPreflightLock.Lock()
+			defer c.iamPreflightLock.Unlock()
+
+			if c.iamPreflightDecisions == nil {
+				c.iamPreflightDecisions = make(map[string]bool)
+			}
+			maps.Copy(c.iamPreflightDecisions, decisions)
+
+			return nil, nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.iamPreflightLock.Lock()
+	defer c.iamPreflightLock.Unlock()
+
+	var denied []string
+	for _, action := range actions {
+		if !c.iamPreflightDecisions[strings.ToLower(action)] {
+			denied = append(denied, action)
+		}
+	}
+
+	return denied, nil
+}
+
+// unevaluatedIAMActions returns those of the specified IAM actions that have no cached evaluation result.
+func (c *AWSClient) unevaluatedIAMActions(actions []string) []string {
+	c.iamPreflightLock.Lock()
+	defer c.iamPreflightLock.Unlock()
+
+	var unevaluated []string
+	for _, action := range actions {
+		if _, ok := c.iamPreflightDecisions[strings.ToLower(action)]; !ok {
+			unevaluated = append(unevaluated, action)
+		}
+	}
+
+	return unevaluated
+}
+
+// simulateIAMActions evaluates the specified IAM actions using the IAM policy simulator.
+// The returned map is keyed by lower-cased IAM action.
+func (c *AWSClient) simulateIAMActions(ctx context.Context, actions []string) (map[string]bool, error) {
+	principalARN, err := c.iamPreflightPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make(map[string]bool)
+	input := iam.SimulatePrincipalPolicyInput{
+		ActionNames:     actions,
+		PolicySourceArn: aws.String(principalARN),
+	}
+	pages := iam.NewSimulatePrincipalPolicyPaginator(c.IAMClient(ctx), &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("simulating IAM principal policy (%s): %w", principalARN, err)
+		}
+
+		for _, v := range page.EvaluationResults {
+			decisions[strings.ToLower(aws.ToString(v.EvalActionName))] = v.EvalDecision == awstypes.PolicyEvaluationDecisionTypeAllowed
+		}
+	}
+
+	return decisions, nil
+}
+
+// iamPreflightPrincipal returns the ARN of the IAM principal whose policies are simulated.
+func (c *AWSClient) iamPreflightPrincipal(ctx context.Context) (string, error) {
+	c.iamPreflightLock.Lock()
+	principalARN := c.iamPreflightPrincipalARN
+	c.iamPreflightLock.Unlock()
+
+	if principalARN != "" {
+		return principalARN, nil
+	}
+
+	v, err, _ := c.iamPreflightGroup.Do("principal", func() (any, error) {
+		output, err := c.STSClient(ctx).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+
+		if err != nil {
+			return "", fmt.Errorf("reading STS Caller Identity: %w", err)
+		}
+
+		principalARN := aws.ToString(output.Arn)
+
+		// The IAM policy simulator does not accept STS assumed-role session ARNs, so simulate the assumed IAM role.
+		if roleName, ok := assumedRoleName(principalARN); ok {
+			output, err := c.IAMClient(ctx).GetRole(ctx, &iam.GetRoleInput{
+				RoleName: aws.String(roleName),
+			})
+
+			if err != nil {
+				return "", fmt.Errorf("reading IAM Role (%s): %w", roleName, err)
+			}
+
+			principalARN = aws.ToString(output.Role.Arn)
+		}
+
+		c.iamPreflightLock.Lock()
+		defer c.iamPreflightLock.Unlock()
+
+		c.iamPreflightPrincipalARN = principalARN
+
+		return principalARN, nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return v.(string), nil
+}
+
+// assumedRoleName returns the IAM role name from an STS assumed-role session ARN,
+// e.g. "arn:aws:sts::123456789012:assumed-role/example/session" -> "example".
+func assumedRoleName(v string) (string, bool) {
+	parsed, err := arn.Parse(v)
+	if err != nil || parsed.Service != "sts" {
+		return "", false
+	}
+
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) != 3 || parts[0] != "assumed-role" {
+		return "", false
+	}
+
+	return parts[1], true
+}
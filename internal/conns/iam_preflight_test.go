@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAssumedRoleName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn        string
+		expected   string
+		expectedOK bool
+	}{
+		"assumed role": {
+			arn:        "arn:aws:sts::123456789012:assumed-role/example/session", //lintignore:AWSAT003,AWSAT005
+			expected:   "example",
+			expectedOK: true,
+		},
+		"assumed role in other partition": {
+			arn:        "arn:aws-us-gov:sts::123456789012:assumed-role/example/session", //lintignore:AWSAT003,AWSAT005
+			expected:   "example",
+			expectedOK: true,
+		},
+		"IAM user": {
+			arn: "arn:aws:iam::123456789012:user/example", //lintignore:AWSAT003,AWSAT005
+		},
+		"IAM role": {
+			arn: "arn:aws:iam::123456789012:role/path/example", //lintignore:AWSAT003,AWSAT005
+		},
+		"federated user": {
+			arn: "arn:aws:sts::123456789012:federated-user/example", //lintignore:AWSAT003,AWSAT005
+		},
+		"not an ARN": {
+			arn: "example",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := assumedRoleName(testCase.arn)
+
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("role name = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestReportIAMPreflightUnmapped(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &AWSClient{
+		iamPreflightWarnUnchecked: true,
+	}
+
+	if !c.ReportIAMPreflightUnmapped(ctx, "aws_example_thing") {
+		t.Error("first report of aws_example_thing = false, want true")
+	}
+	if c.ReportIAMPreflightUnmapped(ctx, "aws_example_thing") {
+		t.Error("second report of aws_example_thing = true, want false")
+	}
+	if !c.ReportIAMPreflightUnmapped(ctx, "aws_example_other") {
+		t.Error("first report of aws_example_other = false, want true")
+	}
+}
+
+func TestReportIAMPreflightUnmapped_disabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &AWSClient{}
+
+	if c.ReportIAMPreflightUnmapped(ctx, "aws_example_thing") {
+		t.Error("report of aws_example_thing = true, want false")
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// readOnlyOperationPrefixes are the prefixes of API operation names which are considered read-only in all services.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+}
+
+// readOnlyOperations are the names of API operations, keyed by service package name,
+// which do not modify any AWS resources but are not in one of the read-only operation families.
+var readOnlyOperations = map[string][]string{
+	names.APIGatewayV2:      {"ExportApi"},
+	names.Connect:           {"SearchVocabularies"},
+	names.CustomerProfiles:  {"SearchProfiles"},
+	names.DynamoDB:          {"Query", "Scan"},
+	names.EC2:               {"SearchLocalGatewayRoutes", "SearchTransitGatewayMulticastGroups", "SearchTransitGatewayRoutes"},
+	names.IAM:               {"SimulateCustomPolicy", "SimulatePrincipalPolicy"},
+	names.KMS:               {"Decrypt", "Encrypt"},
+	names.Logs:              {"FilterLogEvents"},
+	names.ResourceExplorer2: {"Search"},
+	names.ServiceCatalog:    {"SearchProducts", "SearchProductsAsAdmin", "SearchProvisionedProducts"},
+}
+
+// isReadOnlyOperation returns whether or not the specified API operation is permitted in read-only mode.
+func isReadOnlyOperation(servicePackageName, operationName string) bool {
+	if slices.ContainsFunc(readOnlyOperationPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(operationName, prefix)
+	}) {
+		return true
+	}
+
+	return slices.Contains(readOnlyOperations[servicePackageName], operationName)
+}
+
+// ReadOnlyModeError is returned when a non-read API operation is attempted while the provider is in read-only mode.
+type ReadOnlyModeError struct {
+	OperationName string
+	ServiceID     string
+	TypeName      string
+}
+
+func (e *ReadOnlyModeError) Error() string {
+	caller := "the provider"
+	if e.TypeName != "" {
+		caller = e.TypeName
+	}
+
+	return fmt.Sprintf("provider is in read-only mode: %s attempted %s operation %s, which may modify AWS resources", caller, e.ServiceID, e.OperationName)
+}
+
+// addReadOnlyMiddleware returns an API option which rejects any non-read API operation.
+func addReadOnlyMiddleware(servicePackageName string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(readOnlyMiddleware(servicePackageName), middleware.After)
+	}
+}
+
+func readOnlyMiddleware(servicePackageName string) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc(
+		"TerraformReadOnlyMode",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			if operationName := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(servicePackageName, operationName) {
+				err := &ReadOnlyModeError{
+					OperationName: operationName,
+					ServiceID:     awsmiddleware.GetServiceID(ctx),
+				}
+				if inContext, ok := FromContext(ctx); ok {
+					err.TypeName = inContext.TypeName()
+				}
+
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+
+			return next.HandleInitialize(ctx, in)
+		},
+	)
+}
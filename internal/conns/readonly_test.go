@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		servicePackageName string
+		operationName      string
+		expected           bool
+	}{
+		"Describe": {
+			servicePackageName: names.EC2,
+			operationName:      "DescribeVpcs",
+			expected:           true,
+		},
+		"Get": {
+			servicePackageName: names.IAM,
+			operationName:      "GetRole",
+			expected:           true,
+		},
+		"List": {
+			servicePackageName: names.S3,
+			operationName:      "ListObjectsV2",
+			expected:           true,
+		},
+		"Head": {
+			servicePackageName: names.S3,
+			operationName:      "HeadBucket",
+			expected:           true,
+		},
+		"BatchGet": {
+			servicePackageName: names.CodeBuild,
+			operationName:      "BatchGetProjects",
+			expected:           true,
+		},
+		"Create": {
+			servicePackageName: names.EC2,
+			operationName:      "CreateVpc",
+			expected:           false,
+		},
+		"Put": {
+			servicePackageName: names.IAM,
+			operationName:      "PutRolePolicy",
+			expected:           false,
+		},
+		"allowlisted": {
+			servicePackageName: names.DynamoDB,
+			operationName:      "Query",
+			expected:           true,
+		},
+		"allowlisted in another service": {
+			servicePackageName: names.EC2,
+			operationName:      "Query",
+			expected:           false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isReadOnlyOperation(testCase.servicePackageName, testCase.operationName), testCase.expected; got != want {
+				t.Errorf("isReadOnlyOperation(%q, %q) = %t, want %t", testCase.servicePackageName, testCase.operationName, got, want)
+			}
+		})
+	}
+}
+
+func TestReadOnlyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx            context.Context
+		operationName  string
+		expectedCalled bool
+		expectedError  string
+	}{
+		"read": {
+			ctx:            context.Background(),
+			operationName:  "DescribeVpcs",
+			expectedCalled: true,
+		},
+		"write": {
+			ctx:           context.Background(),
+			operationName: "CreateVpc",
+			expectedError: "provider is in read-only mode: the provider attempted EC2 operation CreateVpc, which may modify AWS resources",
+		},
+		"write in resource": {
+			ctx:           NewResourceContext(context.Background(), names.EC2, "VPC", "aws_vpc", ""),
+			operationName: "CreateVpc",
+			expectedError: "provider is in read-only mode: aws_vpc attempted EC2 operation CreateVpc, which may modify AWS resources",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stack := middleware.NewStack(testCase.operationName, func() any { return nil })
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     "EC2",
+				OperationName: testCase.operationName,
+			}, middleware.Before); err != nil {
+				t.Fatalf("adding service metadata middleware: %s", err)
+			}
+			if err := addReadOnlyMiddleware(names.EC2)(stack); err != nil {
+				t.Fatalf("adding read-only middleware: %s", err)
+			}
+
+			var called bool
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				called = true
+				return nil, middleware.Metadata{}, nil
+			}), stack)
+
+			_, _, err := handler.Handle(testCase.ctx, nil)
+
+			if got, want := called, testCase.expectedCalled; got != want {
+				t.Errorf("called = %t, want %t", got, want)
+			}
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			var readOnlyModeErr *ReadOnlyModeError
+			if !errors.As(err, &readOnlyModeErr) {
+				t.Fatalf("expected *ReadOnlyModeError, got %T", err)
+			}
+			if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// ResourceDefaults are provider-level defaults for a resource type's operation timeouts and AWS API retry behavior.
+// Zero values are not set.
+type ResourceDefaults struct {
+	CreateTimeout time.Duration
+	ReadTimeout   time.Duration
+	UpdateTimeout time.Duration
+	DeleteTimeout time.Duration
+	MaxRetries    int
+	RetryMode     aws.RetryMode
+}
+
+// merge returns the defaults with any values set in other taking precedence.
+func (d ResourceDefaults) merge(other ResourceDefaults) ResourceDefaults {
+	if other.CreateTimeout > 0 {
+		d.CreateTimeout = other.CreateTimeout
+	}
+	if other.ReadTimeout > 0 {
+		d.ReadTimeout = other.ReadTimeout
+	}
+	if other.UpdateTimeout > 0 {
+		d.UpdateTimeout = other.UpdateTimeout
+	}
+	if other.DeleteTimeout > 0 {
+		d.DeleteTimeout = other.DeleteTimeout
+	}
+	if other.MaxRetries > 0 {
+		d.MaxRetries = other.MaxRetries
+	}
+	if other.RetryMode != "" {
+		d.RetryMode = other.RetryMode
+	}
+
+	return d
+}
+
+// hasRetryOverrides returns whether or not the defaults override the provider-level retry behavior.
+func (d ResourceDefaults) hasRetryOverrides() bool {
+	return d.MaxRetries > 0 || d.RetryMode != ""
+}
+
+// ResourceDefaultsConfig holds the provider-level resource defaults, from the `resource_defaults` provider configuration blocks.
+type ResourceDefaultsConfig struct {
+	ResourceTypes map[string]ResourceDefaults // Keyed by Terraform type name, e.g. "aws_db_instance".
+	Services      map[string]ResourceDefaults // Keyed by service package name, e.g. "rds".
+}
+
+// Get returns the defaults for the specified service package name and Terraform type name.
+// Any defaults for the resource type take precedence over those for the service.
+func (c *ResourceDefaultsConfig) Get(servicePackageName, typeName string) ResourceDefaults {
+	if c == nil {
+		return ResourceDefaults{}
+	}
+
+	return c.Services[servicePackageName].merge(c.ResourceTypes[typeName])
+}
+
+type resourceDefaultsContextKeyType int
+
+var resourceDefaultsContextKey resourceDefaultsContextKeyType
+
+// NewResourceDefaultsContext returns a Context enriched with the specified resource defaults.
+func NewResourceDefaultsContext(ctx context.Context, defaults ResourceDefaults) context.Context {
+	return context.WithValue(ctx, resourceDefaultsContextKey, defaults)
+}
+
+// ResourceDefaultsFromContext returns the resource defaults in Context.
+func ResourceDefaultsFromContext(ctx context.Context) (ResourceDefaults, bool) {
+	v, ok := ctx.Value(resourceDefaultsContextKey).(ResourceDefaults)
+	return v, ok
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceDefaultsConfigGet(t *testing.T) {
+	t.Parallel()
+
+	config := &ResourceDefaultsConfig{
+		ResourceTypes: map[string]ResourceDefaults{
+			"aws_db_instance": {
+				CreateTimeout: 2 * time.Hour,
+				MaxRetries:    10,
+			},
+		},
+		Services: map[string]ResourceDefaults{
+			names.RDS: {
+				CreateTimeout: time.Hour,
+				DeleteTimeout: time.Hour,
+				MaxRetries:    50,
+				RetryMode:     aws.RetryModeAdaptive,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		config             *ResourceDefaultsConfig
+		servicePackageName string
+		typeName           string
+		expected           ResourceDefaults
+	}{
+		"nil config": {
+			servicePackageName: names.RDS,
+			typeName:           "aws_db_instance",
+		},
+		"no defaults": {
+			config:             config,
+			servicePackageName: names.EC2,
+			typeName:           "aws_vpc",
+		},
+		"service": {
+			config:             config,
+			servicePackageName: names.RDS,
+			typeName:           "aws_db_cluster",
+			expected: ResourceDefaults{
+				CreateTimeout: time.Hour,
+				DeleteTimeout: time.Hour,
+				MaxRetries:    50,
+				RetryMode:     aws.RetryModeAdaptive,
+			},
+		},
+		"resource type overrides service": {
+			config:             config,
+			servicePackageName: names.RDS,
+			typeName:           "aws_db_instance",
+			expected: ResourceDefaults{
+				CreateTimeout: 2 * time.Hour,
+				DeleteTimeout: time.Hour,
+				MaxRetries:    10,
+				RetryMode:     aws.RetryModeAdaptive,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.expected, testCase.config.Get(testCase.servicePackageName, testCase.typeName)); diff != "" {
+				t.Errorf("unexpected resource defaults difference: %s", diff)
+			}
+		})
+	}
+}
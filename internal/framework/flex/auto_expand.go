@@ -37,6 +37,8 @@ type TypedExpander interface {
 // The resource's data structure is walked and exported fields that
 // have a corresponding field in the API data structure (and a suitable
 // target data type) are copied.
+// If no options are specified and the resource's data structure implements
+// GeneratedExpander, its generated expander is used instead.
 func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
 	expander := newAutoExpander(optFns)
@@ -46,6 +48,18 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 		logAttrKeyTargetType: fullTypeName(reflect.TypeOf(apiObject)),
 	})
 
+	// Generated expanders don't support options.
+	if v, ok := tfObject.(GeneratedExpander); ok && len(optFns) == 0 {
+		if ok, d := v.ExpandGenerated(ctx, apiObject); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.GeneratedExpander", map[string]any{
+				logAttrKeySourceType: fullTypeName(reflect.TypeOf(tfObject)),
+				logAttrKeyTargetType: fullTypeName(reflect.TypeOf(apiObject)),
+			})
+			diags.Append(d...)
+			return diags
+		}
+	}
+
 	diags.Append(autoExpandConvert(ctx, tfObject, apiObject, expander)...)
 
 	return diags
@@ -137,6 +151,12 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 		return diags
 	}
 
+	if fromUnion, ok := unionModel(valFrom); ok && vTo.Kind() == reflect.Interface {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.UnionModel")
+		diags.Append(expandUnion(ctx, sourcePath, fromUnion, valFrom, targetPath, vTo, expander)...)
+		return diags
+	}
+
 	vFrom, ok := valFrom.Interface().(attr.Value)
 	if !ok {
 		tflog.SubsystemError(ctx, subsystemName, "Source does not implement attr.Value")
@@ -1151,6 +1171,12 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		if fromUnion, ok := unionModel(valFrom); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.UnionModel")
+			diags.Append(expandUnion(ctx, sourcePath, fromUnion, valFrom, targetPath, valTo, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
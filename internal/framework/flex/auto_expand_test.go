@@ -4841,6 +4841,248 @@ func testFlexAWSInterfaceInterfacePtr(v awsInterfaceInterface) *awsInterfaceInte
 	return &v
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"top level string member": {
+			Source: tfUnion{
+				StringMember: types.StringValue("value1"),
+				StructMember: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+			},
+			Target: testFlexAWSUnionPtr(nil),
+			WantTarget: testFlexAWSUnionPtr(&awsUnionMemberStringMember{
+				Value: "value1",
+			}),
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnion]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsUnionModel("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[awsUnion]()),
+				traceMatchedUnionMember("", "StringMember", reflect.TypeFor[tfUnion](), "", "awsUnionMemberStringMember", reflect.TypeFor[awsUnion]()),
+				infoConvertingWithPath("StringMember", reflect.TypeFor[types.String](), "", reflect.TypeFor[string]()),
+			},
+		},
+		"top level no members": {
+			Source: tfUnion{
+				StringMember: types.StringNull(),
+				StructMember: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+			},
+			Target:     testFlexAWSUnionPtr(nil),
+			WantTarget: testFlexAWSUnionPtr(nil),
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnion]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsUnionModel("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[awsUnion]()),
+			},
+		},
+		"top level multiple members": {
+			Source: tfUnion{
+				StringMember: types.StringValue("value1"),
+				StructMember: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+					{
+						Field1: types.StringValue("value2"),
+					},
+				}),
+			},
+			Target: testFlexAWSUnionPtr(nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Invalid Attribute Combination", `Only one of "string_member", "struct_member" can be set.`),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnion]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsUnionModel("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[awsUnion]()),
+			},
+		},
+		"nested multiple members": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringMember: types.StringValue("value1"),
+						StructMember: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Invalid Attribute Combination", `Only one of "string_member", "struct_member" can be set in Field1[0].`),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsUnionModel("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+			},
+		},
+		"single list Source and single union Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringMember: types.StringNull(),
+						StructMember: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberStructMember{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsUnionModel("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "StructMember", reflect.TypeFor[tfUnion](), "Field1", "awsUnionMemberStructMember", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[0].StructMember", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].StructMember[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].StructMember[0].Field1", reflect.TypeFor[types.String](), "Field1.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"non-empty list Source and non-empty union Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringMember: types.StringValue("value1"),
+						StructMember: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						StringMember: types.StringNull(),
+						StructMember: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringMember{
+						Value: "value1",
+					},
+					&awsUnionMemberStructMember{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSlice]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[[]awsUnion]()),
+				traceExpandingNestedObjectCollection("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), 2, "Field1", reflect.TypeFor[[]awsUnion]()),
+				infoSourceImplementsUnionModel("Field1[0]", reflect.TypeFor[tfUnion](), "Field1[0]", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "StringMember", reflect.TypeFor[tfUnion](), "Field1[0]", "awsUnionMemberStringMember", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[0].StringMember", reflect.TypeFor[types.String](), "Field1[0]", reflect.TypeFor[string]()),
+				infoSourceImplementsUnionModel("Field1[1]", reflect.TypeFor[tfUnion](), "Field1[1]", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[1]", "StructMember", reflect.TypeFor[tfUnion](), "Field1[1]", "awsUnionMemberStructMember", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[1].StructMember", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1[1]", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[1].StructMember[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1[1]", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[1].StructMember[0].Field1", reflect.TypeFor[types.String](), "Field1[1].Field1", reflect.TypeFor[string]()),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandGenerated(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"generated": {
+			Source: tfGenerated{
+				Field1: types.StringValue("value1"),
+				Field2: types.Int64Value(42),
+			},
+			Target: &awsGenerated{},
+			WantTarget: &awsGenerated{
+				Field1: aws.String("value1"),
+				Field2: 42,
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfGenerated](), reflect.TypeFor[*awsGenerated]()),
+				infoConvertingWithPath("", reflect.TypeFor[types.Int64](), "", reflect.TypeFor[int32]()),
+				infoSourceImplementsGeneratedExpander(reflect.TypeFor[tfGenerated](), reflect.TypeFor[*awsGenerated]()),
+			},
+		},
+		"generated pointer source": {
+			Source: &tfGenerated{
+				Field1: types.StringNull(),
+				Field2: types.Int64Null(),
+			},
+			Target:     &awsGenerated{},
+			WantTarget: &awsGenerated{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfGenerated](), reflect.TypeFor[*awsGenerated]()),
+				infoConvertingWithPath("", reflect.TypeFor[types.Int64](), "", reflect.TypeFor[int32]()),
+				traceExpandingNullValue("", reflect.TypeFor[types.Int64](), "", reflect.TypeFor[int32]()),
+				infoSourceImplementsGeneratedExpander(reflect.TypeFor[*tfGenerated](), reflect.TypeFor[*awsGenerated]()),
+			},
+		},
+		"options": {
+			Options: []AutoFlexOptionsFunc{WithNoIgnoredFieldNames()},
+			Source: tfGenerated{
+				Field1: types.StringValue("value1"),
+				Field2: types.Int64Value(42),
+			},
+			Target: &awsGenerated{},
+			WantTarget: &awsGenerated{
+				Field1: aws.String("value1"),
+				Field2: 42,
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfGenerated](), reflect.TypeFor[*awsGenerated]()),
+				infoConverting(reflect.TypeFor[tfGenerated](), reflect.TypeFor[*awsGenerated]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfGenerated](), "Field1", reflect.TypeFor[*awsGenerated]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+				traceMatchedFields("Field2", reflect.TypeFor[tfGenerated](), "Field2", reflect.TypeFor[*awsGenerated]()),
+				infoConvertingWithPath("Field2", reflect.TypeFor[types.Int64](), "Field2", reflect.TypeFor[int32]()),
+			},
+		},
+		"no generated expander": {
+			Source: tfGenerated{
+				Field1: types.StringValue("value1"),
+				Field2: types.Int64Value(42),
+			},
+			Target: &awsSingleStringValue{},
+			WantTarget: &awsSingleStringValue{
+				Field1: "value1",
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfGenerated](), reflect.TypeFor[*awsSingleStringValue]()),
+				infoConverting(reflect.TypeFor[tfGenerated](), reflect.TypeFor[*awsSingleStringValue]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfGenerated](), "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[string]()),
+				debugNoCorrespondingField(reflect.TypeFor[tfGenerated](), "Field2", reflect.TypeFor[*awsSingleStringValue]()),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
+func testFlexAWSUnionPtr(v awsUnion) *awsUnion { // nosemgrep:ci.aws-in-func-name
+	return &v
+}
+
 func TestExpandExpander(t *testing.T) {
 	t.Parallel()
 
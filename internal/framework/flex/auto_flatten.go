@@ -38,6 +38,8 @@ type Flattener interface {
 // The API data structure's fields are walked and exported fields that
 // have a corresponding field in the resource's data structure (and a
 // suitable target data type) are copied.
+// If no options are specified and the resource's data structure implements
+// GeneratedFlattener, its generated flattener is used instead.
 func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
 	flattener := newAutoFlattener(optFns)
@@ -47,6 +49,18 @@ func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOpt
 		logAttrKeyTargetType: fullTypeName(reflect.TypeOf(tfObject)),
 	})
 
+	// Generated flatteners don't support options.
+	if v, ok := tfObject.(GeneratedFlattener); ok && len(optFns) == 0 {
+		if ok, d := v.FlattenGenerated(ctx, apiObject); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.GeneratedFlattener", map[string]any{
+				logAttrKeySourceType: fullTypeName(reflect.TypeOf(apiObject)),
+				logAttrKeyTargetType: fullTypeName(reflect.TypeOf(tfObject)),
+			})
+			diags.Append(d...)
+			return diags
+		}
+	}
+
 	diags.Append(autoFlattenConvert(ctx, apiObject, tfObject, flattener)...)
 
 	return diags
@@ -193,7 +207,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -612,7 +626,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -653,7 +667,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1215,7 +1229,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1236,6 +1250,29 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 	}
 
 	toFlattener, ok := to.(Flattener)
+	if toUnion, isUnion := to.(UnionModel); !ok && isUnion {
+		tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.UnionModel")
+
+		// Dereference interface and pointer.
+		vFrom = vFrom.Elem()
+		if vFrom.Kind() == reflect.Pointer {
+			vFrom = vFrom.Elem()
+		}
+
+		diags.Append(flattenUnion(ctx, sourcePath, vFrom, targetPath, toUnion, reflect.ValueOf(to), flattener)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
 	if !ok {
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
@@ -1506,6 +1543,12 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		return diags
 	}
 
+	if toUnion, ok := to.(UnionModel); ok {
+		tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.UnionModel")
+		diags.Append(flattenUnion(ctx, sourcePath, valFrom, targetPath, toUnion, valTo, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
@@ -4781,6 +4781,199 @@ func TestFlattenInterface(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"top level struct member": {
+			Source: &awsUnionMemberStructMember{
+				Value: awsSingleStringValue{
+					Field1: "value1",
+				},
+			},
+			Target: &tfUnion{},
+			WantTarget: &tfUnion{
+				StringMember: types.StringNull(),
+				StructMember: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+					{
+						Field1: types.StringValue("value1"),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsUnionMemberStructMember](), reflect.TypeFor[*tfUnion]()),
+				infoConverting(reflect.TypeFor[awsUnionMemberStructMember](), reflect.TypeFor[*tfUnion]()),
+				infoTargetImplementsUnionModel("", reflect.TypeFor[awsUnionMemberStructMember](), "", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("", "awsUnionMemberStructMember", reflect.TypeFor[awsUnionMemberStructMember](), "", "StructMember", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("", reflect.TypeFor[awsSingleStringValue](), "StructMember", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("", "Field1", reflect.TypeFor[awsSingleStringValue](), "StructMember", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[string](), "StructMember.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"nil union Source and list Target": {
+			Source: awsUnionSingle{
+				Field1: nil,
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+			},
+		},
+		"single union Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberStringMember{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringMember: types.StringValue("value1"),
+						StructMember: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoTargetImplementsUnionModel("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceMatchedUnionMember("Field1", "awsUnionMemberStringMember", reflect.TypeFor[awsUnion](), "Field1", "StringMember", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[string](), "Field1.StringMember", reflect.TypeFor[types.String]()),
+			},
+		},
+		"non-empty union slice Source and non-empty list Target": {
+			Source: awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringMember{
+						Value: "value1",
+					},
+					&awsUnionMemberStructMember{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringMember: types.StringValue("value1"),
+						StructMember: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						StringMember: types.StringNull(),
+						StructMember: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSlice](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceFlatteningNestedObjectCollection("Field1", reflect.TypeFor[[]awsUnion](), 2, "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoTargetImplementsUnionModel("Field1[0]", reflect.TypeFor[awsUnionMemberStringMember](), "Field1[0]", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1[0]", "awsUnionMemberStringMember", reflect.TypeFor[awsUnionMemberStringMember](), "Field1[0]", "StringMember", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[0]", reflect.TypeFor[string](), "Field1[0].StringMember", reflect.TypeFor[types.String]()),
+				infoTargetImplementsUnionModel("Field1[1]", reflect.TypeFor[awsUnionMemberStructMember](), "Field1[1]", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1[1]", "awsUnionMemberStructMember", reflect.TypeFor[awsUnionMemberStructMember](), "Field1[1]", "StructMember", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[1]", reflect.TypeFor[awsSingleStringValue](), "Field1[1].StructMember", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1[1]", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1[1].StructMember", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1[1].Field1", reflect.TypeFor[string](), "Field1[1].StructMember.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenGenerated(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"generated": {
+			Source: &awsGenerated{
+				Field1: aws.String("value1"),
+				Field2: 42,
+			},
+			Target: &tfGenerated{},
+			WantTarget: &tfGenerated{
+				Field1: types.StringValue("value1"),
+				Field2: types.Int64Value(42),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsGenerated](), reflect.TypeFor[*tfGenerated]()),
+				infoConvertingWithPath("", reflect.TypeFor[int32](), "", reflect.TypeFor[types.Int64]()),
+				infoTargetImplementsGeneratedFlattener(reflect.TypeFor[*awsGenerated](), reflect.TypeFor[*tfGenerated]()),
+			},
+		},
+		"generated value source": {
+			Source: awsGenerated{},
+			Target: &tfGenerated{},
+			WantTarget: &tfGenerated{
+				Field1: types.StringNull(),
+				Field2: types.Int64Value(0),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsGenerated](), reflect.TypeFor[*tfGenerated]()),
+				infoConvertingWithPath("", reflect.TypeFor[int32](), "", reflect.TypeFor[types.Int64]()),
+				infoTargetImplementsGeneratedFlattener(reflect.TypeFor[awsGenerated](), reflect.TypeFor[*tfGenerated]()),
+			},
+		},
+		"options": {
+			Options: []AutoFlexOptionsFunc{WithNoIgnoredFieldNames()},
+			Source: &awsGenerated{
+				Field1: aws.String("value1"),
+				Field2: 42,
+			},
+			Target: &tfGenerated{},
+			WantTarget: &tfGenerated{
+				Field1: types.StringValue("value1"),
+				Field2: types.Int64Value(42),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsGenerated](), reflect.TypeFor[*tfGenerated]()),
+				infoConverting(reflect.TypeFor[awsGenerated](), reflect.TypeFor[*tfGenerated]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsGenerated](), "Field1", reflect.TypeFor[*tfGenerated]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[*string](), "Field1", reflect.TypeFor[types.String]()),
+				traceMatchedFields("Field2", reflect.TypeFor[awsGenerated](), "Field2", reflect.TypeFor[*tfGenerated]()),
+				infoConvertingWithPath("Field2", reflect.TypeFor[int32](), "Field2", reflect.TypeFor[types.Int64]()),
+			},
+		},
+		"no generated flattener": {
+			Source: &awsSingleStringValue{
+				Field1: "value1",
+			},
+			Target: &tfGenerated{},
+			WantTarget: &tfGenerated{
+				Field1: types.StringValue("value1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsSingleStringValue](), reflect.TypeFor[*tfGenerated]()),
+				infoConverting(reflect.TypeFor[awsSingleStringValue](), reflect.TypeFor[*tfGenerated]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsSingleStringValue](), "Field1", reflect.TypeFor[*tfGenerated]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[string](), "Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenFlattener(t *testing.T) {
 	t.Parallel()
 
@@ -558,6 +558,118 @@ var _ awsInterfaceInterface = &awsInterfaceInterfaceImpl{}
 
 func (t *awsInterfaceInterfaceImpl) isAWSInterfaceInterface() {} // nosemgrep:ci.aws-in-func-name
 
+type tfUnion struct {
+	StringMember types.String                                         `tfsdk:"string_member"`
+	StructMember fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"struct_member"`
+}
+
+var _ UnionModel = tfUnion{}
+
+func (tfUnion) UnionMemberTypes() []any {
+	return []any{
+		&awsUnionMemberStringMember{},
+		&awsUnionMemberStructMember{},
+	}
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberStringMember struct {
+	Value string
+}
+
+func (*awsUnionMemberStringMember) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberStructMember struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberStructMember) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+// tfGenerated has hand-written implementations of the methods generated by internal/generate/autoflex.
+type tfGenerated struct {
+	Field1 types.String `tfsdk:"field1"`
+	Field2 types.Int64  `tfsdk:"field2"`
+}
+
+var (
+	_ GeneratedExpander  = tfGenerated{}
+	_ GeneratedFlattener = (*tfGenerated)(nil)
+)
+
+func (m tfGenerated) ExpandGenerated(ctx context.Context, apiObject any) (bool, diag.Diagnostics) {
+	switch apiObject := apiObject.(type) {
+	case *awsGenerated:
+		if apiObject == nil {
+			return false, nil
+		}
+		return true, m.expandToAWSGenerated(ctx, apiObject)
+	}
+
+	return false, nil
+}
+
+func (m tfGenerated) expandToAWSGenerated(ctx context.Context, apiObject *awsGenerated) diag.Diagnostics { // nosemgrep:ci.aws-in-func-name
+	var diags diag.Diagnostics
+
+	// Field1 -> Field1.
+	if !m.Field1.IsNull() && !m.Field1.IsUnknown() {
+		apiObject.Field1 = m.Field1.ValueStringPointer()
+	}
+
+	// Field2 -> Field2.
+	diags.Append(ExpandField(ctx, &m.Field2, &apiObject.Field2, "")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+func (m *tfGenerated) FlattenGenerated(ctx context.Context, apiObject any) (bool, diag.Diagnostics) {
+	switch apiObject := apiObject.(type) {
+	case *awsGenerated:
+		if apiObject == nil {
+			return false, nil
+		}
+		return true, m.flattenFromAWSGenerated(ctx, apiObject)
+	case awsGenerated:
+		return true, m.flattenFromAWSGenerated(ctx, &apiObject)
+	}
+
+	return false, nil
+}
+
+func (m *tfGenerated) flattenFromAWSGenerated(ctx context.Context, apiObject *awsGenerated) diag.Diagnostics { // nosemgrep:ci.aws-in-func-name
+	var diags diag.Diagnostics
+
+	// Field1 -> Field1.
+	m.Field1 = types.StringPointerValue(apiObject.Field1)
+
+	// Field2 -> Field2.
+	diags.Append(FlattenField(ctx, &apiObject.Field2, &m.Field2, "")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+type awsGenerated struct {
+	Field1 *string
+	Field2 int32
+}
+
 type tfFlexer struct {
 	Field1 types.String `tfsdk:"field1"`
 }
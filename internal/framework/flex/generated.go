@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// GeneratedExpander is implemented by types with generated, reflection-free expanders.
+// See internal/generate/autoflex.
+type GeneratedExpander interface {
+	// ExpandGenerated expands into the AWS API data structure pointed to by `apiObject`.
+	// It returns false if no expander has been generated for the data structure's type.
+	ExpandGenerated(ctx context.Context, apiObject any) (bool, diag.Diagnostics)
+}
+
+// GeneratedFlattener is implemented by types with generated, reflection-free flatteners.
+// See internal/generate/autoflex.
+type GeneratedFlattener interface {
+	// FlattenGenerated flattens the specified AWS API data structure.
+	// It returns false if no flattener has been generated for the data structure's type.
+	FlattenGenerated(ctx context.Context, apiObject any) (bool, diag.Diagnostics)
+}
+
+// ExpandField expands a single field of a resource's data structure into a field of an AWS API data structure.
+// `from` and `to` are pointers to the fields and `tag` is the source field's `autoflex` struct tag.
+// Generated expanders use ExpandField for fields with types that they do not convert statically.
+func ExpandField(ctx context.Context, from, to any, tag string) diag.Diagnostics {
+	_, tagOpts := parseTag(tag)
+	opts := fieldOpts{
+		legacy: tagOpts.Legacy(),
+	}
+
+	return newAutoExpander(nil).convert(ctx, path.Empty(), reflect.ValueOf(from).Elem(), path.Empty(), reflect.ValueOf(to).Elem(), opts)
+}
+
+// FlattenField flattens a single field of an AWS API data structure into a field of a resource's data structure.
+// `from` and `to` are pointers to the fields and `tag` is the target field's `autoflex` struct tag.
+// Generated flatteners use FlattenField for fields with types that they do not convert statically.
+func FlattenField(ctx context.Context, from, to any, tag string) diag.Diagnostics {
+	_, tagOpts := parseTag(tag)
+	opts := fieldOpts{
+		legacy:    tagOpts.Legacy(),
+		omitempty: tagOpts.OmitEmpty(),
+	}
+
+	return newAutoFlattener(nil).convert(ctx, path.Empty(), reflect.ValueOf(from).Elem(), path.Empty(), reflect.ValueOf(to).Elem(), opts)
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+const (
+	unionMemberNameSeparator = "Member"
+	unionMemberValueField    = "Value"
+)
+
+// UnionModel is implemented by Plugin Framework models of AWS API union types
+// (Smithy tagged unions such as `awstypes.ConfigTypeData`, implemented by `awstypes.ConfigTypeDataMemberAntennaDownlinkConfig` etc.).
+//
+// The model has one field (usually a nested block) per union member.
+// A model field corresponds to the member type whose name ends with "Member" followed by the (case-insensitive) field name,
+// e.g. the field `AntennaDownlinkConfig` corresponds to `awstypes.ConfigTypeDataMemberAntennaDownlinkConfig`.
+//
+// When expanding, the model field that is neither null nor unknown is expanded to the corresponding member's `Value`;
+// it is an error for more than one field to be set.
+// When flattening, the member's `Value` is flattened to the corresponding model field and all other fields are set to null.
+// Implement UnionModel on the model's value type.
+type UnionModel interface {
+	// UnionMemberTypes returns a (pointer to a) value of each of the union's member types,
+	// e.g. `&awstypes.ConfigTypeDataMemberAntennaDownlinkConfig{}`.
+	UnionMemberTypes() []any
+}
+
+// unionModel returns the specified value as a UnionModel, if it implements the interface.
+func unionModel(v reflect.Value) (UnionModel, bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil, false
+	}
+
+	if m, ok := v.Interface().(UnionModel); ok {
+		return m, true
+	}
+
+	if v.Kind() != reflect.Pointer && v.CanAddr() {
+		if m, ok := v.Addr().Interface().(UnionModel); ok {
+			return m, true
+		}
+	}
+
+	return nil, false
+}
+
+// unionMemberStructTypes returns the struct types of the specified model's union members.
+func unionMemberStructTypes(m UnionModel) []reflect.Type {
+	var types []reflect.Type
+
+	for _, v := range m.UnionMemberTypes() {
+		typ := reflect.TypeOf(v)
+		if typ == nil {
+			continue
+		}
+		if typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			continue
+		}
+
+		types = append(types, typ)
+	}
+
+	return types
+}
+
+// isUnionMemberForField returns whether the specified union member type corresponds to the specified model field.
+func isUnionMemberForField(memberType reflect.Type, fieldName string) bool {
+	memberName := memberType.Name()
+	suffix := unionMemberNameSeparator + fieldName
+
+	if len(memberName) <= len(suffix) {
+		return false
+	}
+
+	return strings.EqualFold(memberName[len(memberName)-len(suffix):], suffix)
+}
+
+// expandUnion expands a UnionModel to the union member corresponding to its set field
+// and assigns the member to the AWS API union interface value `valTo`.
+// An error is returned if more than one field corresponding to a union member is set.
+func expandUnion(ctx context.Context, sourcePath path.Path, m UnionModel, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	memberTypes := unionMemberStructTypes(m)
+
+	var (
+		fields  []reflect.StructField
+		members []reflect.Type
+	)
+	for field := range expandSourceFields(ctx, valFrom.Type(), flexer.getOptions()) {
+		v, ok := valFrom.FieldByIndex(field.Index).Interface().(attr.Value)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		i := slices.IndexFunc(memberTypes, func(memberType reflect.Type) bool {
+			return isUnionMemberForField(memberType, field.Name)
+		})
+		if i == -1 {
+			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding union member", map[string]any{
+				logAttrKeySourceFieldname: field.Name,
+			})
+			continue
+		}
+
+		fields = append(fields, field)
+		members = append(members, memberTypes[i])
+	}
+
+	switch len(fields) {
+	case 0:
+		return diags
+	case 1:
+	default:
+		diags.Append(diagUnionMultipleMembers(sourcePath, fields))
+		return diags
+	}
+
+	field, memberType := fields[0], members[0]
+
+	member := reflect.New(memberType)
+	if !member.Type().Implements(valTo.Type()) {
+		diags.Append(diagExpandedTypeDoesNotImplement(member.Type(), valTo.Type()))
+		return diags
+	}
+
+	memberValue := member.Elem().FieldByName(unionMemberValueField)
+	if !memberValue.IsValid() || !memberValue.CanSet() {
+		diags.Append(diagUnionMemberHasNoValue(memberType))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: field.Name,
+		logAttrKeyTargetFieldname: memberType.Name(),
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(field.Name), valFrom.FieldByIndex(field.Index), targetPath, memberValue, fieldOpts{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valTo.Set(member)
+
+	return diags
+}
+
+// flattenUnion flattens an AWS API union member to the corresponding field of a UnionModel.
+// All other fields of the model are set to null.
+func flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, m UnionModel, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if valTo.Kind() == reflect.Pointer {
+		valTo = valTo.Elem()
+	}
+
+	memberType := valFrom.Type()
+	if !slices.Contains(unionMemberStructTypes(m), memberType) {
+		tflog.SubsystemDebug(ctx, subsystemName, "Source is not a union member", map[string]any{
+			logAttrKeySourceType: fullTypeName(memberType),
+		})
+		return diags
+	}
+
+	memberValue := valFrom.FieldByName(unionMemberValueField)
+	if !memberValue.IsValid() {
+		diags.Append(diagUnionMemberHasNoValue(memberType))
+		return diags
+	}
+
+	for field := range tfreflect.ExportedStructFields(valTo.Type()) {
+		if !isUnionMemberForField(memberType, field.Name) {
+			continue
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: memberType.Name(),
+			logAttrKeyTargetFieldname: field.Name,
+		})
+
+		diags.Append(flexer.convert(ctx, sourcePath, memberValue, targetPath.AtName(field.Name), valTo.FieldByIndex(field.Index), fieldOpts{})...)
+
+		return diags
+	}
+
+	tflog.SubsystemDebug(ctx, subsystemName, "No corresponding union model field", map[string]any{
+		logAttrKeySourceFieldname: memberType.Name(),
+	})
+
+	return diags
+}
+
+func diagUnionMemberHasNoValue(memberType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while converting configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Union member type %q has no Value field", fullTypeName(memberType)),
+	)
+}
+
+func diagUnionMultipleMembers(sourcePath path.Path, fields []reflect.StructField) diag.ErrorDiagnostic {
+	attributeNames := make([]string, 0, len(fields))
+	for _, field := range fields {
+		name := field.Tag.Get("tfsdk")
+		if name == "" {
+			name = field.Name
+		}
+		attributeNames = append(attributeNames, fmt.Sprintf("%q", name))
+	}
+
+	var detail string
+	if sourcePath.Equal(path.Empty()) {
+		detail = fmt.Sprintf("Only one of %s can be set.", strings.Join(attributeNames, ", "))
+	} else {
+		detail = fmt.Sprintf("Only one of %s can be set in %s.", strings.Join(attributeNames, ", "), sourcePath)
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		detail,
+	)
+}
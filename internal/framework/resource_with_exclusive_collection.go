@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ExclusiveCollection is implemented by resources which take exclusive ownership of a remote collection,
+// for example the managed policies attached to an IAM role.
+// M is the resource's model and T is the type of a collection member.
+type ExclusiveCollection[M any, T comparable] interface {
+	// ConfiguredMembers returns the collection members configured in the model.
+	ConfiguredMembers(ctx context.Context, data *M) ([]T, diag.Diagnostics)
+	// SetMembers sets the collection members in the model.
+	SetMembers(ctx context.Context, data *M, members []T) diag.Diagnostics
+	// ListMembers returns the members currently in the remote collection.
+	// A NotFound error indicates that the collection's owner no longer exists.
+	// ListMembers may also set computed attributes of the model.
+	ListMembers(ctx context.Context, data *M) ([]T, error)
+	// AddMembers adds members to the remote collection.
+	AddMembers(ctx context.Context, data *M, members []T) error
+	// RemoveMembers removes members from the remote collection.
+	RemoveMembers(ctx context.Context, data *M, members []T) error
+}
+
+// ExclusiveCollectionWithDiff is implemented by exclusive collections whose members are not simply compared using ==.
+type ExclusiveCollectionWithDiff[T comparable] interface {
+	// DiffMembers returns the members to be added to and removed from the remote collection.
+	DiffMembers(have, want []T) (add, remove []T)
+}
+
+// ExclusiveCollectionWithBatchChanges is implemented by exclusive collections that add and remove members in a single operation.
+// When implemented, AddMembers and RemoveMembers are not called to reconcile the remote collection.
+type ExclusiveCollectionWithBatchChanges[M any, T comparable] interface {
+	// ChangeMembers adds members to and removes members from the remote collection.
+	ChangeMembers(ctx context.Context, data *M, add, remove []T) error
+}
+
+// ExclusiveCollectionWithTimeouts is implemented by exclusive collections with configurable Create and Update timeouts.
+// The context passed to the collection's methods during Create and Update has the corresponding deadline.
+type ExclusiveCollectionWithTimeouts[M any] interface {
+	CreateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration
+	UpdateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration
+	// Timeouts returns the timeouts configured in the model.
+	Timeouts(data *M) timeouts.Value
+}
+
+// ExclusiveCollectionWithoutNotFoundWarning is implemented by exclusive collections that are removed from state
+// without a warning diagnostic when the collection's owner no longer exists.
+type ExclusiveCollectionWithoutNotFoundWarning interface {
+	OmitNotFoundWarning()
+}
+
+// ExclusiveCollectionWithErrorSummary is implemented by exclusive collections that report errors using their own diagnostic summaries.
+type ExclusiveCollectionWithErrorSummary[M any] interface {
+	// ErrorSummary returns the summary of the error diagnostic reported when the specified action fails.
+	ErrorSummary(data *M, action string, err error) string
+}
+
+// ResourceWithExclusiveCollection is a structure to be embedded within a Resource that takes exclusive ownership of a remote collection.
+// Members configured on the resource but not in the remote collection are added and
+// members in the remote collection but not configured on the resource are removed.
+// Update only reconciles the remote collection if the configured members have changed.
+// Deleting the resource leaves the remote collection unchanged.
+//
+// The embedding Resource must call SetExclusiveCollection, typically with itself, in its constructor.
+type ResourceWithExclusiveCollection[M any, T comparable] struct {
+	ResourceWithModel[M]
+	WithNoOpDelete
+	collection ExclusiveCollection[M, T]
+}
+
+// SetExclusiveCollection sets the hooks used to reconcile the remote collection.
+func (r *ResourceWithExclusiveCollection[M, T]) SetExclusiveCollection(collection ExclusiveCollection[M, T]) {
+	r.collection = collection
+}
+
+func (r *ResourceWithExclusiveCollection[M, T]) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data M
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := r.collection.ConfiguredMembers(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if v, ok := r.collection.(ExclusiveCollectionWithTimeouts[M]); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.CreateTimeout(ctx, v.Timeouts(&data)))
+		defer cancel()
+	}
+
+	if err := r.syncMembers(ctx, &data, want); err != nil {
+		r.addError(ctx, &response.Diagnostics, &data, create.ErrActionCreating, err)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ResourceWithExclusiveCollection[M, T]) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data M
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	have, err := r.collection.ListMembers(ctx, &data)
+
+	if tfresource.NotFound(err) {
+		if _, ok := r.collection.(ExclusiveCollectionWithoutNotFoundWarning); !ok {
+			response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		}
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		r.addError(ctx, &response.Diagnostics, &data, create.ErrActionReading, err)
+		return
+	}
+
+	response.Diagnostics.Append(r.collection.SetMembers(ctx, &data, have)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ResourceWithExclusiveCollection[M, T]) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new M
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	have, diags := r.collection.ConfiguredMembers(ctx, &old)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := r.collection.ConfiguredMembers(ctx, &new)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if add, remove := r.diffMembers(have, want); len(add) > 0 || len(remove) > 0 {
+		if v, ok := r.collection.(ExclusiveCollectionWithTimeouts[M]); ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, v.UpdateTimeout(ctx, v.Timeouts(&new)))
+			defer cancel()
+		}
+
+		if err := r.syncMembers(ctx, &new, want); err != nil {
+			r.addError(ctx, &response.Diagnostics, &new, create.ErrActionUpdating, err)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// addError adds an error diagnostic for the failed action.
+func (r *ResourceWithExclusiveCollection[M, T]) addError(ctx context.Context, diags *diag.Diagnostics, data *M, action string, err error) {
+	if v, ok := r.collection.(ExclusiveCollectionWithErrorSummary[M]); ok {
+		diags.AddError(v.ErrorSummary(data, action, err), err.Error())
+		return
+	}
+
+	smerr.AddError(ctx, diags, err)
+}
+
+// syncMembers brings the remote collection in line with the wanted members.
+func (r *ResourceWithExclusiveCollection[M, T]) syncMembers(ctx context.Context, data *M, want []T) error {
+	have, err := r.collection.ListMembers(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	add, remove := r.diffMembers(have, want)
+
+	if v, ok := r.collection.(ExclusiveCollectionWithBatchChanges[M, T]); ok {
+		if len(add) > 0 || len(remove) > 0 {
+			return v.ChangeMembers(ctx, data, add, remove)
+		}
+
+		return nil
+	}
+
+	if len(add) > 0 {
+		if err := r.collection.AddMembers(ctx, data, add); err != nil {
+			return err
+		}
+	}
+
+	if len(remove) > 0 {
+		if err := r.collection.RemoveMembers(ctx, data, remove); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffMembers returns the members to be added to and removed from have to match want.
+func (r *ResourceWithExclusiveCollection[M, T]) diffMembers(have, want []T) ([]T, []T) {
+	if v, ok := r.collection.(ExclusiveCollectionWithDiff[T]); ok {
+		return v.DiffMembers(have, want)
+	}
+
+	add, remove, _ := intflex.DiffSlices(have, want, func(t1, t2 T) bool { return t1 == t2 })
+
+	return add, remove
+}
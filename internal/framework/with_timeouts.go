@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 // WithTimeouts is intended to be embedded in resources which use the special "timeouts" nested block.
@@ -38,8 +39,14 @@ func (w *WithTimeouts) SetDefaultDeleteTimeout(timeout time.Duration) {
 }
 
 // CreateTimeout returns any configured Create timeout value or the default value.
+// Any provider-level default takes precedence over the resource's default value.
 func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Create(ctx, w.defaultCreateTimeout)
+	defaultTimeout := w.defaultCreateTimeout
+	if v, ok := conns.ResourceDefaultsFromContext(ctx); ok && v.CreateTimeout > 0 {
+		defaultTimeout = v.CreateTimeout
+	}
+
+	timeout, diags := timeouts.Create(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Create timeout", map[string]any{
@@ -47,15 +54,21 @@ func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultCreateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
 // ReadTimeout returns any configured Read timeout value or the default value.
+// Any provider-level default takes precedence over the resource's default value.
 func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Read(ctx, w.defaultReadTimeout)
+	defaultTimeout := w.defaultReadTimeout
+	if v, ok := conns.ResourceDefaultsFromContext(ctx); ok && v.ReadTimeout > 0 {
+		defaultTimeout = v.ReadTimeout
+	}
+
+	timeout, diags := timeouts.Read(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Read timeout", map[string]any{
@@ -63,15 +76,21 @@ func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value)
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultReadTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
 // UpdateTimeout returns any configured Update timeout value or the default value.
+// Any provider-level default takes precedence over the resource's default value.
 func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Update(ctx, w.defaultUpdateTimeout)
+	defaultTimeout := w.defaultUpdateTimeout
+	if v, ok := conns.ResourceDefaultsFromContext(ctx); ok && v.UpdateTimeout > 0 {
+		defaultTimeout = v.UpdateTimeout
+	}
+
+	timeout, diags := timeouts.Update(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Update timeout", map[string]any{
@@ -79,15 +98,21 @@ func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultUpdateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
 // DeleteTimeout returns any configured Delete timeout value or the default value.
+// Any provider-level default takes precedence over the resource's default value.
 func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Delete(ctx, w.defaultDeleteTimeout)
+	defaultTimeout := w.defaultDeleteTimeout
+	if v, ok := conns.ResourceDefaultsFromContext(ctx); ok && v.DeleteTimeout > 0 {
+		defaultTimeout = v.DeleteTimeout
+	}
+
+	timeout, diags := timeouts.Delete(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Delete timeout", map[string]any{
@@ -95,7 +120,7 @@ func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultDeleteTimeout
+		return defaultTimeout
 	}
 
 	return timeout
@@ -0,0 +1,576 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// EventBridge event pattern reference:
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-create-pattern-operators.html
+
+const (
+	patternOperatorAnythingBut      = "anything-but"
+	patternOperatorCIDR             = "cidr"
+	patternOperatorEqualsIgnoreCase = "equals-ignore-case"
+	patternOperatorExists           = "exists"
+	patternOperatorNumeric          = "numeric"
+	patternOperatorPrefix           = "prefix"
+	patternOperatorSuffix           = "suffix"
+	patternOperatorWildcard         = "wildcard"
+
+	// patternKeyOr is the key of an object whose value is a list of alternative patterns.
+	patternKeyOr = "$or"
+)
+
+var _ function.Function = eventPatternMatchesFunction{}
+
+func NewEventPatternMatchesFunction() function.Function {
+	return &eventPatternMatchesFunction{}
+}
+
+type eventPatternMatchesFunction struct{}
+
+func (f eventPatternMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "event_pattern_matches"
+}
+
+func (f eventPatternMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "event_pattern_matches Function",
+		MarkdownDescription: "Tests whether an Amazon EventBridge event pattern matches an event. " +
+			"Matching is performed locally, without calling the EventBridge TestEventPattern API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "JSON-encoded EventBridge event pattern",
+			},
+			function.StringParameter{
+				Name:                "event",
+				MarkdownDescription: "JSON-encoded event",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f eventPatternMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, event string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &event))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := eventPatternMatches(pattern, event)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// eventPatternMatches returns whether the JSON event pattern matches the JSON event
+func eventPatternMatches(pattern, event string) (bool, error) {
+	var p map[string]any
+	if err := json.Unmarshal([]byte(pattern), &p); err != nil {
+		return false, fmt.Errorf("pattern must be a JSON object: %w", err)
+	}
+
+	if err := validatePattern("", p); err != nil {
+		return false, err
+	}
+
+	var e map[string]any
+	if err := json.Unmarshal([]byte(event), &e); err != nil {
+		return false, fmt.Errorf("event must be a JSON object: %w", err)
+	}
+
+	return matchObject(p, e, true), nil
+}
+
+// validatePattern checks the structure of a pattern object so that matching can assume it is well-formed
+func validatePattern(path string, pattern map[string]any) error {
+	if len(pattern) == 0 {
+		return fmt.Errorf("%s: pattern must not be empty", patternPath(path))
+	}
+
+	for k, v := range pattern {
+		fieldPath := path + "." + k
+
+		if k == patternKeyOr {
+			alternatives, ok := v.([]any)
+			if !ok || len(alternatives) == 0 {
+				return fmt.Errorf("%s: must be a non-empty list of patterns", fieldPath)
+			}
+			for i, alternative := range alternatives {
+				alternative, ok := alternative.(map[string]any)
+				if !ok {
+					return fmt.Errorf("%s[%d]: must be a pattern object", fieldPath, i)
+				}
+				if err := validatePattern(path, alternative); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		switch v := v.(type) {
+		case map[string]any:
+			if err := validatePattern(fieldPath, v); err != nil {
+				return err
+			}
+		case []any:
+			if len(v) == 0 {
+				return fmt.Errorf("%s: must be a non-empty list of match values", fieldPath)
+			}
+			for _, condition := range v {
+				if err := validateCondition(fieldPath, condition); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("%s: must be an object or a list of match values", fieldPath)
+		}
+	}
+
+	return nil
+}
+
+func validateCondition(path string, condition any) error {
+	m, ok := condition.(map[string]any)
+	if !ok {
+		// Exact match.
+		return nil
+	}
+
+	if len(m) != 1 {
+		return fmt.Errorf("%s: content filter must contain exactly one operator", path)
+	}
+
+	for operator, v := range m {
+		switch operator {
+		case patternOperatorAnythingBut:
+			switch v := v.(type) {
+			case string, float64:
+			case []any:
+				for _, v := range v {
+					switch v.(type) {
+					case string, float64:
+					default:
+						return fmt.Errorf("%s: %q list values must be strings or numbers", path, operator)
+					}
+				}
+			case map[string]any:
+				if len(v) != 1 {
+					return fmt.Errorf("%s: %q must contain exactly one operator", path, operator)
+				}
+				for nested, value := range v {
+					switch nested {
+					case patternOperatorEqualsIgnoreCase, patternOperatorPrefix, patternOperatorSuffix, patternOperatorWildcard:
+						if err := validateStringOrStrings(path, nested, value); err != nil {
+							return err
+						}
+					default:
+						return fmt.Errorf("%s: unsupported %q operator %q", path, operator, nested)
+					}
+				}
+			default:
+				return fmt.Errorf("%s: %q must be a string, number, list or object", path, operator)
+			}
+		case patternOperatorCIDR:
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%s: %q must be a string", path, operator)
+			}
+			if _, err := netip.ParsePrefix(s); err != nil {
+				return fmt.Errorf("%s: %q: %w", path, operator, err)
+			}
+		case patternOperatorEqualsIgnoreCase, patternOperatorWildcard:
+			if _, ok := v.(string); !ok {
+				return fmt.Errorf("%s: %q must be a string", path, operator)
+			}
+		case patternOperatorExists:
+			if _, ok := v.(bool); !ok {
+				return fmt.Errorf("%s: %q must be a boolean", path, operator)
+			}
+		case patternOperatorNumeric:
+			if _, err := parseNumericRange(v); err != nil {
+				return fmt.Errorf("%s: %q: %w", path, operator, err)
+			}
+		case patternOperatorPrefix, patternOperatorSuffix:
+			switch v := v.(type) {
+			case string:
+			case map[string]any:
+				if s, ok := v[patternOperatorEqualsIgnoreCase].(string); !ok || len(v) != 1 {
+					return fmt.Errorf("%s: %q must be a string or an %q object", path, operator, patternOperatorEqualsIgnoreCase)
+				} else if s == "" {
+					return fmt.Errorf("%s: %q must not be empty", path, operator)
+				}
+			default:
+				return fmt.Errorf("%s: %q must be a string or an %q object", path, operator, patternOperatorEqualsIgnoreCase)
+			}
+		default:
+			return fmt.Errorf("%s: unsupported operator %q", path, operator)
+		}
+	}
+
+	return nil
+}
+
+func validateStringOrStrings(path, operator string, v any) error {
+	switch v := v.(type) {
+	case string:
+		return nil
+	case []any:
+		for _, v := range v {
+			if _, ok := v.(string); !ok {
+				return fmt.Errorf("%s: %q list values must be strings", path, operator)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%s: %q must be a string or a list of strings", path, operator)
+	}
+}
+
+func patternPath(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
+
+// matchObject returns whether all fields of a pattern object match an event object.
+// present is false when the event object does not exist, so that only "exists": false conditions can match.
+func matchObject(pattern map[string]any, event map[string]any, present bool) bool {
+	for k, v := range pattern {
+		if k == patternKeyOr {
+			if !slices.ContainsFunc(v.([]any), func(alternative any) bool {
+				return matchObject(alternative.(map[string]any), event, present)
+			}) {
+				return false
+			}
+			continue
+		}
+
+		value, ok := event[k]
+		ok = ok && present
+
+		switch v := v.(type) {
+		case map[string]any:
+			if !matchNested(v, value, ok) {
+				return false
+			}
+		case []any:
+			if !matchConditions(v, value, ok) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func matchNested(pattern map[string]any, value any, present bool) bool {
+	switch value := value.(type) {
+	case map[string]any:
+		return matchObject(pattern, value, present)
+	case []any:
+		// Any object in an array of objects may match.
+		for _, v := range value {
+			if v, ok := v.(map[string]any); ok && matchObject(pattern, v, present) {
+				return true
+			}
+		}
+		return matchObject(pattern, nil, false)
+	default:
+		return matchObject(pattern, nil, false)
+	}
+}
+
+// matchConditions returns whether any of a field's match conditions match the event value.
+// If the event value is an array, any element may match.
+func matchConditions(conditions []any, value any, present bool) bool {
+	values := []any{value}
+	if v, ok := value.([]any); ok {
+		values = v
+	}
+
+	for _, condition := range conditions {
+		if m, ok := condition.(map[string]any); ok {
+			if exists, ok := m[patternOperatorExists].(bool); ok {
+				if matchExists(exists, value, present) {
+					return true
+				}
+				continue
+			}
+		}
+
+		if !present {
+			continue
+		}
+
+		for _, v := range values {
+			if matchCondition(condition, v) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func matchExists(exists bool, value any, present bool) bool {
+	// Exists matching only applies to leaf nodes.
+	if _, ok := value.(map[string]any); ok {
+		return !exists
+	}
+
+	return exists == present
+}
+
+func matchCondition(condition, value any) bool {
+	m, ok := condition.(map[string]any)
+	if !ok {
+		return matchExact(condition, value)
+	}
+
+	for operator, v := range m {
+		switch operator {
+		case patternOperatorAnythingBut:
+			return matchAnythingBut(v, value)
+		case patternOperatorCIDR:
+			s, ok := value.(string)
+			if !ok {
+				return false
+			}
+			addr, err := netip.ParseAddr(s)
+			if err != nil {
+				return false
+			}
+			prefix, _ := netip.ParsePrefix(v.(string))
+			return prefix.Contains(addr)
+		case patternOperatorEqualsIgnoreCase:
+			s, ok := value.(string)
+			return ok && strings.EqualFold(s, v.(string))
+		case patternOperatorNumeric:
+			n, ok := value.(float64)
+			if !ok {
+				return false
+			}
+			r, _ := parseNumericRange(v)
+			return r.contains(n)
+		case patternOperatorPrefix:
+			return matchAffix(v, value, strings.HasPrefix)
+		case patternOperatorSuffix:
+			return matchAffix(v, value, strings.HasSuffix)
+		case patternOperatorWildcard:
+			s, ok := value.(string)
+			return ok && matchWildcard(v.(string), s)
+		}
+	}
+
+	return false
+}
+
+func matchExact(condition, value any) bool {
+	switch condition := condition.(type) {
+	case nil:
+		return value == nil
+	case bool:
+		v, ok := value.(bool)
+		return ok && v == condition
+	case float64:
+		v, ok := value.(float64)
+		return ok && v == condition
+	case string:
+		v, ok := value.(string)
+		return ok && v == condition
+	}
+
+	return false
+}
+
+func matchAffix(affix, value any, f func(string, string) bool) bool {
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+
+	switch affix := affix.(type) {
+	case string:
+		return f(s, affix)
+	case map[string]any:
+		return f(strings.ToLower(s), strings.ToLower(affix[patternOperatorEqualsIgnoreCase].(string)))
+	}
+
+	return false
+}
+
+// matchAnythingBut returns whether the event value is present and does not match any of the excluded values
+func matchAnythingBut(excluded, value any) bool {
+	switch excluded := excluded.(type) {
+	case []any:
+		return !slices.ContainsFunc(excluded, func(v any) bool {
+			return matchExact(v, value)
+		})
+	case map[string]any:
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+		for operator, v := range excluded {
+			var strs []string
+			switch v := v.(type) {
+			case string:
+				strs = []string{v}
+			case []any:
+				for _, v := range v {
+					strs = append(strs, v.(string))
+				}
+			}
+
+			return !slices.ContainsFunc(strs, func(v string) bool {
+				switch operator {
+				case patternOperatorEqualsIgnoreCase:
+					return strings.EqualFold(s, v)
+				case patternOperatorPrefix:
+					return strings.HasPrefix(s, v)
+				case patternOperatorSuffix:
+					return strings.HasSuffix(s, v)
+				case patternOperatorWildcard:
+					return matchWildcard(v, s)
+				}
+				return false
+			})
+		}
+		return false
+	default:
+		return !matchExact(excluded, value)
+	}
+}
+
+// matchWildcard returns whether s matches a wildcard pattern in which "*" matches zero or more characters.
+// "\*" and "\\" match a literal asterisk and backslash respectively.
+func matchWildcard(pattern, s string) bool {
+	type token struct {
+		r    rune
+		star bool
+	}
+
+	var tokens []token
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			tokens = append(tokens, token{r: r})
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '*':
+			tokens = append(tokens, token{star: true})
+		default:
+			tokens = append(tokens, token{r: r})
+		}
+	}
+
+	runes := []rune(s)
+	// Iterative glob matching with backtracking to the most recent star.
+	ti, si := 0, 0
+	starTi, starSi := -1, 0
+	for si < len(runes) {
+		switch {
+		case ti < len(tokens) && !tokens[ti].star && tokens[ti].r == runes[si]:
+			ti++
+			si++
+		case ti < len(tokens) && tokens[ti].star:
+			starTi, starSi = ti, si
+			ti++
+		case starTi != -1:
+			ti = starTi + 1
+			starSi++
+			si = starSi
+		default:
+			return false
+		}
+	}
+	for ti < len(tokens) && tokens[ti].star {
+		ti++
+	}
+
+	return ti == len(tokens)
+}
+
+type numericRange struct {
+	lower, upper                   *float64
+	lowerInclusive, upperInclusive bool
+	equals                         *float64
+}
+
+func (r numericRange) contains(n float64) bool {
+	if r.equals != nil && n != *r.equals {
+		return false
+	}
+	if r.lower != nil && (n < *r.lower || (n == *r.lower && !r.lowerInclusive)) {
+		return false
+	}
+	if r.upper != nil && (n > *r.upper || (n == *r.upper && !r.upperInclusive)) {
+		return false
+	}
+
+	return true
+}
+
+func parseNumericRange(v any) (numericRange, error) {
+	var r numericRange
+
+	list, ok := v.([]any)
+	if !ok || len(list) == 0 || len(list)%2 != 0 {
+		return r, errors.New("must be a list of operator and value pairs")
+	}
+
+	for i := 0; i < len(list); i += 2 {
+		operator, ok := list[i].(string)
+		if !ok {
+			return r, fmt.Errorf("operator must be a string, got %v", list[i])
+		}
+		n, ok := list[i+1].(float64)
+		if !ok {
+			return r, fmt.Errorf("value for operator %q must be a number", operator)
+		}
+
+		switch operator {
+		case "=":
+			if r.equals != nil {
+				return r, errors.New(`"=" must be specified at most once`)
+			}
+			r.equals = &n
+		case ">", ">=":
+			if r.lower != nil {
+				return r, errors.New("lower bound must be specified at most once")
+			}
+			r.lower, r.lowerInclusive = &n, operator == ">="
+		case "<", "<=":
+			if r.upper != nil {
+				return r, errors.New("upper bound must be specified at most once")
+			}
+			r.upper, r.upperInclusive = &n, operator == "<="
+		default:
+			return r, fmt.Errorf("unsupported operator %q", operator)
+		}
+	}
+
+	return r, nil
+}
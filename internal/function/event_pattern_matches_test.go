@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestEventPatternMatches(t *testing.T) {
+	t.Parallel()
+
+	const event = `{
+  "id": "7bf73129-1428-4cd3-a780-95db273d1602",
+  "detail-type": "EC2 Instance State-change Notification",
+  "source": "aws.ec2",
+  "account": "123456789012",
+  "region": "us-east-1",
+  "resources": ["arn:aws:ec2:us-east-1:123456789012:instance/i-1234567890abcdef0"],
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "running",
+    "c-count": 5,
+    "d-count": 3.018e2,
+    "source-ip": "10.0.0.123",
+    "file": "images/photo.PNG",
+    "tags": ["prod", "web"],
+    "location": {
+      "city": "Seattle"
+    },
+    "optional": null
+  }
+}`
+
+	testCases := map[string]struct {
+		pattern       string
+		expected      bool
+		expectedError string
+	}{
+		"exact": {
+			pattern:  `{"source": ["aws.ec2"]}`,
+			expected: true,
+		},
+		"exact no match": {
+			pattern:  `{"source": ["aws.s3"]}`,
+			expected: false,
+		},
+		"multiple values": {
+			pattern:  `{"source": ["aws.s3", "aws.ec2"], "detail": {"state": ["pending", "running"]}}`,
+			expected: true,
+		},
+		"all fields must match": {
+			pattern:  `{"source": ["aws.ec2"], "detail": {"state": ["stopped"]}}`,
+			expected: false,
+		},
+		"missing field": {
+			pattern:  `{"detail": {"missing": ["value"]}}`,
+			expected: false,
+		},
+		"number": {
+			pattern:  `{"detail": {"d-count": [301.8]}}`,
+			expected: true,
+		},
+		"number does not match string": {
+			pattern:  `{"detail": {"c-count": ["5"]}}`,
+			expected: false,
+		},
+		"null": {
+			pattern:  `{"detail": {"optional": [null]}}`,
+			expected: true,
+		},
+		"array value": {
+			pattern:  `{"detail": {"tags": ["web"]}}`,
+			expected: true,
+		},
+		"nested": {
+			pattern:  `{"detail": {"location": {"city": ["Seattle"]}}}`,
+			expected: true,
+		},
+		"prefix": {
+			pattern:  `{"detail-type": [{"prefix": "EC2 Instance"}]}`,
+			expected: true,
+		},
+		"prefix no match": {
+			pattern:  `{"detail-type": [{"prefix": "ec2 instance"}]}`,
+			expected: false,
+		},
+		"prefix equals-ignore-case": {
+			pattern:  `{"detail-type": [{"prefix": {"equals-ignore-case": "ec2 instance"}}]}`,
+			expected: true,
+		},
+		"suffix": {
+			pattern:  `{"detail": {"file": [{"suffix": ".PNG"}]}}`,
+			expected: true,
+		},
+		"suffix equals-ignore-case": {
+			pattern:  `{"detail": {"file": [{"suffix": {"equals-ignore-case": ".png"}}]}}`,
+			expected: true,
+		},
+		"equals-ignore-case": {
+			pattern:  `{"detail": {"state": [{"equals-ignore-case": "RUNNING"}]}}`,
+			expected: true,
+		},
+		"anything-but": {
+			pattern:  `{"detail": {"state": [{"anything-but": "stopped"}]}}`,
+			expected: true,
+		},
+		"anything-but no match": {
+			pattern:  `{"detail": {"state": [{"anything-but": "running"}]}}`,
+			expected: false,
+		},
+		"anything-but list": {
+			pattern:  `{"detail": {"state": [{"anything-but": ["stopped", "running"]}]}}`,
+			expected: false,
+		},
+		"anything-but number": {
+			pattern:  `{"detail": {"c-count": [{"anything-but": [1, 2, 3]}]}}`,
+			expected: true,
+		},
+		"anything-but prefix": {
+			pattern:  `{"detail": {"state": [{"anything-but": {"prefix": "run"}}]}}`,
+			expected: false,
+		},
+		"anything-but suffix": {
+			pattern:  `{"detail": {"file": [{"anything-but": {"suffix": ".jpg"}}]}}`,
+			expected: true,
+		},
+		"anything-but equals-ignore-case": {
+			pattern:  `{"detail": {"state": [{"anything-but": {"equals-ignore-case": ["STOPPED", "RUNNING"]}}]}}`,
+			expected: false,
+		},
+		"anything-but missing field": {
+			pattern:  `{"detail": {"missing": [{"anything-but": "value"}]}}`,
+			expected: false,
+		},
+		"numeric range": {
+			pattern:  `{"detail": {"c-count": [{"numeric": [">", 0, "<=", 5]}]}}`,
+			expected: true,
+		},
+		"numeric range no match": {
+			pattern:  `{"detail": {"c-count": [{"numeric": [">", 5]}]}}`,
+			expected: false,
+		},
+		"numeric equals": {
+			pattern:  `{"detail": {"d-count": [{"numeric": ["=", 301.8]}]}}`,
+			expected: true,
+		},
+		"numeric string value": {
+			pattern:  `{"detail": {"state": [{"numeric": [">", 0]}]}}`,
+			expected: false,
+		},
+		"exists": {
+			pattern:  `{"detail": {"state": [{"exists": true}]}}`,
+			expected: true,
+		},
+		"exists missing field": {
+			pattern:  `{"detail": {"missing": [{"exists": true}]}}`,
+			expected: false,
+		},
+		"exists false": {
+			pattern:  `{"detail": {"missing": [{"exists": false}]}}`,
+			expected: true,
+		},
+		"exists false missing parent": {
+			pattern:  `{"missing": {"child": [{"exists": false}]}}`,
+			expected: true,
+		},
+		"exists non-leaf": {
+			pattern:  `{"detail": {"location": [{"exists": true}]}}`,
+			expected: false,
+		},
+		"wildcard": {
+			pattern:  `{"detail": {"file": [{"wildcard": "images/*.PNG"}]}}`,
+			expected: true,
+		},
+		"wildcard no match": {
+			pattern:  `{"detail": {"file": [{"wildcard": "videos/*"}]}}`,
+			expected: false,
+		},
+		"wildcard escaped": {
+			pattern:  `{"detail": {"file": [{"wildcard": "images/\\*"}]}}`,
+			expected: false,
+		},
+		"cidr": {
+			pattern:  `{"detail": {"source-ip": [{"cidr": "10.0.0.0/24"}]}}`,
+			expected: true,
+		},
+		"or": {
+			pattern:  `{"source": ["aws.ec2"], "$or": [{"detail": {"c-count": [{"numeric": [">", 10]}]}}, {"detail": {"state": ["running"]}}]}`,
+			expected: true,
+		},
+		"or no match": {
+			pattern:  `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["stopped"]}}]}`,
+			expected: false,
+		},
+		"nested or": {
+			pattern:  `{"detail": {"$or": [{"state": ["stopped"]}, {"tags": ["prod"]}]}}`,
+			expected: true,
+		},
+		"invalid pattern JSON": {
+			pattern:       `{`,
+			expectedError: `pattern must be a JSON object`,
+		},
+		"invalid pattern value": {
+			pattern:       `{"source": "aws.ec2"}`,
+			expectedError: `must be an object or a list of match values`,
+		},
+		"invalid operator": {
+			pattern:       `{"source": [{"contains": "ec2"}]}`,
+			expectedError: `unsupported operator "contains"`,
+		},
+		"invalid numeric": {
+			pattern:       `{"detail": {"c-count": [{"numeric": [">", "five"]}]}}`,
+			expectedError: `must be a number`,
+		},
+		"invalid or": {
+			pattern:       `{"$or": {"source": ["aws.ec2"]}}`,
+			expectedError: `must be a non-empty list of patterns`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.EventPatternMatches(testCase.pattern, event)
+
+			if testCase.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Errorf("expected error %q, got %v", testCase.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestEventPatternMatchesFunction_match(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchesFunctionConfig(`{"source": ["aws.ec2"], "detail": {"state": [{"anything-but": "stopped"}]}}`, `{"source": "aws.ec2", "detail": {"state": "running"}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchesFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchesFunctionConfig(`{"source": ["aws.ec2"], "detail": {"state": [{"anything-but": "stopped"}]}}`, `{"source": "aws.ec2", "detail": {"state": "stopped"}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchesFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEventPatternMatchesFunctionConfig(`{"source": [{"contains": "ec2"}]}`, `{"source": "aws.ec2"}`),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*operator`),
+			},
+		},
+	})
+}
+
+func testEventPatternMatchesFunctionConfig(pattern, event string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::event_pattern_matches(%[1]q, %[2]q)
+}`, pattern, event)
+}
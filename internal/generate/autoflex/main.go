@@ -0,0 +1,1087 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/types"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"text/template"
+
+	pluralize "github.com/gertd/go-pluralize"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	defaultFilename = "autoflex_gen.go"
+
+	basetypesPackagePath = "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fwtypesPackagePath   = "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	sdkPackagePathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+
+	mapBlockKeyFieldName = "MapBlockKey"
+)
+
+// unsourcedFieldNames are the names of model fields that are never flattened from an AWS API data structure.
+var unsourcedFieldNames = []string{
+	"Region",
+	"Tags",
+	"TagsAll",
+	"Timeouts",
+}
+
+var (
+	expand  = flag.String("Expand", "", "comma-separated list of <model>:<AWS type> pairs to generate expanders for")
+	flatten = flag.String("Flatten", "", "comma-separated list of <model>:<AWS type> pairs to generate flatteners for")
+)
+
+var (
+	//go:embed file.gtpl
+	fileTemplate string
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetPrefix("generate/autoflex: ")
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *expand == "" && *flatten == "" {
+		log.Fatal("at least one of Expand or Flatten must be specified")
+	}
+
+	filename := defaultFilename
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+	log.SetPrefix(fmt.Sprintf("generate/autoflex: %s: ", servicePackage))
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	sdkPackagePath := sdkPackagePathPrefix + service.GoV2Package()
+
+	gen, err := newGenerator(filename, sdkPackagePath)
+	if err != nil {
+		log.Fatalf("loading packages: %s", err)
+	}
+
+	for _, v := range splitPairs(*expand) {
+		if err := gen.addExpander(v[0], v[1]); err != nil {
+			log.Fatalf("generating expander %s: %s", strings.Join(v, ":"), err)
+		}
+	}
+
+	for _, v := range splitPairs(*flatten) {
+		if err := gen.addFlattener(v[0], v[1]); err != nil {
+			log.Fatalf("generating flattener %s: %s", strings.Join(v, ":"), err)
+		}
+	}
+
+	var buf bytes.Buffer
+	tmpl := template.Must(template.New("autoflex").Parse(fileTemplate))
+	if err := tmpl.Execute(&buf, gen.templateData()); err != nil {
+		log.Fatalf("error generating file: %s", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+// splitPairs splits a comma-separated list of <model>:<AWS type> pairs.
+func splitPairs(s string) [][]string {
+	var pairs [][]string
+
+	if s == "" {
+		return pairs
+	}
+
+	for v := range strings.SplitSeq(s, ",") {
+		model, apiType, ok := strings.Cut(v, ":")
+		if !ok || model == "" || apiType == "" {
+			log.Fatalf("invalid pair %q, expected <model>:<AWS type>", v)
+		}
+		pairs = append(pairs, []string{model, apiType})
+	}
+
+	return pairs
+}
+
+type TemplateData struct {
+	PackageName string
+	Imports     []Import
+	Models      []*Model
+	Funcs       []*Func
+}
+
+type Import struct {
+	Alias string
+	Path  string
+}
+
+// Model is a resource's data structure with generated expanders and/or flatteners.
+type Model struct {
+	Name       string
+	Expanders  []*Func // Top-level expanders, dispatched to from ExpandGenerated.
+	Flatteners []*Func // Top-level flatteners, dispatched to from FlattenGenerated.
+}
+
+// Func is a generated function that converts between a model and an AWS API data structure.
+type Func struct {
+	Expand    bool
+	FuncName  string
+	ModelName string
+	APIType   string
+	Fields    []Field
+}
+
+type Field struct {
+	Comment string
+	Code    string
+}
+
+type generator struct {
+	pkg      *types.Package
+	sdkPkg   *types.Package
+	typesPkg *types.Package
+	imports  map[string]string // Package path to alias.
+	models   map[string]*Model
+	funcs    map[string]*Func // Keyed by receiver and function name.
+	plural   *pluralize.Client
+
+	unmatched []string // Flattened model fields with no corresponding AWS API field.
+}
+
+func newGenerator(filename, sdkPackagePath string) (*generator, error) {
+	filename, err := filepath.Abs(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	typesPackagePath := sdkPackagePath + "/types"
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedImports,
+		// Ignore any previously generated code.
+		Overlay: map[string][]byte{
+			filename: fmt.Appendf(nil, "package %s\n", os.Getenv("GOPACKAGE")),
+		},
+	}
+	pkgs, err := packages.Load(cfg, ".", sdkPackagePath, typesPackagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		imports: make(map[string]string),
+		models:  make(map[string]*Model),
+		funcs:   make(map[string]*Func),
+		plural:  pluralize.NewClient(),
+	}
+
+	for _, pkg := range pkgs {
+		if pkg.Types == nil {
+			return nil, fmt.Errorf("package %s has no type information", pkg.PkgPath)
+		}
+
+		switch pkg.PkgPath {
+		case sdkPackagePath:
+			g.sdkPkg = pkg.Types
+		case typesPackagePath:
+			g.typesPkg = pkg.Types
+		default:
+			g.pkg = pkg.Types
+		}
+	}
+
+	if g.pkg == nil || g.sdkPkg == nil || g.typesPkg == nil {
+		return nil, fmt.Errorf("expected packages not found")
+	}
+
+	return g, nil
+}
+
+func (g *generator) templateData() TemplateData {
+	td := TemplateData{
+		PackageName: g.pkg.Name(),
+	}
+
+	for path, alias := range g.imports {
+		if alias == filepath.Base(path) {
+			alias = ""
+		}
+		td.Imports = append(td.Imports, Import{Alias: alias, Path: path})
+	}
+	slices.SortFunc(td.Imports, func(a, b Import) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	for _, name := range slices.Sorted(maps.Keys(g.models)) {
+		td.Models = append(td.Models, g.models[name])
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(g.funcs)) {
+		td.Funcs = append(td.Funcs, g.funcs[key])
+	}
+
+	return td
+}
+
+func (g *generator) addExpander(modelName, apiTypeName string) error {
+	model, apiType, err := g.lookupPair(modelName, apiTypeName)
+	if err != nil {
+		return err
+	}
+
+	f := g.expander(model, apiType)
+	m := g.model(modelName)
+	m.Expanders = append(m.Expanders, f)
+
+	return nil
+}
+
+func (g *generator) addFlattener(modelName, apiTypeName string) error {
+	model, apiType, err := g.lookupPair(modelName, apiTypeName)
+	if err != nil {
+		return err
+	}
+
+	f := g.flattener(model, apiType)
+	m := g.model(modelName)
+	m.Flatteners = append(m.Flatteners, f)
+
+	if len(g.unmatched) > 0 {
+		return fmt.Errorf("model fields have no source field (set a value in resource code and tag the field `autoflex:\",noflatten\"`):\n\t%s", strings.Join(g.unmatched, "\n\t"))
+	}
+
+	return nil
+}
+
+func (g *generator) model(name string) *Model {
+	m, ok := g.models[name]
+	if !ok {
+		m = &Model{Name: name}
+		g.models[name] = m
+	}
+
+	g.imports["github.com/hashicorp/terraform-provider-aws/internal/framework/flex"] = "fwflex"
+
+	return m
+}
+
+// lookupPair returns the named model type in the service package and the named AWS API type
+// in the AWS SDK for Go v2 service package or its types package.
+func (g *generator) lookupPair(modelName, apiTypeName string) (*types.Named, *types.Named, error) {
+	model := lookupNamed(g.pkg, modelName)
+	if model == nil {
+		return nil, nil, fmt.Errorf("type %s not found in package %s", modelName, g.pkg.Path())
+	}
+	if !g.isGeneratable(model) {
+		return nil, nil, fmt.Errorf("%s must be a non-generic struct that does not implement flex.Expander, flex.TypedExpander, flex.Flattener or flex.UnionModel", modelName)
+	}
+
+	apiType := lookupNamed(g.sdkPkg, apiTypeName)
+	if apiType == nil {
+		apiType = lookupNamed(g.typesPkg, apiTypeName)
+	}
+	if apiType == nil {
+		return nil, nil, fmt.Errorf("type %s not found in package %s or %s", apiTypeName, g.sdkPkg.Path(), g.typesPkg.Path())
+	}
+	if _, ok := apiType.Underlying().(*types.Struct); !ok {
+		return nil, nil, fmt.Errorf("%s is not a struct", apiTypeName)
+	}
+
+	return model, apiType, nil
+}
+
+func lookupNamed(pkg *types.Package, name string) *types.Named {
+	obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil
+	}
+
+	named, _ := types.Unalias(obj.Type()).(*types.Named)
+
+	return named
+}
+
+// isGeneratable returns whether conversion functions can be generated for the specified model type.
+// Models that customize their expansion or flattening are left to AutoFlex.
+func (g *generator) isGeneratable(model *types.Named) bool {
+	if model.Obj().Pkg() != g.pkg || model.TypeParams().Len() > 0 || model.TypeArgs().Len() > 0 {
+		return false
+	}
+
+	if _, ok := model.Underlying().(*types.Struct); !ok {
+		return false
+	}
+
+	methods := types.NewMethodSet(types.NewPointer(model))
+	for _, name := range []string{"Expand", "ExpandTo", "Flatten", "UnionMemberTypes"} {
+		if methods.Lookup(nil, name) != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// expander returns the function that expands the specified model to the specified AWS API type,
+// generating it (and any functions for nested models) if necessary.
+func (g *generator) expander(model, apiType *types.Named) *Func {
+	return g.function(true, model, apiType)
+}
+
+// flattener returns the function that flattens the specified AWS API type to the specified model,
+// generating it (and any functions for nested models) if necessary.
+func (g *generator) flattener(model, apiType *types.Named) *Func {
+	return g.function(false, model, apiType)
+}
+
+func (g *generator) function(expand bool, model, apiType *types.Named) *Func {
+	prefix := "flattenFrom"
+	if expand {
+		prefix = "expandTo"
+	}
+	funcName := prefix + apiType.Obj().Name()
+	key := model.Obj().Name() + "." + funcName
+
+	if f, ok := g.funcs[key]; ok {
+		return f
+	}
+
+	f := &Func{
+		Expand:    expand,
+		FuncName:  funcName,
+		ModelName: model.Obj().Name(),
+		APIType:   g.typeString(apiType),
+	}
+	// Register before generating fields to terminate recursion.
+	g.funcs[key] = f
+
+	g.imports["github.com/hashicorp/terraform-plugin-framework/diag"] = "diag"
+
+	modelFields := structFields(model.Underlying().(*types.Struct))
+	apiFields := structFields(apiType.Underlying().(*types.Struct))
+
+	if expand {
+		for _, from := range modelFields {
+			if slices.Contains(fwflex.DefaultIgnoredFieldNames, from.name) || from.name == mapBlockKeyFieldName {
+				continue
+			}
+
+			tag := from.tag.Get("autoflex")
+			if name, _ := parseTag(tag); name == "-" {
+				continue
+			}
+
+			to, ok := g.findField(from.name, modelFields, apiFields)
+			if !ok {
+				continue
+			}
+
+			f.Fields = append(f.Fields, Field{
+				Comment: fmt.Sprintf("%s -> %s.", from.name, to.name),
+				Code:    g.expandField(from, to, tag),
+			})
+		}
+	} else {
+		matched := make(map[string]bool)
+
+		for _, from := range apiFields {
+			if slices.Contains(fwflex.DefaultIgnoredFieldNames, from.name) {
+				continue
+			}
+
+			to, ok := g.findField(from.name, apiFields, modelFields)
+			if !ok {
+				continue
+			}
+			matched[to.name] = true
+
+			tag := to.tag.Get("autoflex")
+			if name, opts := parseTag(tag); name == "-" || opts.contains("noflatten") {
+				continue
+			}
+
+			f.Fields = append(f.Fields, Field{
+				Comment: fmt.Sprintf("%s -> %s.", from.name, to.name),
+				Code:    g.flattenField(from, to, tag),
+			})
+		}
+
+		// A model field that nothing is flattened to is left unchanged, which is almost always a bug
+		// (for example, an "ARN" field when the AWS API field is named "ConfigArn").
+		for _, to := range modelFields {
+			if matched[to.name] || slices.Contains(fwflex.DefaultIgnoredFieldNames, to.name) || slices.Contains(unsourcedFieldNames, to.name) || to.name == mapBlockKeyFieldName {
+				continue
+			}
+
+			if name, opts := parseTag(to.tag.Get("autoflex")); name == "-" || opts.contains("noflatten") {
+				continue
+			}
+
+			g.unmatched = append(g.unmatched, fmt.Sprintf("%s.%s (flattening from %s)", f.ModelName, to.name, apiType.Obj().Name()))
+		}
+	}
+
+	return f
+}
+
+// expandField returns the code that expands a model field to an AWS API field.
+func (g *generator) expandField(from, to structField, tag string) string {
+	src, dst := "m."+from.name, "apiObject."+to.name
+
+	fallback := func() string {
+		g.imports["github.com/hashicorp/terraform-provider-aws/internal/framework/flex"] = "fwflex"
+		return fmt.Sprintf(`diags.Append(fwflex.ExpandField(ctx, &%[1]s, &%[2]s, %[3]q)...)
+if diags.HasError() {
+	return diags
+}`, src, dst, tag)
+	}
+
+	// Legacy fields are left to AutoFlex.
+	if _, opts := parseTag(tag); opts.contains("legacy") {
+		return fallback()
+	}
+
+	knownValue := func(code string) string {
+		return fmt.Sprintf(`if !%[1]s.IsNull() && !%[1]s.IsUnknown() {
+	%[2]s
+}`, src, code)
+	}
+
+	fromKind, fromElem := classifyModelType(from.typ)
+	toKind, toElem := classifyAPIType(to.typ)
+
+	switch fromKind {
+	case modelString, modelARN, modelStringEnum:
+		switch toKind {
+		case apiString:
+			return knownValue(fmt.Sprintf("%s = %s.ValueString()", dst, src))
+		case apiStringPtr:
+			return knownValue(fmt.Sprintf("%s = %s.ValueStringPointer()", dst, src))
+		case apiEnum:
+			if fromKind == modelStringEnum && types.Identical(fromElem, toElem) {
+				return knownValue(fmt.Sprintf("%s = %s.ValueEnum()", dst, src))
+			}
+			return knownValue(fmt.Sprintf("%s = %s(%s.ValueString())", dst, g.typeString(toElem), src))
+		}
+
+	case modelInt64:
+		switch toKind {
+		case apiInt64:
+			return knownValue(fmt.Sprintf("%s = %s.ValueInt64()", dst, src))
+		case apiInt64Ptr:
+			return knownValue(fmt.Sprintf("%s = %s.ValueInt64Pointer()", dst, src))
+		case apiInt32:
+			return knownValue(fmt.Sprintf("%s = int32(%s.ValueInt64())", dst, src))
+		case apiInt32Ptr:
+			g.imports["github.com/hashicorp/terraform-provider-aws/internal/framework/flex"] = "fwflex"
+			return knownValue(fmt.Sprintf("%s = fwflex.Int32FromFrameworkInt64(ctx, %s)", dst, src))
+		}
+
+	case modelInt32:
+		switch toKind {
+		case apiInt32:
+			return knownValue(fmt.Sprintf("%s = %s.ValueInt32()", dst, src))
+		case apiInt32Ptr:
+			return knownValue(fmt.Sprintf("%s = %s.ValueInt32Pointer()", dst, src))
+		}
+
+	case modelBool:
+		switch toKind {
+		case apiBool:
+			return knownValue(fmt.Sprintf("%s = %s.ValueBool()", dst, src))
+		case apiBoolPtr:
+			return knownValue(fmt.Sprintf("%s = %s.ValueBoolPointer()", dst, src))
+		}
+
+	case modelFloat64:
+		switch toKind {
+		case apiFloat64:
+			return knownValue(fmt.Sprintf("%s = %s.ValueFloat64()", dst, src))
+		case apiFloat64Ptr:
+			return knownValue(fmt.Sprintf("%s = %s.ValueFloat64Pointer()", dst, src))
+		}
+
+	case modelListNested, modelSetNested:
+		nested, ok := fromElem.(*types.Named)
+		if !ok || !g.isGeneratable(nested) {
+			break
+		}
+
+		switch toKind {
+		case apiStruct, apiStructPtr:
+			funcName := g.expander(nested, toElem).FuncName
+			apiType := g.typeString(toElem)
+			var code string
+			if toKind == apiStruct {
+				code = fmt.Sprintf(`%[1]s = %[2]s{}
+if v != nil {
+	diags.Append(v.%[3]s(ctx, &%[1]s)...)
+	if diags.HasError() {
+		return diags
+	}
+}`, dst, apiType, funcName)
+			} else {
+				code = fmt.Sprintf(`%[1]s = &%[2]s{}
+if v != nil {
+	diags.Append(v.%[3]s(ctx, %[1]s)...)
+	if diags.HasError() {
+		return diags
+	}
+}`, dst, apiType, funcName)
+			}
+			return knownValue(fmt.Sprintf(`v, d := %[1]s.ToPtr(ctx)
+diags.Append(d...)
+if diags.HasError() {
+	return diags
+}
+%[2]s`, src, code))
+
+		case apiStructSlice, apiStructPtrSlice:
+			funcName := g.expander(nested, toElem).FuncName
+			apiType := g.typeString(toElem)
+			var code string
+			if toKind == apiStructSlice {
+				code = fmt.Sprintf(`%[1]s = make([]%[2]s, len(s))
+for i, v := range s {
+	diags.Append(v.%[3]s(ctx, &%[1]s[i])...)
+	if diags.HasError() {
+		return diags
+	}
+}`, dst, apiType, funcName)
+			} else {
+				code = fmt.Sprintf(`%[1]s = make([]*%[2]s, len(s))
+for i, v := range s {
+	%[1]s[i] = &%[2]s{}
+	diags.Append(v.%[3]s(ctx, %[1]s[i])...)
+	if diags.HasError() {
+		return diags
+	}
+}`, dst, apiType, funcName)
+			}
+			return knownValue(fmt.Sprintf(`s, d := %[1]s.ToSlice(ctx)
+diags.Append(d...)
+if diags.HasError() {
+	return diags
+}
+%[2]s`, src, code))
+		}
+	}
+
+	return fallback()
+}
+
+// flattenField returns the code that flattens an AWS API field to a model field.
+func (g *generator) flattenField(from, to structField, tag string) string {
+	src, dst := "apiObject."+from.name, "m."+to.name
+
+	fallback := func() string {
+		g.imports["github.com/hashicorp/terraform-provider-aws/internal/framework/flex"] = "fwflex"
+		return fmt.Sprintf(`diags.Append(fwflex.FlattenField(ctx, &%[1]s, &%[2]s, %[3]q)...)
+if diags.HasError() {
+	return diags
+}`, src, dst, tag)
+	}
+
+	// Legacy and omitempty fields are left to AutoFlex.
+	if _, opts := parseTag(tag); opts.contains("legacy") || opts.contains("omitempty") {
+		return fallback()
+	}
+
+	fromKind, fromElem := classifyAPIType(from.typ)
+	toKind, toElem := classifyModelType(to.typ)
+
+	frameworkTypes := func() {
+		g.imports["github.com/hashicorp/terraform-plugin-framework/types"] = "types"
+	}
+	fwtypes := func() {
+		g.imports[fwtypesPackagePath] = "fwtypes"
+	}
+	stringEnum := func(cond, value string) string {
+		fwtypes()
+		return fmt.Sprintf(`if %[2]s {
+	%[1]s = fwtypes.StringEnumValue(%[3]s)
+} else {
+	%[1]s = fwtypes.StringEnumNull[%[4]s]()
+}`, dst, cond, value, g.typeString(toElem))
+	}
+
+	switch fromKind {
+	case apiString, apiEnum:
+		value := src
+		if fromKind == apiEnum {
+			value = fmt.Sprintf("string(%s)", src)
+		}
+
+		switch toKind {
+		case modelString:
+			frameworkTypes()
+			return fmt.Sprintf("%s = types.StringValue(%s)", dst, value)
+		case modelARN:
+			fwtypes()
+			return fmt.Sprintf("%s = fwtypes.ARNValue(%s)", dst, value)
+		case modelStringEnum:
+			value := src
+			if fromKind != apiEnum || !types.Identical(fromElem, toElem) {
+				value = fmt.Sprintf("%s(%s)", g.typeString(toElem), src)
+			}
+			return stringEnum(fmt.Sprintf(`%s != ""`, src), value)
+		}
+
+	case apiStringPtr:
+		switch toKind {
+		case modelString:
+			frameworkTypes()
+			return fmt.Sprintf("%s = types.StringPointerValue(%s)", dst, src)
+		case modelARN:
+			fwtypes()
+			return fmt.Sprintf(`if %[2]s != nil {
+	%[1]s = fwtypes.ARNValue(*%[2]s)
+} else {
+	%[1]s = fwtypes.ARNNull()
+}`, dst, src)
+		case modelStringEnum:
+			return stringEnum(fmt.Sprintf(`%[1]s != nil && *%[1]s != ""`, src), fmt.Sprintf("%s(*%s)", g.typeString(toElem), src))
+		}
+
+	case apiInt64:
+		switch toKind {
+		case modelInt64:
+			frameworkTypes()
+			return fmt.Sprintf("%s = types.Int64Value(%s)", dst, src)
+		}
+
+	case apiInt64Ptr:
+		switch toKind {
+		case modelInt64:
+			frameworkTypes()
+			return fmt.Sprintf("%s = types.Int64PointerValue(%s)", dst, src)
+		}
+
+	case apiInt32:
+		switch toKind {
+		case modelInt64:
+			g.imports["github.com/hashicorp/terraform-provider-aws/internal/framework/flex"] = "fwflex"
+			return fmt.Sprintf("%s = fwflex.Int32ValueToFrameworkInt64(ctx, %s)", dst, src)
+		case modelInt32:
+			frameworkTypes()
+			return fmt.Sprintf("%s = types.Int32Value(%s)", dst, src)
+		}
+
+	case apiInt32Ptr:
+		switch toKind {
+		case modelInt64:
+			g.imports["github.com/hashicorp/terraform-provider-aws/internal/framework/flex"] = "fwflex"
+			return fmt.Sprintf("%s = fwflex.Int32ToFrameworkInt64(ctx, %s)", dst, src)
+		case modelInt32:
+			frameworkTypes()
+			return fmt.Sprintf("%s = types.Int32PointerValue(%s)", dst, src)
+		}
+
+	case apiBool:
+		switch toKind {
+		case modelBool:
+			frameworkTypes()
+			return fmt.Sprintf("%s = types.BoolValue(%s)", dst, src)
+		}
+
+	case apiBoolPtr:
+		switch toKind {
+		case modelBool:
+			frameworkTypes()
+			return fmt.Sprintf("%s = types.BoolPointerValue(%s)", dst, src)
+		}
+
+	case apiFloat64:
+		switch toKind {
+		case modelFloat64:
+			frameworkTypes()
+			return fmt.Sprintf("%s = types.Float64Value(%s)", dst, src)
+		}
+
+	case apiFloat64Ptr:
+		switch toKind {
+		case modelFloat64:
+			frameworkTypes()
+			return fmt.Sprintf("%s = types.Float64PointerValue(%s)", dst, src)
+		}
+
+	case apiStruct, apiStructPtr, apiStructSlice, apiStructPtrSlice:
+		nested, ok := toElem.(*types.Named)
+		if !ok || !g.isGeneratable(nested) {
+			break
+		}
+
+		var collection string
+		switch toKind {
+		case modelListNested:
+			collection = "List"
+		case modelSetNested:
+			collection = "Set"
+		default:
+			return fallback()
+		}
+
+		fwtypes()
+		funcName := g.flattener(nested, fromElem).FuncName
+		modelType := g.typeString(nested)
+
+		switch fromKind {
+		case apiStruct, apiStructPtr:
+			ptr := src
+			if fromKind == apiStruct {
+				ptr = "&" + src
+			}
+			code := fmt.Sprintf(`v := new(%[3]s)
+diags.Append(v.%[4]s(ctx, %[2]s)...)
+if diags.HasError() {
+	return diags
+}
+value, d := fwtypes.New%[5]sNestedObjectValueOfPtr(ctx, v)
+diags.Append(d...)
+if diags.HasError() {
+	return diags
+}
+%[1]s = value`, dst, ptr, modelType, funcName, collection)
+			if fromKind == apiStruct {
+				return fmt.Sprintf("{\n%s\n}", code)
+			}
+			return fmt.Sprintf(`if %[2]s == nil {
+	%[1]s = fwtypes.New%[3]sNestedObjectValueOfNull[%[4]s](ctx)
+} else {
+	%[5]s
+}`, dst, src, collection, modelType, code)
+
+		case apiStructSlice, apiStructPtrSlice:
+			elem := fmt.Sprintf("&%s[i]", src)
+			if fromKind == apiStructPtrSlice {
+				elem = fmt.Sprintf("%s[i]", src)
+			}
+			return fmt.Sprintf(`if %[2]s == nil {
+	%[1]s = fwtypes.New%[3]sNestedObjectValueOfNull[%[4]s](ctx)
+} else {
+	s := make([]*%[4]s, len(%[2]s))
+	for i := range %[2]s {
+		s[i] = new(%[4]s)
+		diags.Append(s[i].%[5]s(ctx, %[6]s)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	value, d := fwtypes.New%[3]sNestedObjectValueOfSlice(ctx, s, nil)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	%[1]s = value
+}`, dst, src, collection, modelType, funcName, elem)
+		}
+	}
+
+	return fallback()
+}
+
+// typeString returns the Go source representation of the specified type, recording any required imports.
+func (g *generator) typeString(typ types.Type) string {
+	return types.TypeString(typ, func(pkg *types.Package) string {
+		if pkg == g.pkg {
+			return ""
+		}
+
+		alias := pkg.Name()
+		switch pkg.Path() {
+		case g.typesPkg.Path():
+			alias = "awstypes"
+		case fwtypesPackagePath:
+			alias = "fwtypes"
+		}
+		g.imports[pkg.Path()] = alias
+
+		return alias
+	})
+}
+
+type structField struct {
+	name string
+	typ  types.Type
+	tag  reflect.StructTag
+}
+
+// structFields returns a struct's exported fields, including those of any embedded structs.
+// See tfreflect.ExportedStructFields.
+func structFields(s *types.Struct) []structField {
+	var fields []structField
+
+	for i := range s.NumFields() {
+		v := s.Field(i)
+
+		if v.Embedded() {
+			if s, ok := v.Type().Underlying().(*types.Struct); ok {
+				fields = append(fields, structFields(s)...)
+			}
+			continue
+		}
+
+		if !v.Exported() {
+			continue
+		}
+
+		fields = append(fields, structField{
+			name: v.Name(),
+			typ:  v.Type(),
+			tag:  reflect.StructTag(s.Tag(i)),
+		})
+	}
+
+	return fields
+}
+
+func fieldByName(fields []structField, name string) (structField, bool) {
+	i := slices.IndexFunc(fields, func(v structField) bool {
+		return v.name == name
+	})
+	if i == -1 {
+		return structField{}, false
+	}
+
+	return fields[i], true
+}
+
+// findField returns the field corresponding to the named field.
+// The rules are those of AutoFlex's fuzzy field matching without field name prefixes or suffixes.
+func (g *generator) findField(name string, fromFields, toFields []structField) (structField, bool) {
+	existsInFrom := func(name string) bool {
+		_, ok := fieldByName(fromFields, name)
+		return ok
+	}
+
+	// Exact match.
+	if v, ok := fieldByName(toFields, name); ok {
+		return v, true
+	}
+
+	// Case insensitive match.
+	for _, v := range toFields {
+		if slices.Contains(fwflex.DefaultIgnoredFieldNames, v.name) {
+			continue
+		}
+		if strings.EqualFold(name, v.name) && !existsInFrom(v.name) {
+			return v, true
+		}
+	}
+
+	// Singular/plural match.
+	if nameTo := g.plural.Plural(name); g.plural.IsSingular(name) && !existsInFrom(nameTo) {
+		if v, ok := fieldByName(toFields, nameTo); ok {
+			return v, true
+		}
+	}
+
+	if nameTo := g.plural.Singular(name); g.plural.IsPlural(name) && !existsInFrom(nameTo) {
+		if v, ok := fieldByName(toFields, nameTo); ok {
+			return v, true
+		}
+	}
+
+	return structField{}, false
+}
+
+type modelKind int
+
+const (
+	modelOther modelKind = iota
+	modelString
+	modelARN
+	modelStringEnum
+	modelInt64
+	modelInt32
+	modelBool
+	modelFloat64
+	modelListNested
+	modelSetNested
+)
+
+// classifyModelType classifies a model field's type.
+// For generic types the type argument is also returned.
+func classifyModelType(typ types.Type) (modelKind, types.Type) {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok {
+		return modelOther, nil
+	}
+
+	obj := named.Origin().Obj()
+	if obj.Pkg() == nil {
+		return modelOther, nil
+	}
+
+	var typeArg types.Type
+	if args := named.TypeArgs(); args.Len() == 1 {
+		typeArg = types.Unalias(args.At(0))
+	}
+
+	switch obj.Pkg().Path() {
+	case basetypesPackagePath:
+		switch obj.Name() {
+		case "StringValue":
+			return modelString, nil
+		case "Int64Value":
+			return modelInt64, nil
+		case "Int32Value":
+			return modelInt32, nil
+		case "BoolValue":
+			return modelBool, nil
+		case "Float64Value":
+			return modelFloat64, nil
+		}
+
+	case fwtypesPackagePath:
+		switch obj.Name() {
+		case "ARN":
+			return modelARN, nil
+		case "StringEnum":
+			return modelStringEnum, typeArg
+		case "ListNestedObjectValueOf":
+			return modelListNested, typeArg
+		case "SetNestedObjectValueOf":
+			return modelSetNested, typeArg
+		}
+	}
+
+	return modelOther, nil
+}
+
+type apiKind int
+
+const (
+	apiOther apiKind = iota
+	apiString
+	apiStringPtr
+	apiEnum
+	apiInt64
+	apiInt64Ptr
+	apiInt32
+	apiInt32Ptr
+	apiBool
+	apiBoolPtr
+	apiFloat64
+	apiFloat64Ptr
+	apiStruct
+	apiStructPtr
+	apiStructSlice
+	apiStructPtrSlice
+)
+
+// classifyAPIType classifies an AWS API field's type.
+// For enumerations and (pointers to or slices of) structs the named type is also returned.
+func classifyAPIType(typ types.Type) (apiKind, *types.Named) {
+	basic := func(typ types.Type, kinds map[types.BasicKind]apiKind) apiKind {
+		if v, ok := typ.(*types.Basic); ok {
+			if kind, ok := kinds[v.Kind()]; ok {
+				return kind
+			}
+		}
+		return apiOther
+	}
+	namedStruct := func(typ types.Type) *types.Named {
+		if v, ok := types.Unalias(typ).(*types.Named); ok {
+			if _, ok := v.Underlying().(*types.Struct); ok {
+				return v
+			}
+		}
+		return nil
+	}
+
+	typ = types.Unalias(typ)
+
+	switch typ := typ.(type) {
+	case *types.Basic:
+		return basic(typ, map[types.BasicKind]apiKind{
+			types.String:  apiString,
+			types.Int64:   apiInt64,
+			types.Int32:   apiInt32,
+			types.Bool:    apiBool,
+			types.Float64: apiFloat64,
+		}), nil
+
+	case *types.Named:
+		if v, ok := typ.Underlying().(*types.Basic); ok && v.Kind() == types.String {
+			return apiEnum, typ
+		}
+		if v := namedStruct(typ); v != nil {
+			return apiStruct, v
+		}
+
+	case *types.Pointer:
+		if v := namedStruct(typ.Elem()); v != nil {
+			return apiStructPtr, v
+		}
+		return basic(types.Unalias(typ.Elem()), map[types.BasicKind]apiKind{
+			types.String:  apiStringPtr,
+			types.Int64:   apiInt64Ptr,
+			types.Int32:   apiInt32Ptr,
+			types.Bool:    apiBoolPtr,
+			types.Float64: apiFloat64Ptr,
+		}), nil
+
+	case *types.Slice:
+		if v := namedStruct(typ.Elem()); v != nil {
+			return apiStructSlice, v
+		}
+		if v, ok := types.Unalias(typ.Elem()).(*types.Pointer); ok {
+			if v := namedStruct(v.Elem()); v != nil {
+				return apiStructPtrSlice, v
+			}
+		}
+	}
+
+	return apiOther, nil
+}
+
+type tagOptions string
+
+// parseTag splits an `autoflex` struct tag into its name and comma-separated options.
+// See flex.parseTag.
+func parseTag(tag string) (string, tagOptions) {
+	tag, opt, _ := strings.Cut(tag, ",")
+	return tag, tagOptions(opt)
+}
+
+func (o tagOptions) contains(optionName string) bool {
+	return slices.Contains(strings.Split(string(o), ","), optionName)
+}
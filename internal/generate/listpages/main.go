@@ -13,11 +13,12 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
-	"html/template"
 	"log"
 	"os"
 	"slices"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"golang.org/x/tools/go/packages"
@@ -36,6 +37,10 @@ var (
 	paginator       = flag.String("Paginator", "NextToken", "name of the pagination token field")
 	export          = flag.Bool("Export", false, "whether to export the list functions")
 	v2Suffix        = flag.Bool("V2Suffix", false, "whether to append a V2 suffix to the list functions")
+	iterator        = flag.Bool("Iterator", false, "whether to generate iterators over page items instead of callback-style list functions")
+	items           = flag.String("Items", "", "name of the output field containing page items (iterators only)")
+	retryErrorCodes = flag.String("RetryErrorCodes", "", "comma-separated list of AWS error codes on which to retry a page request (iterators only)")
+	retryTimeout    = flag.Duration("RetryTimeout", 2*time.Minute, "how long to retry a page request (iterators only)")
 )
 
 func usage() {
@@ -55,6 +60,10 @@ func main() {
 		log.Fatal("both InputPaginator and OutputPaginator must be specified if one is")
 	}
 
+	if !*iterator && (*items != "" || *retryErrorCodes != "") {
+		log.Fatal("Items and RetryErrorCodes can only be specified with Iterator")
+	}
+
 	if *inputPaginator == "" {
 		*inputPaginator = *paginator
 	}
@@ -81,25 +90,44 @@ func main() {
 	slices.Sort(functions)
 
 	tmpl := template.Must(template.New("function").Parse(functionTemplate))
+	if *iterator {
+		tmpl = template.Must(template.New("iterator").Parse(iteratorTemplate))
+	}
+
+	var codes []string
+	if *retryErrorCodes != "" {
+		codes = strings.Split(*retryErrorCodes, ",")
+	}
 
 	g := Generator{
 		tmpl:            tmpl,
 		inputPaginator:  *inputPaginator,
 		outputPaginator: *outputPaginator,
+		items:           *items,
+		retryErrorCodes: codes,
+		retryTimeout:    *retryTimeout,
 	}
 
 	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%[1]s", awsService)
 
 	g.parsePackage(sourcePackage)
 
+	funcSpecs := make([]FuncSpec, 0, len(functions))
+	for _, functionName := range functions {
+		funcSpecs = append(funcSpecs, g.newFuncSpec(functionName, awsService, *export, *iterator))
+	}
+
 	g.printHeader(HeaderInfo{
 		Parameters:         strings.Join(os.Args[1:], " "),
 		DestinationPackage: servicePackage,
 		SourcePackage:      sourcePackage,
+		Iterator:           *iterator,
+		Retry:              len(codes) > 0,
+		TypesPackage:       slices.ContainsFunc(funcSpecs, func(v FuncSpec) bool { return strings.HasPrefix(strings.TrimLeft(v.ItemType, "*"), "awstypes.") }),
 	})
 
-	for _, functionName := range functions {
-		g.generateFunction(functionName, awsService, *export)
+	for _, funcSpec := range funcSpecs {
+		g.generateFunction(funcSpec)
 	}
 
 	src := g.format()
@@ -114,6 +142,9 @@ type HeaderInfo struct {
 	Parameters         string
 	DestinationPackage string
 	SourcePackage      string
+	Iterator           bool
+	Retry              bool
+	TypesPackage       bool
 }
 
 type Generator struct {
@@ -122,6 +153,9 @@ type Generator struct {
 	tmpl            *template.Template
 	inputPaginator  string
 	outputPaginator string
+	items           string
+	retryErrorCodes []string
+	retryTimeout    time.Duration
 }
 
 func (g *Generator) Printf(format string, args ...any) {
@@ -178,12 +212,17 @@ type FuncSpec struct {
 	AWSName         string
 	AWSService      string
 	ParamType       string
+	InputType       string
 	ResultType      string
 	InputPaginator  string
 	OutputPaginator string
+	Items           string
+	ItemType        string
+	RetryErrorCodes []string
+	RetryTimeout    string
 }
 
-func (g *Generator) generateFunction(functionName, awsService string, export bool) {
+func (g *Generator) newFuncSpec(functionName, awsService string, export, iterator bool) FuncSpec {
 	var function *ast.FuncDecl
 
 	for _, file := range g.pkg.files {
@@ -222,9 +261,100 @@ func (g *Generator) generateFunction(functionName, awsService string, export boo
 		OutputPaginator: g.outputPaginator,
 	}
 
+	if iterator {
+		funcSpec.InputType = strings.TrimPrefix(funcSpec.ParamType, "*")
+		funcSpec.Items, funcSpec.ItemType = g.itemsField(funcSpec.ResultType)
+		funcSpec.RetryErrorCodes = g.retryErrorCodes
+		funcSpec.RetryTimeout = durationExpr(g.retryTimeout)
+	}
+
+	return funcSpec
+}
+
+func (g *Generator) generateFunction(funcSpec FuncSpec) {
 	err := g.tmpl.Execute(&g.buf, funcSpec)
 	if err != nil {
-		log.Fatalf("error writing function \"%s\": %s", functionName, err)
+		log.Fatalf("error writing function \"%s\": %s", funcSpec.AWSName, err)
+	}
+}
+
+// itemsField returns the name and element type of the slice field containing page items in the specified output type.
+// If no items field name was specified, the output type must have exactly one slice field.
+func (g *Generator) itemsField(resultType string) (string, string) {
+	typeName := strings.TrimPrefix(resultType, fmt.Sprintf("*%s.", g.pkg.name))
+
+	var structType *ast.StructType
+
+	for _, file := range g.pkg.files {
+		if file.file == nil {
+			continue
+		}
+		ast.Inspect(file.file, func(n ast.Node) bool {
+			if typeSpec, ok := n.(*ast.TypeSpec); ok && typeSpec.Name.Name == typeName {
+				structType, _ = typeSpec.Type.(*ast.StructType)
+				return false
+			}
+			return structType == nil
+		})
+		if structType != nil {
+			break
+		}
+	}
+
+	if structType == nil {
+		log.Fatalf("struct type \"%s\" not found", typeName)
+	}
+
+	var name, itemType string
+
+	for _, field := range structType.Fields.List {
+		arrayType, ok := field.Type.(*ast.ArrayType)
+		if !ok || arrayType.Len != nil {
+			continue
+		}
+
+		for _, ident := range field.Names {
+			if g.items != "" && ident.Name != g.items {
+				continue
+			}
+			if name != "" {
+				log.Fatalf("struct type \"%s\" has multiple slice fields, specify Items", typeName)
+			}
+			name, itemType = ident.Name, g.expandItemTypeExpr(arrayType.Elt)
+		}
+	}
+
+	if name == "" {
+		log.Fatalf("struct type \"%s\" has no items field", typeName)
+	}
+
+	return name, itemType
+}
+
+func (g *Generator) expandItemTypeExpr(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.StarExpr:
+		return fmt.Sprintf("*%s", g.expandItemTypeExpr(v.X))
+	case *ast.SelectorExpr:
+		if ident, ok := v.X.(*ast.Ident); ok && ident.Name == "types" {
+			return fmt.Sprintf("awstypes.%s", v.Sel.Name)
+		}
+	}
+
+	log.Fatalf("Unexpected item type expression: (%[1]T) %[1]v", expr)
+	return ""
+}
+
+func durationExpr(d time.Duration) string {
+	switch {
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("time.Duration(%d)", d)
 	}
 }
 
@@ -258,6 +388,9 @@ var headerTemplate string
 //go:embed v2/function.gtpl
 var functionTemplate string
 
+//go:embed v2/iterator.gtpl
+var iteratorTemplate string
+
 func (g *Generator) format() []byte {
 	src, err := format.Source(g.buf.Bytes())
 	if err != nil {
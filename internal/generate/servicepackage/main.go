@@ -75,6 +75,14 @@ func main() {
 			g.Fatalf("%s", err.Error())
 		}
 
+		for _, resources := range []map[string]ResourceDatum{v.frameworkResources, v.sdkResources} {
+			for typeName, resource := range resources {
+				if resource.arnFormat != "" && len(resource.IdentityAttributes) > 0 && resource.ParameterizedARNFormat() == "" {
+					g.Warnf("%s: ARN format %q does not correspond to the identity attributes, import by ARN is not supported", typeName, resource.arnFormat)
+				}
+			}
+		}
+
 		for _, resource := range v.frameworkResources {
 			if resource.IsGlobal {
 				if resource.isARNFormatGlobal == arnFormatStateUnset {
@@ -178,6 +186,7 @@ type ResourceDatum struct {
 	ARNIdentity                       bool
 	arnAttribute                      string
 	isARNFormatGlobal                 arnFormatState
+	arnFormat                         string
 	SingletonIdentity                 bool
 	MutableIdentity                   bool
 	WrappedImport                     bool
@@ -188,12 +197,42 @@ type ResourceDatum struct {
 	SetIDAttribute                    bool
 	HasV6_0SDKv2Fix                   bool
 	HasIdentityFix                    bool
+	IAMCreateActions                  []string
+	IAMUpdateActions                  []string
+	IAMDeleteActions                  []string
+	CreationPending                   bool
 }
 
 func (r ResourceDatum) IsARNFormatGlobal() bool {
 	return r.isARNFormatGlobal == arnFormatStateGlobal
 }
 
+var arnFormatPlaceholder = regexache.MustCompile(`\{([^}]+)\}`)
+
+// ParameterizedARNFormat returns the ARN format of a resource type with a parameterized identity,
+// if each identity attribute appears in the ARN format and each placeholder in the ARN format is an identity attribute.
+func (r ResourceDatum) ParameterizedARNFormat() string {
+	if r.arnFormat == "" || len(r.IdentityAttributes) == 0 {
+		return ""
+	}
+
+	placeholders := make(map[string]bool)
+	for _, m := range arnFormatPlaceholder.FindAllStringSubmatch(r.arnFormat, -1) {
+		placeholders[namesgen.ConstOrQuote(m[1])] = true
+	}
+	for _, attr := range r.IdentityAttributes {
+		if !placeholders[attr.Name] && !attr.Optional {
+			return ""
+		}
+		delete(placeholders, attr.Name)
+	}
+	if len(placeholders) > 0 {
+		return ""
+	}
+
+	return r.arnFormat
+}
+
 type identityAttribute struct {
 	Name                  string
 	Optional              bool
@@ -225,6 +264,10 @@ func (r ResourceDatum) HasIdentityDuplicateAttrs() bool {
 	return len(r.IdentityDuplicateAttrs) > 0
 }
 
+func (r ResourceDatum) HasIAMActions() bool {
+	return len(r.IAMCreateActions) > 0 || len(r.IAMUpdateActions) > 0 || len(r.IAMDeleteActions) > 0
+}
+
 type ServiceDatum struct {
 	GenerateClient          bool
 	IsGlobal                bool // Is the service global?
@@ -374,6 +417,20 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					d.TagsResourceType = attr
 				}
 
+			case "IAMActions":
+				if attr, ok := args.Keyword["create"]; ok {
+					d.IAMCreateActions = strings.Split(attr, ";")
+				}
+				if attr, ok := args.Keyword["update"]; ok {
+					d.IAMUpdateActions = strings.Split(attr, ";")
+				}
+				if attr, ok := args.Keyword["delete"]; ok {
+					d.IAMDeleteActions = strings.Split(attr, ";")
+				}
+				if !d.HasIAMActions() {
+					v.errs = append(v.errs, fmt.Errorf("no IAM actions: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+
 			case "IdentityAttribute":
 				d.WrappedImport = true
 				if len(args.Positional) == 0 {
@@ -436,6 +493,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			case "ArnFormat":
 				args := common.ParseArgs(m[3])
 
+				if len(args.Positional) > 0 {
+					d.arnFormat = args.Positional[0]
+				}
+
 				if attr, ok := args.Keyword["global"]; ok {
 					if b, err := strconv.ParseBool(attr); err != nil {
 						v.errs = append(v.errs, fmt.Errorf("invalid global value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -494,6 +555,9 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 
 			case "IdentityFix":
 				d.HasIdentityFix = true
+
+			case "CreationPending":
+				d.CreationPending = true
 			}
 		}
 	}
@@ -648,9 +712,9 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkResources[typeName] = d
 				}
 
-			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport":
+			case "IdentityAttribute", "ArnFormat", "ArnIdentity", "CreationPending", "IAMActions", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport":
 				// Handled above.
-			case "ArnFormat", "IdAttrFormat", "NoImport", "Testing":
+			case "IdAttrFormat", "NoImport", "Testing":
 				// Ignored.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
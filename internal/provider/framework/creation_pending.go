@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// creationPendingPrivateStateKey is the private state key used to mark a resource whose creation is pending.
+const creationPendingPrivateStateKey = "creation_pending"
+
+type creationPendingPrivateState struct {
+	ID     string `json:"id"`
+	Failed bool   `json:"failed,omitempty"` // Set when resuming the wait found that creation failed.
+}
+
+// resourceCreateWithCreationPending wraps a resource's Create handler.
+// If a wait for the new resource to become ready is interrupted after the resource's identifier is known,
+// the resource is read and saved to state untainted and marked in private state as pending creation.
+func resourceCreateWithCreationPending(f innerFunc[resource.CreateRequest, resource.CreateResponse], read innerFunc[resource.ReadRequest, resource.ReadResponse]) innerFunc[resource.CreateRequest, resource.CreateResponse] {
+	return func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+		ctx, recorder := retry.WithInterruptedWaitRecorder(ctx)
+
+		f(ctx, request, response)
+
+		if !response.Diagnostics.HasError() {
+			return
+		}
+
+		waitErr := recorder.Err()
+		if waitErr == nil || response.State.Raw.IsNull() {
+			return
+		}
+
+		var id types.String
+		if diags := response.State.GetAttribute(ctx, path.Root(names.AttrID), &id); diags.HasError() || id.IsNull() || id.IsUnknown() {
+			return
+		}
+
+		state, diags := creationPendingState(ctx, request.Plan, response.State)
+		if diags.HasError() {
+			return
+		}
+
+		v, err := json.Marshal(creationPendingPrivateState{ID: id.ValueString()})
+		if err != nil {
+			return
+		}
+
+		if diags := response.Private.SetKey(ctx, creationPendingPrivateStateKey, v); diags.HasError() {
+			return
+		}
+
+		response.Diagnostics = errorsToWarnings(response.Diagnostics)
+
+		// Populate any attributes that are already known.
+		readRequest := resource.ReadRequest{
+			State:        state,
+			Private:      response.Private,
+			ProviderMeta: request.ProviderMeta,
+		}
+		readResponse := resource.ReadResponse{
+			State:   state,
+			Private: response.Private,
+		}
+		read(ctx, readRequest, &readResponse)
+		response.Diagnostics.Append(errorsToWarnings(readResponse.Diagnostics)...)
+		if !readResponse.Diagnostics.HasError() && !readResponse.State.Raw.IsNull() {
+			state = readResponse.State
+		}
+
+		response.State = state
+		response.Diagnostics.AddWarning(
+			"Resource creation pending",
+			fmt.Sprintf("Waiting for %s to become ready was interrupted: %s\n\n"+
+				"The resource has been saved to state. The next plan or apply will resume waiting for creation to complete. "+
+				"Attributes that are only known once creation completes are empty until then, including in resources that reference them in this run.", id.ValueString(), waitErr),
+		)
+	}
+}
+
+// resourceReadWithCreationPending wraps a resource's Read handler.
+// If the resource is marked in private state as pending creation, the resource's Read handler is signaled to resume waiting.
+// The marker is removed once the resource has been read without the wait being interrupted.
+// If the wait ends in an unexpected state, creation has failed and the resource is marked for replacement.
+func resourceReadWithCreationPending(f innerFunc[resource.ReadRequest, resource.ReadResponse]) innerFunc[resource.ReadRequest, resource.ReadResponse] {
+	return func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+		if request.Private == nil {
+			f(ctx, request, response)
+			return
+		}
+
+		v, diags := request.Private.GetKey(ctx, creationPendingPrivateStateKey)
+		if diags.HasError() || len(v) == 0 {
+			f(ctx, request, response)
+			return
+		}
+
+		var data creationPendingPrivateState
+		if err := json.Unmarshal(v, &data); err != nil {
+			f(ctx, request, response)
+			return
+		}
+
+		ctx = retry.WithCreationPending(ctx)
+		ctx, recorder := retry.WithInterruptedWaitRecorder(ctx)
+
+		f(ctx, request, response)
+
+		if waitErr := recorder.Err(); waitErr != nil {
+			response.Diagnostics = errorsToWarnings(response.Diagnostics)
+			response.Diagnostics.AddWarning(
+				"Resource creation pending",
+				fmt.Sprintf("Waiting for %s to become ready was interrupted: %s\n\n"+
+					"The next plan or apply will resume waiting for creation to complete.", data.ID, waitErr),
+			)
+
+			return
+		}
+
+		if err := recorder.UnexpectedStateErr(); err != nil && response.Diagnostics.HasError() {
+			data.Failed = true
+			v, err := json.Marshal(data)
+			if err != nil {
+				return
+			}
+
+			response.Diagnostics = errorsToWarnings(response.Diagnostics)
+			response.Diagnostics.Append(response.Private.SetKey(ctx, creationPendingPrivateStateKey, v)...)
+			response.Diagnostics.AddWarning(
+				"Resource creation failed",
+				fmt.Sprintf("%s did not become ready: %s\n\n"+
+					"The next plan or apply will replace the resource.", data.ID, err),
+			)
+
+			return
+		}
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(response.Private.SetKey(ctx, creationPendingPrivateStateKey, nil)...)
+	}
+}
+
+// resourceModifyPlanWithCreationPending wraps a resource's ModifyPlan handler.
+// A resource can't be tainted after it has been saved to state, so the replacement of a resource
+// whose creation is known to have failed is forced by planning a change to its identifier, which requires replacement.
+func resourceModifyPlanWithCreationPending(f innerFunc[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) innerFunc[resource.ModifyPlanRequest, resource.ModifyPlanResponse] {
+	return func(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+		f(ctx, request, response)
+
+		if response.Diagnostics.HasError() || request.Private == nil || request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+			return
+		}
+
+		v, diags := request.Private.GetKey(ctx, creationPendingPrivateStateKey)
+		if diags.HasError() || len(v) == 0 {
+			return
+		}
+
+		var data creationPendingPrivateState
+		if err := json.Unmarshal(v, &data); err != nil || !data.Failed {
+			return
+		}
+
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrID), types.StringUnknown())...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrID))
+		response.Diagnostics.AddWarning(
+			"Resource creation failed",
+			fmt.Sprintf("Creation of %s failed after it was saved to state. The resource must be replaced.", data.ID),
+		)
+	}
+}
+
+// creationPendingState returns the state to save for a resource whose creation is pending.
+// Terraform requires that the state of a successfully created resource is consistent with the planned state,
+// so the planned state is used with any unknown values set to null, overlaid with any known top-level attribute values set by the resource.
+func creationPendingState(ctx context.Context, plan tfsdk.Plan, current tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := tftypes.Transform(plan.Raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+
+		return v, nil
+	})
+	if err != nil {
+		diags.AddError("Building pending state", err.Error())
+		return tfsdk.State{}, diags
+	}
+
+	state := tfsdk.State{
+		Schema: current.Schema,
+		Raw:    raw,
+	}
+
+	for name := range current.Schema.GetAttributes() {
+		var v attr.Value
+		diags.Append(current.GetAttribute(ctx, path.Root(name), &v)...)
+		if diags.HasError() {
+			return tfsdk.State{}, diags
+		}
+
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		diags.Append(state.SetAttribute(ctx, path.Root(name), v)...)
+		if diags.HasError() {
+			return tfsdk.State{}, diags
+		}
+	}
+
+	return state, diags
+}
+
+// errorsToWarnings returns the specified diagnostics with any errors converted to warnings.
+func errorsToWarnings(diags diag.Diagnostics) diag.Diagnostics {
+	var warnings diag.Diagnostics
+
+	for _, d := range diags {
+		if d.Severity() != diag.SeverityError {
+			warnings.Append(d)
+			continue
+		}
+
+		if v, ok := d.(diag.DiagnosticWithPath); ok {
+			warnings.AddAttributeWarning(v.Path(), d.Summary(), d.Detail())
+		} else {
+			warnings.AddWarning(d.Summary(), d.Detail())
+		}
+	}
+
+	return warnings
+}
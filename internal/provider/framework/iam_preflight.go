@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type resourceIAMPreflightInterceptor struct {
+	iamActions inttypes.ServicePackageResourceIAMActions
+}
+
+func (r resourceIAMPreflightInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c, ok := opts.c.(interceptors.IAMPreflightAWSClient)
+	if !ok {
+		return
+	}
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case After:
+		changes := plannedChanges(request.State.Raw, response.Plan.Raw, response.RequiresReplace)
+		if len(changes) == 0 {
+			return
+		}
+
+		if summary, detail := interceptors.IAMPreflight(ctx, c, interceptors.TypeNameFromContext(ctx), r.iamActions, changes...); summary != "" {
+			opts.response.Diagnostics.AddWarning(summary, detail)
+		}
+	}
+}
+
+// resourceIAMPreflight warns of any IAM actions used by a resource's planned changes that the caller is not allowed to perform.
+func resourceIAMPreflight(iamActions inttypes.ServicePackageResourceIAMActions) resourceModifyPlanInterceptor {
+	return &resourceIAMPreflightInterceptor{
+		iamActions: iamActions,
+	}
+}
+
+// plannedChanges returns the changes planned for a resource from its prior state and planned new state.
+func plannedChanges(state, plan tftypes.Value, requiresReplace path.Paths) []interceptors.PlannedChange {
+	switch {
+	case state.IsNull() && plan.IsNull():
+		return nil
+	case state.IsNull():
+		return []interceptors.PlannedChange{interceptors.PlannedCreate}
+	case plan.IsNull():
+		return []interceptors.PlannedChange{interceptors.PlannedDelete}
+	case len(requiresReplace) > 0:
+		return []interceptors.PlannedChange{interceptors.PlannedDelete, interceptors.PlannedCreate}
+	case !plan.Equal(state):
+		return []interceptors.PlannedChange{interceptors.PlannedUpdate}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPlannedChanges(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrName: tftypes.String,
+		},
+	}
+	null := tftypes.NewValue(typ, nil)
+	value := func(name string) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			names.AttrName: tftypes.NewValue(tftypes.String, name),
+		})
+	}
+
+	testCases := map[string]struct {
+		state           tftypes.Value
+		plan            tftypes.Value
+		requiresReplace path.Paths
+		expected        []interceptors.PlannedChange
+	}{
+		"create": {
+			state:    null,
+			plan:     value("a"),
+			expected: []interceptors.PlannedChange{interceptors.PlannedCreate},
+		},
+		"update": {
+			state:    value("a"),
+			plan:     value("b"),
+			expected: []interceptors.PlannedChange{interceptors.PlannedUpdate},
+		},
+		"replace": {
+			state:           value("a"),
+			plan:            value("b"),
+			requiresReplace: path.Paths{path.Root(names.AttrName)},
+			expected:        []interceptors.PlannedChange{interceptors.PlannedDelete, interceptors.PlannedCreate},
+		},
+		"delete": {
+			state:    value("a"),
+			plan:     null,
+			expected: []interceptors.PlannedChange{interceptors.PlannedDelete},
+		},
+		"no change": {
+			state: value("a"),
+			plan:  value("a"),
+		},
+		"unknown": {
+			state:    value("a"),
+			plan:     tftypes.NewValue(typ, map[string]tftypes.Value{names.AttrName: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)}),
+			expected: []interceptors.PlannedChange{interceptors.PlannedUpdate},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.expected, plannedChanges(testCase.state, testCase.plan, testCase.requiresReplace)); diff != "" {
+				t.Errorf("unexpected planned changes difference: %s", diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestIdentityInterceptor(t *testing.T) {
@@ -255,7 +256,7 @@ func (c mockClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
 }
 
 func (c mockClient) Partition(context.Context) string {
-	panic("not implemented") //lintignore:R009
+	return names.PartitionForRegion(c.region).ID()
 }
 
 func (c mockClient) ServicePackage(_ context.Context, name string) conns.ServicePackage {
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var globalARNSchema = schema.Schema{
@@ -60,6 +61,10 @@ func (c mockClient) AccountID(_ context.Context) string {
 	return c.accountID
 }
 
+func (c mockClient) Partition(_ context.Context) string {
+	return names.PartitionForRegion(c.region).ID()
+}
+
 func (c mockClient) Region(_ context.Context) string {
 	return c.region
 }
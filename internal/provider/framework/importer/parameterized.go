@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,6 +16,11 @@ import (
 )
 
 func SingleParameterized(ctx context.Context, client AWSClient, request resource.ImportStateRequest, identitySpec *inttypes.Identity, importSpec *inttypes.FrameworkImport, response *resource.ImportStateResponse) {
+	if isParameterizedARN(request, identitySpec) {
+		parameterizedARN(ctx, client, request, identitySpec, importSpec, response)
+		return
+	}
+
 	attr := identitySpec.Attributes[len(identitySpec.Attributes)-1]
 	identityPath := path.Root(attr.Name())
 	resourcePath := path.Root(attr.ResourceAttributeName())
@@ -48,6 +54,11 @@ func SingleParameterized(ctx context.Context, client AWSClient, request resource
 }
 
 func MultipleParameterized(ctx context.Context, client AWSClient, request resource.ImportStateRequest, identitySpec *inttypes.Identity, importSpec *inttypes.FrameworkImport, response *resource.ImportStateResponse) {
+	if isParameterizedARN(request, identitySpec) {
+		parameterizedARN(ctx, client, request, identitySpec, importSpec, response)
+		return
+	}
+
 	if request.ID != "" {
 		id, parts, err := importSpec.ImportID.Parse(request.ID)
 		if err != nil {
@@ -124,3 +135,107 @@ func MultipleParameterized(ctx context.Context, client AWSClient, request resour
 		setRegionFromStateOrIdentity(ctx, client, request, response)
 	}
 }
+
+// isParameterizedARN returns whether the import ID is an ARN that can be decomposed into the identity attributes
+// of a resource type with a parameterized identity.
+func isParameterizedARN(request resource.ImportStateRequest, identitySpec *inttypes.Identity) bool {
+	return request.ID != "" && identitySpec.HasARNFormat() && arn.IsARN(request.ID)
+}
+
+func parameterizedARN(ctx context.Context, client AWSClient, request resource.ImportStateRequest, identitySpec *inttypes.Identity, importSpec *inttypes.FrameworkImport, response *resource.ImportStateResponse) {
+	arnARN, err := arn.Parse(request.ID)
+	if err != nil {
+		response.Diagnostics.Append(InvalidResourceImportIDError(
+			"could not be parsed as an ARN.\n\n" +
+				fmt.Sprintf("Value: %q\nError: %s", request.ID, err),
+		))
+		return
+	}
+
+	partition := client.Partition(ctx)
+	if arnARN.Partition != partition {
+		response.Diagnostics.Append(InvalidResourceImportIDError(
+			fmt.Sprintf("contains a Partition %q which does not match the provider's %q.\n\nValue: %q", arnARN.Partition, partition, request.ID),
+		))
+		return
+	}
+
+	accountID := client.AccountID(ctx)
+	if arnARN.AccountID != accountID {
+		response.Diagnostics.Append(InvalidResourceImportIDError(
+			fmt.Sprintf("contains an Account ID %q which does not match the provider's %q.\n\nValue: %q", arnARN.AccountID, accountID, request.ID),
+		))
+		return
+	}
+
+	parts, err := identitySpec.ParseARN(arnARN)
+	if err != nil {
+		response.Diagnostics.Append(InvalidResourceImportIDError(
+			"could not be decomposed into identity attributes.\n\n" +
+				fmt.Sprintf("Value: %q\nError: %s", request.ID, err),
+		))
+		return
+	}
+
+	for _, attr := range identitySpec.Attributes {
+		switch attr.Name() {
+		case names.AttrAccountID, names.AttrRegion:
+			// Do nothing
+
+		default:
+			val, ok := parts[attr.Name()]
+			if !ok {
+				if attr.Required() {
+					response.Diagnostics.Append(InvalidResourceImportIDError(
+						fmt.Sprintf("does not contain a value for identity attribute %q.\n\nValue: %q", attr.Name(), request.ID),
+					))
+					return
+				}
+				continue
+			}
+
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(attr.ResourceAttributeName()), val)...)
+
+			if identity := response.Identity; identity != nil {
+				response.Diagnostics.Append(identity.SetAttribute(ctx, path.Root(attr.Name()), val)...)
+			}
+		}
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if importSpec.SetIDAttr {
+		if idCreator, ok := importSpec.ImportID.(inttypes.FrameworkImportIDCreator); ok {
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), idCreator.Create(ctx, response.State))...)
+		} else {
+			response.Diagnostics.AddError(
+				"Unexpected Error",
+				"An unexpected error occurred while importing a resource. "+
+					"This is always an error in the provider. "+
+					"Please report the following to the provider developer:\n\n"+
+					"Import ID handler does not implement Creator, but needs to set \"id\" attribute.",
+			)
+			return
+		}
+	}
+
+	if identity := response.Identity; identity != nil {
+		response.Diagnostics.Append(identity.SetAttribute(ctx, path.Root(names.AttrAccountID), accountID)...)
+	}
+
+	if !identitySpec.IsGlobalResource {
+		if arnARN.Region == "" {
+			setRegionFromStateOrIdentity(ctx, client, request, response)
+		} else {
+			setRegionFromARN(ctx, request, arnARN, response)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if identity := response.Identity; identity != nil {
+				response.Diagnostics.Append(identity.SetAttribute(ctx, path.Root(names.AttrRegion), arnARN.Region)...)
+			}
+		}
+	}
+}
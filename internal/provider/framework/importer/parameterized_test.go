@@ -199,6 +199,120 @@ func TestRegionalSingleParameterized_ByImportID(t *testing.T) {
 	}
 }
 
+func TestRegionalSingleParameterized_ByARN(t *testing.T) {
+	t.Parallel()
+
+	f := importer.SingleParameterized
+
+	accountID := "123456789012"
+	region := "a-region-1"
+	anotherRegion := "another-region-1"
+
+	testCases := map[string]struct {
+		inputID             string
+		inputRegion         string
+		expectedName        string
+		expectedRegion      string
+		expectError         bool
+		expectedErrorPrefix string
+	}{
+		"ARNRegion": {
+			inputID:        "arn:aws:a-service:" + anotherRegion + ":" + accountID + ":thing/a_name",
+			expectedName:   "a_name",
+			expectedRegion: anotherRegion,
+			expectError:    false,
+		},
+		"MatchingRegion": {
+			inputID:        "arn:aws:a-service:" + anotherRegion + ":" + accountID + ":thing/a_name",
+			inputRegion:    anotherRegion,
+			expectedName:   "a_name",
+			expectedRegion: anotherRegion,
+			expectError:    false,
+		},
+		"MismatchedRegion": {
+			inputID:     "arn:aws:a-service:" + anotherRegion + ":" + accountID + ":thing/a_name",
+			inputRegion: region,
+			expectError: true,
+		},
+		"WrongAccountID": {
+			inputID:             "arn:aws:a-service:" + region + ":987654321098:thing/a_name",
+			expectError:         true,
+			expectedErrorPrefix: "The import ID contains an Account ID",
+		},
+		"WrongPartition": {
+			inputID:             "arn:aws-cn:a-service:" + region + ":" + accountID + ":thing/a_name",
+			expectError:         true,
+			expectedErrorPrefix: "The import ID contains a Partition",
+		},
+		"WrongFormat": {
+			inputID:             "arn:aws:a-service:" + region + ":" + accountID + ":other/a_name",
+			expectError:         true,
+			expectedErrorPrefix: "The import ID could not be decomposed into identity attributes",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			client := mockClient{
+				accountID: accountID,
+				region:    region,
+			}
+
+			stateAttrs := map[string]string{}
+			if tc.inputRegion != "" {
+				stateAttrs["region"] = tc.inputRegion
+			}
+
+			identitySpec := inttypes.RegionalSingleParameterIdentity("name", inttypes.WithARNFormat("thing/{name}"))
+
+			identitySchema := ptr(identity.NewIdentitySchema(identitySpec))
+
+			importSpec := inttypes.FrameworkImport{
+				WrappedImport: true,
+			}
+
+			response := importByIDWithState(ctx, f, &client, regionalSingleParameterizedSchema, tc.inputID, stateAttrs, identitySchema, identitySpec, &importSpec)
+			if tc.expectError {
+				if !response.Diagnostics.HasError() {
+					t.Fatal("Expected error, got none")
+				}
+				if tc.expectedErrorPrefix != "" && !strings.HasPrefix(response.Diagnostics[0].Detail(), tc.expectedErrorPrefix) {
+					t.Fatalf("Unexpected error: %s", fwdiag.DiagnosticsError(response.Diagnostics))
+				}
+				return
+			}
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %s", fwdiag.DiagnosticsError(response.Diagnostics))
+			}
+
+			// Check name value
+			if e, a := tc.expectedName, getAttributeValue(ctx, t, response.State, path.Root("name")); e != a {
+				t.Errorf("expected `name` to be %q, got %q", e, a)
+			}
+
+			// Check region value
+			if e, a := tc.expectedRegion, getAttributeValue(ctx, t, response.State, path.Root("region")); e != a {
+				t.Errorf("expected `region` to be %q, got %q", e, a)
+			}
+
+			// Check identity
+			if e, a := accountID, getIdentityAttributeValue(ctx, t, response.Identity, path.Root("account_id")); e != a {
+				t.Errorf("expected Identity `account_id` to be %q, got %q", e, a)
+			}
+			if e, a := tc.expectedRegion, getIdentityAttributeValue(ctx, t, response.Identity, path.Root("region")); e != a {
+				t.Errorf("expected Identity `region` to be %q, got %q", e, a)
+			}
+			if e, a := tc.expectedName, getIdentityAttributeValue(ctx, t, response.Identity, path.Root("name")); e != a {
+				t.Errorf("expected Identity `name` to be %q, got %q", e, a)
+			}
+		})
+	}
+}
+
 func TestRegionalSingleParameterized_ByIdentity(t *testing.T) {
 	t.Parallel()
 
@@ -916,6 +1030,121 @@ func TestRegionalMutipleParameterized_ByImportID(t *testing.T) {
 	}
 }
 
+func TestRegionalMutipleParameterized_ByARN(t *testing.T) {
+	t.Parallel()
+
+	accountID := "123456789012"
+	region := "a-region-1"
+
+	testCases := map[string]struct {
+		inputID         string
+		useSchemaWithID bool
+		expectedAttrs   map[string]string
+		expectedRegion  string
+		expectedID      string
+		expectError     bool
+	}{
+		"ARNRegion": {
+			inputID: "arn:aws:a-service:" + region + ":" + accountID + ":thing/a_type/a_name",
+			expectedAttrs: map[string]string{
+				"name": "a_name",
+				"type": "a_type",
+			},
+			expectedRegion: region,
+			expectError:    false,
+		},
+		"WithIDAttr": {
+			inputID:         "arn:aws:a-service:" + region + ":" + accountID + ":thing/a_type/a_name",
+			useSchemaWithID: true,
+			expectedAttrs: map[string]string{
+				"name": "a_name",
+				"type": "a_type",
+			},
+			expectedRegion: region,
+			expectedID:     "a_name,a_type",
+			expectError:    false,
+		},
+		"MissingPart": {
+			inputID:     "arn:aws:a-service:" + region + ":" + accountID + ":thing/a_type",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			client := mockClient{
+				accountID: accountID,
+				region:    region,
+			}
+
+			identitySpec := regionalMultipleParameterizedIdentitySpec([]string{"name", "type"})
+			identitySpec.ARNFormat = "thing/{type}/{name}"
+
+			identitySchema := ptr(identity.NewIdentitySchema(identitySpec))
+
+			schema := regionalMultipleParameterizedSchema
+			if tc.useSchemaWithID {
+				schema = regionalMultipleParameterizedWithIDSchema
+			}
+
+			importSpec := inttypes.FrameworkImport{
+				WrappedImport: true,
+				ImportID:      testImportIDCreator{testImportID{t: t}},
+			}
+			if tc.useSchemaWithID {
+				importSpec.SetIDAttr = true
+			}
+
+			response := importByID(ctx, importer.MultipleParameterized, &client, schema, tc.inputID, identitySchema, identitySpec, &importSpec)
+			if tc.expectError {
+				if !response.Diagnostics.HasError() {
+					t.Fatal("Expected error, got none")
+				}
+				return
+			}
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %s", fwdiag.DiagnosticsError(response.Diagnostics))
+			}
+
+			// Check region value
+			if e, a := tc.expectedRegion, getAttributeValue(ctx, t, response.State, path.Root("region")); e != a {
+				t.Errorf("expected `region` to be %q, got %q", e, a)
+			}
+
+			// Check attr values
+			for name, expectedAttr := range tc.expectedAttrs {
+				if e, a := expectedAttr, getAttributeValue(ctx, t, response.State, path.Root(name)); e != a {
+					t.Errorf("expected `%s` to be %q, got %q", name, e, a)
+				}
+			}
+
+			// Check ID value if using schema with ID
+			if tc.useSchemaWithID {
+				if e, a := tc.expectedID, getAttributeValue(ctx, t, response.State, path.Root("id")); e != a {
+					t.Errorf("expected `id` to be %q, got %q", e, a)
+				}
+			}
+
+			// Check identity
+			if e, a := accountID, getIdentityAttributeValue(ctx, t, response.Identity, path.Root("account_id")); e != a {
+				t.Errorf("expected Identity `account_id` to be %q, got %q", e, a)
+			}
+			if e, a := tc.expectedRegion, getIdentityAttributeValue(ctx, t, response.Identity, path.Root("region")); e != a {
+				t.Errorf("expected Identity `region` to be %q, got %q", e, a)
+			}
+			for name, expectedAttr := range tc.expectedAttrs {
+				if e, a := expectedAttr, getIdentityAttributeValue(ctx, t, response.Identity, path.Root(name)); e != a {
+					t.Errorf("expected Identity `%s` to be %q, got %q", name, e, a)
+				}
+			}
+		})
+	}
+}
+
 func TestRegionalMutipleParameterized_ByIdentity(t *testing.T) {
 	t.Parallel()
 
@@ -123,6 +123,14 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_preflight": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set this to true to warn at plan time of any IAM actions used by planned resource changes that the caller is not allowed to perform. Uses the IAM policy simulator.",
+			},
+			"iam_preflight_warn_unchecked": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set this to true to warn, once per resource type, of planned changes to resource types that are not checked by `iam_preflight`.",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
@@ -139,6 +147,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set this to true to reject any AWS API operation which may modify AWS resources. Only read operations, such as those in the Describe, Get, List and Head families, are permitted.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -306,6 +318,26 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"computed": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							Description: "Configuration block with settings to add resource tags with computed values to all resources on creation and whenever their tags are updated.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"provider_version": schema.StringAttribute{
+										Optional:    true,
+										Description: "Key of a resource tag whose value is the provider version.",
+									},
+									"resource_type": schema.StringAttribute{
+										Optional:    true,
+										Description: "Key of a resource tag whose value is the Terraform resource type, e.g. `aws_s3_bucket`.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -331,6 +363,45 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"resource_defaults": schema.ListNestedBlock{
+				Description: "Configuration block with settings to default operation timeouts and retry behavior for a resource type or service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for Create operations, e.g. `2h`.",
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for Delete operations, e.g. `2h`.",
+						},
+						"max_retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an AWS API request is being executed.",
+						},
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for Read operations, e.g. `2h`.",
+						},
+						"resource_type": schema.StringAttribute{
+							Optional:    true,
+							Description: "Terraform resource type to which the defaults apply, e.g. `aws_db_instance`. Exactly one of `resource_type` or `service` must be set.",
+						},
+						"retry_mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`.",
+						},
+						"service": schema.StringAttribute{
+							Optional:    true,
+							Description: "Service to whose resources the defaults apply, e.g. `rds`. Exactly one of `resource_type` or `service` must be set.",
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for Update operations, e.g. `2h`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -379,6 +450,7 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewEventPatternMatchesFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
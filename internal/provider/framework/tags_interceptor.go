@@ -17,6 +17,7 @@ import (
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
 // tagsDataSourceInterceptor implements transparent tagging for data sources.
@@ -101,14 +102,23 @@ func (r tagsResourceInterceptor) create(ctx context.Context, opts interceptorOpt
 
 		// Merge the resource's configured tags with any provider configured default_tags.
 		tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+		// Add any provider configured computed default_tags.
+		tags = c.DefaultTagsConfig(ctx).MergeComputedTags(ctx, interceptors.TypeNameFromContext(ctx), version.ProviderVersion, tags)
 		// Remove system tags.
 		tags = tags.IgnoreSystem(sp.ServicePackageName())
 		tagsInContext.TagsIn = option.Some(tags)
 	case After:
+		var planTags tftags.Map
+		opts.response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+
 		// Set values for unknowns.
-		// Remove any provider configured ignore_tags and system tags from those passed to the service API.
+		// Remove any provider configured ignore_tags, computed default_tags and system tags from those passed to the service API.
+		// Computed default_tags whose keys are also configured on the resource are retained.
 		// Computed tags_all include any provider configured default_tags.
-		stateTagsAll := fwflex.FlattenFrameworkStringValueMapLegacy(ctx, tagsInContext.TagsIn.MustUnwrap().IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx)).Map())
+		stateTagsAll := fwflex.FlattenFrameworkStringValueMapLegacy(ctx, tagsInContext.TagsIn.MustUnwrap().IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx)).RemoveComputedDefaultConfig(c.DefaultTagsConfig(ctx), tftags.New(ctx, planTags)).Map())
 		opts.response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.NewMapFromMapValue(stateTagsAll))...)
 		if opts.response.Diagnostics.HasError() {
 			return
@@ -148,11 +158,13 @@ func (r tagsResourceInterceptor) read(ctx context.Context, opts interceptorOptio
 			}
 		}
 
-		apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
-
 		// AWS APIs often return empty lists of tags when none have been configured.
 		var stateTags tftags.Map
 		response.State.GetAttribute(ctx, path.Root(names.AttrTags), &stateTags)
+
+		// Computed default_tags are only set when tags are written.
+		// Those whose keys are also configured on the resource are retained.
+		apiTags := tagsInContext.TagsOut.UnwrapOrDefault().RemoveComputedDefaultConfig(c.DefaultTagsConfig(ctx), tftags.New(ctx, stateTags))
 		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
 		// The resource's configured tags do not include any provider configured default_tags.
 		if v := apiTags.IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx)).ResolveDuplicatesFramework(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), stateTags, &opts.response.Diagnostics).Map(); len(v) > 0 {
@@ -194,6 +206,9 @@ func (r tagsResourceInterceptor) update(ctx context.Context, opts interceptorOpt
 
 		// Merge the resource's configured tags with any provider configured default_tags.
 		tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+		// Add any provider configured computed default_tags.
+		// These are also passed to service APIs that replace a resource's entire set of tags on update.
+		tags = c.DefaultTagsConfig(ctx).MergeComputedTags(ctx, interceptors.TypeNameFromContext(ctx), version.ProviderVersion, tags)
 		// Remove system tags.
 		tags = tags.IgnoreSystem(sp.ServicePackageName())
 		tagsInContext.TagsIn = option.Some(tags)
@@ -212,7 +227,10 @@ func (r tagsResourceInterceptor) update(ctx context.Context, opts interceptorOpt
 			// Some old resources may not have the required attribute set after Read:
 			// https://github.com/hashicorp/terraform-provider-aws/issues/31180
 			if identifier := r.GetIdentifierFramework(ctx, request.Plan); identifier != "" {
-				if err := r.UpdateTags(ctx, sp, c, identifier, oldTagsAll, newTagsAll); err != nil {
+				// Refresh any provider configured computed default_tags.
+				newTags := c.DefaultTagsConfig(ctx).MergeComputedTags(ctx, interceptors.TypeNameFromContext(ctx), version.ProviderVersion, tftags.New(ctx, newTagsAll))
+
+				if err := r.UpdateTags(ctx, sp, c, identifier, oldTagsAll, newTags); err != nil {
 					opts.response.Diagnostics.AddError(fmt.Sprintf("updating tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
 
 					return
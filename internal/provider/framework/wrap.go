@@ -90,7 +90,7 @@ func (w *wrappedDataSource) context(ctx context.Context, getAttribute getAttribu
 		overrideRegion = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -240,7 +240,7 @@ func (w *wrappedEphemeralResource) context(ctx context.Context, getAttribute get
 		overrideRegion = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
 	if c != nil {
 		ctx = c.RegisterLogger(ctx)
 		ctx = fwflex.RegisterLogger(ctx)
@@ -396,6 +396,8 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		interceptors = append(interceptors, newIdentityInterceptor(spec.Identity.Attributes))
 	}
 
+	interceptors = append(interceptors, resourceIAMPreflight(spec.IAMActions))
+
 	inner, _ := spec.Factory(context.TODO())
 
 	if spec.Import.WrappedImport {
@@ -433,9 +435,10 @@ func (w *wrappedResource) context(ctx context.Context, getAttribute getAttribute
 		overrideRegion = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+		ctx = conns.NewResourceDefaultsContext(ctx, c.ResourceDefaults(ctx, w.servicePackageName, w.spec.TypeName))
 		ctx = c.RegisterLogger(ctx)
 		ctx = fwflex.RegisterLogger(ctx)
 	}
@@ -480,7 +483,11 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
-	interceptedHandler(w.interceptors.resourceCreate(), w.inner.Create, resourceCreateHasError, w.meta)(ctx, request, response)
+	f := w.inner.Create
+	if w.spec.CreationPending {
+		f = resourceCreateWithCreationPending(f, w.inner.Read)
+	}
+	interceptedHandler(w.interceptors.resourceCreate(), f, resourceCreateHasError, w.meta)(ctx, request, response)
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -490,7 +497,11 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return
 	}
 
-	interceptedHandler(w.interceptors.resourceRead(), w.inner.Read, resourceReadHasError, w.meta)(ctx, request, response)
+	f := w.inner.Read
+	if w.spec.CreationPending {
+		f = resourceReadWithCreationPending(f)
+	}
+	interceptedHandler(w.interceptors.resourceRead(), f, resourceReadHasError, w.meta)(ctx, request, response)
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -560,6 +571,9 @@ func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.Modif
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
 		f = v.ModifyPlan
 	}
+	if w.spec.CreationPending {
+		f = resourceModifyPlanWithCreationPending(f)
+	}
 	interceptedHandler(w.interceptors.resourceModifyPlan(), f, resourceModifyPlanHasError, w.meta)(ctx, request, response)
 }
 
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// IAMPreflightAWSClient is implemented by AWS clients that support plan-time IAM permission preflight checks.
+type IAMPreflightAWSClient interface {
+	IAMPreflight(context.Context) bool
+	DeniedIAMActions(context.Context, []string) ([]string, error)
+	ReportIAMPreflightUnmapped(context.Context, string) bool
+}
+
+// PlannedChange is a change to a resource planned by Terraform.
+type PlannedChange string
+
+const (
+	PlannedCreate PlannedChange = "create"
+	PlannedUpdate PlannedChange = "update"
+	PlannedDelete PlannedChange = "delete"
+)
+
+// IAMPreflightActions returns the IAM actions used by the specified planned changes, sorted and without duplicates.
+// A replacement is planned as both a delete and a create.
+func IAMPreflightActions(iamActions inttypes.ServicePackageResourceIAMActions, changes ...PlannedChange) []string {
+	var actions []string
+
+	for _, change := range changes {
+		switch change {
+		case PlannedCreate:
+			actions = append(actions, iamActions.Create...)
+		case PlannedUpdate:
+			actions = append(actions, iamActions.Update...)
+		case PlannedDelete:
+			actions = append(actions, iamActions.Delete...)
+		}
+	}
+
+	slices.Sort(actions)
+
+	return slices.Compact(actions)
+}
+
+// IAMPreflight checks whether the caller is allowed to perform the IAM actions used by a resource's planned changes.
+// A warning diagnostic summary and detail are returned if any action is denied or if the check could not be made.
+// Resource types that don't declare the IAM actions they use are reported once per provider run, if the client reports them.
+// Empty strings are returned if preflight checks are disabled or all actions are allowed.
+func IAMPreflight(ctx context.Context, c IAMPreflightAWSClient, typeName string, iamActions inttypes.ServicePackageResourceIAMActions, changes ...PlannedChange) (string, string) {
+	if !c.IAMPreflight(ctx) || len(changes) == 0 {
+		return "", ""
+	}
+
+	if iamActions.IsEmpty() {
+		if !c.ReportIAMPreflightUnmapped(ctx, typeName) {
+			return "", ""
+		}
+
+		return "IAM permission preflight check skipped",
+			fmt.Sprintf("%s does not declare the IAM actions used by its operations, so planned changes to resources of this type are not checked.", typeName)
+	}
+
+	actions := IAMPreflightActions(iamActions, changes...)
+	if len(actions) == 0 {
+		return "", ""
+	}
+
+	denied, err := c.DeniedIAMActions(ctx, actions)
+
+	if err != nil {
+		return "IAM permission preflight check failed",
+			fmt.Sprintf("Checking the IAM actions used by planned changes to %s: %s", typeName, err)
+	}
+
+	if len(denied) == 0 {
+		return "", ""
+	}
+
+	return "Planned changes may be denied by IAM",
+		fmt.Sprintf("The caller is not allowed to perform the following IAM actions used to %s %s:\n\n%s\n\n"+
+			"Applying this plan may fail with an access denied error. "+
+			"Results are from the IAM policy simulator and do not take resource-based policies or condition keys into account.",
+			plannedChangesVerb(changes), typeName, strings.Join(denied, "\n"))
+}
+
+// plannedChangesVerb returns the verb describing the specified planned changes.
+func plannedChangesVerb(changes []PlannedChange) string {
+	if slices.Contains(changes, PlannedCreate) && slices.Contains(changes, PlannedDelete) {
+		return "replace"
+	}
+
+	return strings.Join(tfslices.ApplyToAll(changes, func(v PlannedChange) string {
+		return string(v)
+	}), " and ")
+}
@@ -36,3 +36,12 @@ func InfoFromContext(ctx context.Context, c infoAWSClient) (conns.ServicePackage
 
 	return nil, "", "", nil, false
 }
+
+// TypeNameFromContext returns the Terraform type name of the resource in Context, e.g. "aws_subnet".
+func TypeNameFromContext(ctx context.Context) string {
+	if inContext, ok := conns.FromContext(ctx); ok {
+		return inContext.TypeName()
+	}
+
+	return ""
+}
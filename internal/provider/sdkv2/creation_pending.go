@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// creationPendingPrivateStateKey is the private state key used to mark a resource whose creation is pending.
+const creationPendingPrivateStateKey = "creation_pending"
+
+type creationPendingPrivateState struct {
+	ID     string `json:"id"`
+	Failed bool   `json:"failed,omitempty"` // Set when resuming the wait found that creation failed.
+}
+
+// Plugin SDK v2 resources have no access to private state, so the CRUD handler wrappers
+// communicate with the protocol server wrapper via a value in Context.
+type creationPendingKey struct{}
+
+type creationPending struct {
+	id       string // The identifier of the resource whose creation is pending.
+	failed   bool   // Set when a pending creation has failed.
+	resolved bool   // Set when a pending creation has completed.
+}
+
+type creationPendingProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+func (s creationPendingProviderServer) ApplyResourceChange(ctx context.Context, request *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	v := &creationPending{}
+	ctx = context.WithValue(ctx, creationPendingKey{}, v)
+
+	response, err := s.ProviderServer.ApplyResourceChange(ctx, request)
+
+	if err != nil || response == nil || v.id == "" {
+		return response, err
+	}
+
+	private, err := setPrivateStateKey(response.Private, creationPendingPrivateStateKey, creationPendingPrivateState{ID: v.id})
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Saving pending creation",
+			Detail:   err.Error(),
+		})
+
+		return response, nil
+	}
+
+	response.Private = private
+
+	return response, nil
+}
+
+func (s creationPendingProviderServer) ReadResource(ctx context.Context, request *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	var data creationPendingPrivateState
+	if ok, err := getPrivateStateKey(request.Private, creationPendingPrivateStateKey, &data); err != nil || !ok {
+		return s.ProviderServer.ReadResource(ctx, request)
+	}
+
+	v := &creationPending{
+		id: data.ID,
+	}
+	ctx = context.WithValue(ctx, creationPendingKey{}, v)
+
+	response, err := s.ProviderServer.ReadResource(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	var private []byte
+	switch {
+	case v.failed:
+		data.Failed = true
+		private, err = setPrivateStateKey(response.Private, creationPendingPrivateStateKey, data)
+	case v.resolved:
+		private, err = setPrivateStateKey(response.Private, creationPendingPrivateStateKey, nil)
+	default:
+		return response, nil
+	}
+	if err != nil {
+		return response, nil //nolint:nilerr // The marker is updated on a subsequent Read.
+	}
+
+	response.Private = private
+
+	return response, nil
+}
+
+// PlanResourceChange plans the replacement of a resource whose creation is known to have failed.
+// A resource can't be tainted after it has been saved to state, so replacement is forced by planning
+// a change to its identifier, which requires replacement.
+func (s creationPendingProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || diagnosticsHaveError(response.Diagnostics) {
+		return response, err
+	}
+
+	var data creationPendingPrivateState
+	if ok, err := getPrivateStateKey(request.PriorPrivate, creationPendingPrivateStateKey, &data); err != nil || !ok || !data.Failed {
+		return response, nil //nolint:nilerr // An unreadable marker is ignored.
+	}
+
+	r, ok := s.provider.ResourcesMap[request.TypeName]
+	if !ok {
+		return response, nil
+	}
+
+	plannedState, err := planReplacement(r.CoreConfigSchema().ImpliedType(), request.PriorState, response.PlannedState)
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Planning replacement of failed resource",
+			Detail:   err.Error(),
+		})
+
+		return response, nil
+	}
+
+	if plannedState == nil {
+		return response, nil
+	}
+
+	response.PlannedState = plannedState
+	response.RequiresReplace = append(response.RequiresReplace, tftypes.NewAttributePath().WithAttributeName(names.AttrID))
+	response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  "Resource creation failed",
+		Detail:   fmt.Sprintf("Creation of %s failed after it was saved to state. The resource must be replaced.", data.ID),
+	})
+
+	return response, nil
+}
+
+// planReplacement returns the specified planned state with the resource's identifier unknown.
+// nil is returned if there is no prior state or the resource is planned for deletion.
+func planReplacement(ty cty.Type, priorState, plannedState *tfprotov5.DynamicValue) (*tfprotov5.DynamicValue, error) {
+	prior, err := dynamicValueToCty(priorState, ty)
+	if err != nil {
+		return nil, err
+	}
+
+	planned, err := dynamicValueToCty(plannedState, ty)
+	if err != nil {
+		return nil, err
+	}
+
+	if prior.IsNull() || planned.IsNull() || !planned.Type().IsObjectType() || !planned.Type().HasAttribute(names.AttrID) {
+		return nil, nil
+	}
+
+	m := planned.AsValueMap()
+	m[names.AttrID] = cty.UnknownVal(cty.String)
+
+	v, err := ctymsgpack.Marshal(cty.ObjectVal(m), ty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov5.DynamicValue{MsgPack: v}, nil
+}
+
+// createWithCreationPending wraps a resource's Create handler.
+// If a wait for the new resource to become ready is interrupted after the resource's identifier is known,
+// the resource is read and saved to state untainted and marked in private state as pending creation.
+func createWithCreationPending(f schema.CreateContextFunc, read schema.ReadContextFunc) schema.CreateContextFunc {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		v, ok := ctx.Value(creationPendingKey{}).(*creationPending)
+		if !ok {
+			return f(ctx, d, meta)
+		}
+
+		ctx, recorder := retry.WithInterruptedWaitRecorder(ctx)
+
+		diags := f(ctx, d, meta)
+
+		if !diags.HasError() {
+			return diags
+		}
+
+		waitErr := recorder.Err()
+		if waitErr == nil || d.Id() == "" {
+			return diags
+		}
+
+		v.id = d.Id()
+		diags = errorsToWarnings(diags)
+
+		// Populate any attributes that are already known.
+		if read != nil {
+			diags = append(diags, errorsToWarnings(read(ctx, d, meta))...)
+			d.SetId(v.id)
+		}
+
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Resource creation pending",
+			Detail: fmt.Sprintf("Waiting for %s to become ready was interrupted: %s\n\n"+
+				"The resource has been saved to state. The next plan or apply will resume waiting for creation to complete. "+
+				"Attributes that are only known once creation completes are empty until then, including in resources that reference them in this run.", d.Id(), waitErr),
+		})
+	}
+}
+
+// readWithCreationPending wraps a resource's Read handler.
+// If the resource is marked in private state as pending creation, the resource's Read handler is signaled to resume waiting.
+// The marker is removed once the resource has been read without the wait being interrupted.
+// If the wait ends in an unexpected state, creation has failed and the resource is marked for replacement.
+func readWithCreationPending(f schema.ReadContextFunc) schema.ReadContextFunc {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		v, ok := ctx.Value(creationPendingKey{}).(*creationPending)
+		if !ok || v.id == "" {
+			return f(ctx, d, meta)
+		}
+
+		ctx = retry.WithCreationPending(ctx)
+		ctx, recorder := retry.WithInterruptedWaitRecorder(ctx)
+
+		diags := f(ctx, d, meta)
+
+		if waitErr := recorder.Err(); waitErr != nil {
+			return append(errorsToWarnings(diags), diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Resource creation pending",
+				Detail: fmt.Sprintf("Waiting for %s to become ready was interrupted: %s\n\n"+
+					"The next plan or apply will resume waiting for creation to complete.", v.id, waitErr),
+			})
+		}
+
+		if err := recorder.UnexpectedStateErr(); err != nil && diags.HasError() {
+			v.failed = true
+
+			return append(errorsToWarnings(diags), diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Resource creation failed",
+				Detail: fmt.Sprintf("%s did not become ready: %s\n\n"+
+					"The next plan or apply will replace the resource.", v.id, err),
+			})
+		}
+
+		if !diags.HasError() {
+			v.resolved = true
+		}
+
+		return diags
+	}
+}
+
+// errorsToWarnings returns the specified diagnostics with any errors converted to warnings.
+func errorsToWarnings(diags diag.Diagnostics) diag.Diagnostics {
+	warnings := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		d.Severity = diag.Warning
+		warnings = append(warnings, d)
+	}
+
+	return warnings
+}
+
+// getPrivateStateKey reads the value at the specified key from Plugin SDK v2 private state.
+func getPrivateStateKey(private []byte, key string, v any) (bool, error) {
+	if len(private) == 0 {
+		return false, nil
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(private, &m); err != nil {
+		return false, err
+	}
+
+	raw, ok := m[key]
+	if !ok {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// setPrivateStateKey sets the value at the specified key in Plugin SDK v2 private state.
+// A nil value removes the key.
+func setPrivateStateKey(private []byte, key string, v any) ([]byte, error) {
+	m := make(map[string]json.RawMessage)
+	if len(private) > 0 {
+		if err := json.Unmarshal(private, &m); err != nil {
+			return nil, err
+		}
+		if m == nil {
+			m = make(map[string]json.RawMessage)
+		}
+	}
+
+	if v == nil {
+		delete(m, key)
+	} else {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		m[key] = raw
+	}
+
+	return json.Marshal(m)
+}
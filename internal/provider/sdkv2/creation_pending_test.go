@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+func TestPrivateStateKey(t *testing.T) {
+	t.Parallel()
+
+	const timeoutsKey = "e2bfb730-ecaa-11e6-8f88-34363bc7c4c0"
+	private := []byte(`{"` + timeoutsKey + `":{"create":1800000000000},"schema_version":"1"}`)
+
+	private, err := setPrivateStateKey(private, creationPendingPrivateStateKey, creationPendingPrivateState{ID: "test-id"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var data creationPendingPrivateState
+	ok, err := getPrivateStateKey(private, creationPendingPrivateStateKey, &data)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !ok {
+		t.Fatal("expected key to be set")
+	}
+	if got, want := data.ID, "test-id"; got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+
+	var timeouts map[string]any
+	if ok, err := getPrivateStateKey(private, timeoutsKey, &timeouts); err != nil || !ok {
+		t.Errorf("expected existing key to be retained, got %t, %v", ok, err)
+	}
+
+	private, err = setPrivateStateKey(private, creationPendingPrivateStateKey, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ok, err := getPrivateStateKey(private, creationPendingPrivateStateKey, &data); err != nil || ok {
+		t.Errorf("expected key to be removed, got %t, %v", ok, err)
+	}
+}
+
+func TestCreateWithCreationPending(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id              string
+		waitErr         bool
+		expectedPending bool
+		expectedError   bool
+	}{
+		"wait timed out": {
+			id:              "test-id",
+			waitErr:         true,
+			expectedPending: true,
+		},
+		"wait timed out no ID": {
+			waitErr:       true,
+			expectedError: true,
+		},
+		"other error": {
+			id:            "test-id",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			create := func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				d.SetId(testCase.id)
+
+				if testCase.waitErr {
+					stateConf := &retry.StateChangeConf{
+						Pending: []string{"pending"},
+						Target:  []string{"ready"},
+						Refresh: func(context.Context) (any, string, error) {
+							return struct{}{}, "pending", nil
+						},
+						Timeout: 10 * time.Millisecond,
+					}
+					if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+						return diag.FromErr(err)
+					}
+				}
+
+				return diag.FromErr(errors.New("failed"))
+			}
+
+			var readCalled bool
+			read := func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				readCalled = true
+
+				return nil
+			}
+
+			v := &creationPending{}
+			ctx := context.WithValue(t.Context(), creationPendingKey{}, v)
+			d := (&schema.Resource{Schema: map[string]*schema.Schema{}}).TestResourceData()
+
+			diags := createWithCreationPending(create, read)(ctx, d, nil)
+
+			if got, want := v.id != "", testCase.expectedPending; got != want {
+				t.Errorf("pending = %t, want %t", got, want)
+			}
+			if got, want := readCalled, testCase.expectedPending; got != want {
+				t.Errorf("read called = %t, want %t", got, want)
+			}
+			if got, want := diags.HasError(), testCase.expectedError; got != want {
+				t.Errorf("HasError = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestReadWithCreationPending(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		status           string
+		expectedFailed   bool
+		expectedResolved bool
+		expectedError    bool
+	}{
+		"ready": {
+			status:           "ready",
+			expectedResolved: true,
+		},
+		"still pending": {
+			status: "pending",
+		},
+		"failed": {
+			status:         "failed",
+			expectedFailed: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			read := func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				if !retry.CreationPending(ctx) {
+					return diag.FromErr(errors.New("creation pending not signaled"))
+				}
+
+				stateConf := &retry.StateChangeConf{
+					Pending: []string{"pending"},
+					Target:  []string{"ready"},
+					Refresh: func(context.Context) (any, string, error) {
+						return struct{}{}, testCase.status, nil
+					},
+					Timeout: 10 * time.Millisecond,
+				}
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					return diag.FromErr(err)
+				}
+
+				return nil
+			}
+
+			v := &creationPending{id: "test-id"}
+			ctx := context.WithValue(t.Context(), creationPendingKey{}, v)
+			d := (&schema.Resource{Schema: map[string]*schema.Schema{}}).TestResourceData()
+
+			diags := readWithCreationPending(read)(ctx, d, nil)
+
+			if got, want := v.failed, testCase.expectedFailed; got != want {
+				t.Errorf("failed = %t, want %t", got, want)
+			}
+			if got, want := v.resolved, testCase.expectedResolved; got != want {
+				t.Errorf("resolved = %t, want %t", got, want)
+			}
+			if got, want := diags.HasError(), testCase.expectedError; got != want {
+				t.Errorf("HasError = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestWrapResourceCreationPendingOptIn(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		creationPending bool
+		expectedError   bool
+	}{
+		"opted in": {
+			creationPending: true,
+		},
+		"not opted in": {
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{},
+				CreateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+					d.SetId("test-id")
+
+					stateConf := &retry.StateChangeConf{
+						Pending: []string{"pending"},
+						Target:  []string{"ready"},
+						Refresh: func(context.Context) (any, string, error) {
+							return struct{}{}, "pending", nil
+						},
+						Timeout: 10 * time.Millisecond,
+					}
+					_, err := stateConf.WaitForStateContext(ctx)
+
+					return diag.FromErr(err)
+				},
+				ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+					return nil
+				},
+			}
+			wrapResource(r, wrappedResourceOptions{
+				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, _ any) (context.Context, error) {
+					return ctx, nil
+				},
+				creationPending: testCase.creationPending,
+			})
+
+			v := &creationPending{}
+			ctx := context.WithValue(t.Context(), creationPendingKey{}, v)
+			d := r.TestResourceData()
+
+			diags := r.CreateWithoutTimeout(ctx, d, mockClient{})
+
+			if got, want := diags.HasError(), testCase.expectedError; got != want {
+				t.Errorf("HasError = %t, want %t", got, want)
+			}
+			if got, want := v.id != "", !testCase.expectedError; got != want {
+				t.Errorf("pending = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestPlanReplacement(t *testing.T) {
+	t.Parallel()
+
+	ty := cty.Object(map[string]cty.Type{
+		"id":   cty.String,
+		"name": cty.String,
+	})
+	state := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.StringVal("test-id"),
+		"name": cty.StringVal("test"),
+	})
+	dynamicValue := func(t *testing.T, v cty.Value) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		b, err := ctymsgpack.Marshal(v, ty)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return &tfprotov5.DynamicValue{MsgPack: b}
+	}
+
+	got, err := planReplacement(ty, dynamicValue(t, state), dynamicValue(t, state))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got == nil {
+		t.Fatal("expected planned state")
+	}
+
+	planned, err := dynamicValueToCty(got, ty)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if planned.GetAttr("id").IsKnown() {
+		t.Errorf("id = %#v, want unknown", planned.GetAttr("id"))
+	}
+	if got, want := planned.GetAttr("name"), cty.StringVal("test"); !got.RawEquals(want) {
+		t.Errorf("name = %#v, want %#v", got, want)
+	}
+
+	got, err = planReplacement(ty, dynamicValue(t, state), dynamicValue(t, cty.NullVal(ty)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != nil {
+		t.Errorf("expected no planned state for deletion, got %v", got)
+	}
+}
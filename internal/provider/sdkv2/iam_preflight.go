@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Plugin SDK v2 CustomizeDiff functions cannot return warnings and are not called for planned deletions,
+// so plan-time IAM permission preflight checks are made in the protocol server wrapper.
+type iamPreflightProviderServer struct {
+	tfprotov5.ProviderServer
+	provider   *schema.Provider
+	iamActions map[string]inttypes.ServicePackageResourceIAMActions // Keyed by Terraform type name. Resource types without declared IAM actions are absent.
+}
+
+func (s iamPreflightProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || diagnosticsHaveError(response.Diagnostics) {
+		return response, err
+	}
+
+	c, ok := s.provider.Meta().(*conns.AWSClient)
+	if !ok || !c.IAMPreflight(ctx) {
+		return response, nil
+	}
+
+	r, ok := s.provider.ResourcesMap[request.TypeName]
+	if !ok {
+		return response, nil
+	}
+
+	changes, err := plannedChanges(r.CoreConfigSchema().ImpliedType(), request.PriorState, response.PlannedState, response.RequiresReplace)
+	if err != nil {
+		return response, nil //nolint:nilerr // Preflight checks are advisory.
+	}
+
+	if summary, detail := interceptors.IAMPreflight(ctx, c, request.TypeName, s.iamActions[request.TypeName], changes...); summary != "" {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  summary,
+			Detail:   detail,
+		})
+	}
+
+	return response, nil
+}
+
+// plannedChanges returns the changes planned for a resource from its prior state and planned new state.
+func plannedChanges(ty cty.Type, priorState, plannedState *tfprotov5.DynamicValue, requiresReplace []*tftypes.AttributePath) ([]interceptors.PlannedChange, error) {
+	prior, err := dynamicValueToCty(priorState, ty)
+	if err != nil {
+		return nil, err
+	}
+
+	planned, err := dynamicValueToCty(plannedState, ty)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case prior.IsNull() && planned.IsNull():
+		return nil, nil
+	case prior.IsNull():
+		return []interceptors.PlannedChange{interceptors.PlannedCreate}, nil
+	case planned.IsNull():
+		return []interceptors.PlannedChange{interceptors.PlannedDelete}, nil
+	case len(requiresReplace) > 0:
+		return []interceptors.PlannedChange{interceptors.PlannedDelete, interceptors.PlannedCreate}, nil
+	case !planned.RawEquals(prior):
+		return []interceptors.PlannedChange{interceptors.PlannedUpdate}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func dynamicValueToCty(v *tfprotov5.DynamicValue, ty cty.Type) (cty.Value, error) {
+	if v == nil || len(v.MsgPack) == 0 {
+		return cty.NullVal(ty), nil
+	}
+
+	return ctymsgpack.Unmarshal(v.MsgPack, ty)
+}
+
+func diagnosticsHaveError(diags []*tfprotov5.Diagnostic) bool {
+	for _, d := range diags {
+		if d != nil && d.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
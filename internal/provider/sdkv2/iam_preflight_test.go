@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPlannedChanges(t *testing.T) {
+	t.Parallel()
+
+	ty := cty.Object(map[string]cty.Type{
+		names.AttrName: cty.String,
+	})
+	dynamicValue := func(t *testing.T, v cty.Value) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		b, err := ctymsgpack.Marshal(v, ty)
+		if err != nil {
+			t.Fatalf("marshaling value: %s", err)
+		}
+
+		return &tfprotov5.DynamicValue{MsgPack: b}
+	}
+	null := cty.NullVal(ty)
+	value := func(name cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			names.AttrName: name,
+		})
+	}
+
+	testCases := map[string]struct {
+		prior           cty.Value
+		planned         cty.Value
+		requiresReplace []*tftypes.AttributePath
+		expected        []interceptors.PlannedChange
+	}{
+		"create": {
+			prior:    null,
+			planned:  value(cty.StringVal("a")),
+			expected: []interceptors.PlannedChange{interceptors.PlannedCreate},
+		},
+		"update": {
+			prior:    value(cty.StringVal("a")),
+			planned:  value(cty.StringVal("b")),
+			expected: []interceptors.PlannedChange{interceptors.PlannedUpdate},
+		},
+		"replace": {
+			prior:           value(cty.StringVal("a")),
+			planned:         value(cty.StringVal("b")),
+			requiresReplace: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName(names.AttrName)},
+			expected:        []interceptors.PlannedChange{interceptors.PlannedDelete, interceptors.PlannedCreate},
+		},
+		"delete": {
+			prior:    value(cty.StringVal("a")),
+			planned:  null,
+			expected: []interceptors.PlannedChange{interceptors.PlannedDelete},
+		},
+		"no change": {
+			prior:   value(cty.StringVal("a")),
+			planned: value(cty.StringVal("a")),
+		},
+		"unknown": {
+			prior:    value(cty.StringVal("a")),
+			planned:  value(cty.UnknownVal(cty.String)),
+			expected: []interceptors.PlannedChange{interceptors.PlannedUpdate},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := plannedChanges(ty, dynamicValue(t, testCase.prior), dynamicValue(t, testCase.planned), testCase.requiresReplace)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected planned changes difference: %s", diff)
+			}
+		})
+	}
+}
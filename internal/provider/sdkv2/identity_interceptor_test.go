@@ -303,7 +303,7 @@ func (c mockClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
 }
 
 func (c mockClient) Partition(context.Context) string {
-	panic("not implemented") //lintignore:R009
+	return names.PartitionForRegion(c.region).ID()
 }
 
 func (c mockClient) ServicePackage(_ context.Context, name string) conns.ServicePackage {
@@ -7,12 +7,17 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegionalSingleParameterized(ctx context.Context, rd *schema.ResourceData, identitySpec inttypes.Identity, client AWSClient) error {
+	if isParameterizedARN(rd, identitySpec) {
+		return parameterizedARN(ctx, rd, identitySpec, nil, client)
+	}
+
 	attr := identitySpec.Attributes[len(identitySpec.Attributes)-1]
 
 	if rd.Id() != "" {
@@ -55,6 +60,10 @@ func RegionalSingleParameterized(ctx context.Context, rd *schema.ResourceData, i
 }
 
 func GlobalSingleParameterized(ctx context.Context, rd *schema.ResourceData, identitySpec inttypes.Identity, client AWSClient) error {
+	if isParameterizedARN(rd, identitySpec) {
+		return parameterizedARN(ctx, rd, identitySpec, nil, client)
+	}
+
 	attr := identitySpec.Attributes[len(identitySpec.Attributes)-1]
 
 	if rd.Id() != "" {
@@ -93,6 +102,10 @@ func GlobalSingleParameterized(ctx context.Context, rd *schema.ResourceData, ide
 }
 
 func RegionalMultipleParameterized(ctx context.Context, rd *schema.ResourceData, identitySpec inttypes.Identity, importSpec *inttypes.SDKv2Import, client AWSClient) error {
+	if isParameterizedARN(rd, identitySpec) {
+		return parameterizedARN(ctx, rd, identitySpec, importSpec, client)
+	}
+
 	if rd.Id() != "" {
 		id, parts, err := importSpec.ImportID.Parse(rd.Id())
 		if err != nil {
@@ -142,6 +155,10 @@ func RegionalMultipleParameterized(ctx context.Context, rd *schema.ResourceData,
 }
 
 func GlobalMultipleParameterized(ctx context.Context, rd *schema.ResourceData, identitySpec inttypes.Identity, importSpec *inttypes.SDKv2Import, client AWSClient) error {
+	if isParameterizedARN(rd, identitySpec) {
+		return parameterizedARN(ctx, rd, identitySpec, importSpec, client)
+	}
+
 	if rd.Id() != "" {
 		id, parts, err := importSpec.ImportID.Parse(rd.Id())
 		if err != nil {
@@ -186,6 +203,69 @@ func GlobalMultipleParameterized(ctx context.Context, rd *schema.ResourceData, i
 	return nil
 }
 
+// isParameterizedARN returns whether the import ID is an ARN that can be decomposed into the identity attributes
+// of a resource type with a parameterized identity.
+func isParameterizedARN(rd *schema.ResourceData, identitySpec inttypes.Identity) bool {
+	return rd.Id() != "" && identitySpec.HasARNFormat() && arn.IsARN(rd.Id())
+}
+
+func parameterizedARN(ctx context.Context, rd *schema.ResourceData, identitySpec inttypes.Identity, importSpec *inttypes.SDKv2Import, client AWSClient) error {
+	arnARN, err := arn.Parse(rd.Id())
+	if err != nil {
+		return fmt.Errorf("could not parse import ID %q as ARN: %w", rd.Id(), err)
+	}
+
+	if partition := client.Partition(ctx); arnARN.Partition != partition {
+		return fmt.Errorf("import ID %q: Provider configured with Partition %q cannot be used to import resources from partition %q", rd.Id(), partition, arnARN.Partition)
+	}
+
+	if accountID := client.AccountID(ctx); arnARN.AccountID != accountID {
+		return fmt.Errorf("import ID %q: Provider configured with Account ID %q cannot be used to import resources from account %q", rd.Id(), accountID, arnARN.AccountID)
+	}
+
+	parts, err := identitySpec.ParseARN(arnARN)
+	if err != nil {
+		return fmt.Errorf("import ID %q: %w", rd.Id(), err)
+	}
+
+	if !identitySpec.IsGlobalResource && arnARN.Region != "" {
+		if region, ok := rd.GetOk(names.AttrRegion); ok {
+			if region != arnARN.Region {
+				return fmt.Errorf("the region passed for import %q does not match the region %q in the ARN %q", region, arnARN.Region, rd.Id())
+			}
+		} else {
+			rd.Set(names.AttrRegion, arnARN.Region)
+		}
+	}
+
+	for _, attr := range identitySpec.Attributes {
+		switch attr.Name() {
+		case names.AttrAccountID, names.AttrRegion:
+			// Do nothing
+
+		default:
+			val, ok := parts[attr.Name()]
+			if attr.Required() && !ok {
+				return fmt.Errorf("import ID %q: no value for identity attribute %q", rd.Id(), attr.Name())
+			}
+			if ok {
+				setAttribute(rd, attr.ResourceAttributeName(), val)
+			}
+		}
+	}
+
+	if identitySpec.IsSingleParameter {
+		attr := identitySpec.Attributes[len(identitySpec.Attributes)-1]
+		if attr.ResourceAttributeName() != names.AttrID {
+			rd.SetId(parts[attr.Name()])
+		}
+	} else {
+		rd.SetId(importSpec.ImportID.Create(rd))
+	}
+
+	return nil
+}
+
 func setRegion(ctx context.Context, identity *schema.IdentityData, rd *schema.ResourceData, client AWSClient) error {
 	if regionRaw, ok := identity.GetOk(names.AttrRegion); ok {
 		if region, ok := regionRaw.(string); !ok {
@@ -132,6 +132,124 @@ func TestRegionalSingleParameterized_ByImportID(t *testing.T) {
 	}
 }
 
+func TestRegionalSingleParameterized_ByARN(t *testing.T) {
+	t.Parallel()
+
+	accountID := "123456789012"
+	region := "a-region-1"
+	anotherRegion := "another-region-1"
+
+	testCases := map[string]struct {
+		attrName            string
+		inputID             string
+		inputRegion         string
+		expectedID          string
+		expectedName        string
+		expectedRegion      string
+		expectError         bool
+		expectedErrorPrefix string
+	}{
+		"Attr_ARNRegion": {
+			attrName:       "name",
+			inputID:        "arn:aws:a-service:" + anotherRegion + ":" + accountID + ":thing/a_name",
+			expectedID:     "a_name",
+			expectedName:   "a_name",
+			expectedRegion: anotherRegion,
+			expectError:    false,
+		},
+		"Attr_MatchingRegion": {
+			attrName:       "name",
+			inputID:        "arn:aws:a-service:" + anotherRegion + ":" + accountID + ":thing/a_name",
+			inputRegion:    anotherRegion,
+			expectedID:     "a_name",
+			expectedName:   "a_name",
+			expectedRegion: anotherRegion,
+			expectError:    false,
+		},
+		"Attr_MismatchedRegion": {
+			attrName:            "name",
+			inputID:             "arn:aws:a-service:" + anotherRegion + ":" + accountID + ":thing/a_name",
+			inputRegion:         region,
+			expectError:         true,
+			expectedErrorPrefix: "the region passed for import",
+		},
+		"ID_ARNRegion": {
+			attrName:       "id",
+			inputID:        "arn:aws:a-service:" + region + ":" + accountID + ":thing/a_name",
+			expectedID:     "a_name",
+			expectedRegion: region,
+			expectError:    false,
+		},
+		"WrongAccountID": {
+			attrName:            "name",
+			inputID:             "arn:aws:a-service:" + region + ":987654321098:thing/a_name",
+			expectError:         true,
+			expectedErrorPrefix: "import ID",
+		},
+		"WrongPartition": {
+			attrName:            "name",
+			inputID:             "arn:aws-cn:a-service:" + region + ":" + accountID + ":thing/a_name",
+			expectError:         true,
+			expectedErrorPrefix: "import ID",
+		},
+		"WrongFormat": {
+			attrName:            "name",
+			inputID:             "arn:aws:a-service:" + region + ":" + accountID + ":other/a_name",
+			expectError:         true,
+			expectedErrorPrefix: "import ID",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			client := mockClient{
+				accountID: accountID,
+				region:    region,
+			}
+
+			identitySpec := inttypes.RegionalSingleParameterIdentity(tc.attrName, inttypes.WithARNFormat("thing/{"+tc.attrName+"}"))
+
+			d := schema.TestResourceDataRaw(t, regionalSingleParameterizedSchema, map[string]any{
+				"region": tc.inputRegion,
+			})
+			d.SetId(tc.inputID)
+
+			err := importer.RegionalSingleParameterized(ctx, d, identitySpec, client)
+			if tc.expectError {
+				if err == nil {
+					t.Fatal("Expected error, got none")
+				}
+				if tc.expectedErrorPrefix != "" && !strings.HasPrefix(err.Error(), tc.expectedErrorPrefix) {
+					t.Fatalf("Unexpected error: %s", err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err.Error())
+			}
+
+			// Check ID value
+			if e, a := tc.expectedID, getAttributeValue(t, d, "id"); e != a {
+				t.Errorf("expected `id` to be %q, got %q", e, a)
+			}
+
+			// Check region value
+			if e, a := tc.expectedRegion, getAttributeValue(t, d, "region"); e != a {
+				t.Errorf("expected `region` to be %q, got %q", e, a)
+			}
+
+			// Check name value
+			if e, a := tc.expectedName, getAttributeValue(t, d, "name"); e != a {
+				t.Errorf("expected `name` to be %q, got %q", e, a)
+			}
+		})
+	}
+}
+
 func TestRegionalSingleParameterized_ByIdentity(t *testing.T) {
 	t.Parallel()
 
@@ -661,6 +779,93 @@ func TestRegionalMutipleParameterized_ByImportID(t *testing.T) {
 	}
 }
 
+func TestRegionalMutipleParameterized_ByARN(t *testing.T) {
+	t.Parallel()
+
+	accountID := "123456789012"
+	region := "a-region-1"
+
+	testCases := map[string]struct {
+		inputID             string
+		expectedID          string
+		expectedAttrs       map[string]string
+		expectedRegion      string
+		expectError         bool
+		expectedErrorPrefix string
+	}{
+		"ARNRegion": {
+			inputID:    "arn:aws:a-service:" + region + ":" + accountID + ":thing/a_type/a_name",
+			expectedID: "a_name,a_type",
+			expectedAttrs: map[string]string{
+				"name": "a_name",
+				"type": "a_type",
+			},
+			expectedRegion: region,
+			expectError:    false,
+		},
+		"MissingPart": {
+			inputID:             "arn:aws:a-service:" + region + ":" + accountID + ":thing/a_type",
+			expectError:         true,
+			expectedErrorPrefix: "import ID",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			client := mockClient{
+				accountID: accountID,
+				region:    region,
+			}
+
+			identitySpec := regionalMultipleParameterizedIdentitySpec([]string{"name", "type"})
+			identitySpec.ARNFormat = "thing/{type}/{name}"
+
+			importSpec := inttypes.SDKv2Import{
+				WrappedImport: true,
+				ImportID:      testImportID{t: t},
+			}
+
+			d := schema.TestResourceDataRaw(t, regionalMultipleParameterizedSchema, map[string]any{})
+			d.SetId(tc.inputID)
+
+			err := importer.RegionalMultipleParameterized(ctx, d, identitySpec, &importSpec, client)
+			if tc.expectError {
+				if err == nil {
+					t.Fatal("Expected error, got none")
+				}
+				if tc.expectedErrorPrefix != "" && !strings.HasPrefix(err.Error(), tc.expectedErrorPrefix) {
+					t.Fatalf("Unexpected error: %s", err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err.Error())
+			}
+
+			// Check ID value
+			if e, a := tc.expectedID, getAttributeValue(t, d, "id"); e != a {
+				t.Errorf("expected `id` to be %q, got %q", e, a)
+			}
+
+			// Check region value
+			if e, a := tc.expectedRegion, getAttributeValue(t, d, "region"); e != a {
+				t.Errorf("expected `region` to be %q, got %q", e, a)
+			}
+
+			// Check attr values
+			for name, expectedAttr := range tc.expectedAttrs {
+				if e, a := expectedAttr, getAttributeValue(t, d, name); e != a {
+					t.Errorf("expected `%s` to be %q, got %q", name, e, a)
+				}
+			}
+		})
+	}
+}
+
 func TestRegionalMutipleParameterized_ByIdentity(t *testing.T) {
 	t.Parallel()
 
@@ -14,6 +14,7 @@ import (
 
 type AWSClient interface {
 	AccountID(ctx context.Context) string
+	Partition(ctx context.Context) string
 	Region(ctx context.Context) string
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var regionalSingletonSchema = map[string]*schema.Schema{
@@ -33,6 +34,10 @@ func (c mockClient) AccountID(_ context.Context) string {
 	return c.accountID
 }
 
+func (c mockClient) Partition(_ context.Context) string {
+	return names.PartitionForRegion(c.region).ID()
+}
+
 func (c mockClient) Region(_ context.Context) string {
 	return c.region
 }
@@ -83,6 +83,26 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Configuration block with settings to default resource tags across all resources.",
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
+							"computed": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								MaxItems:    1,
+								Description: "Configuration block with settings to add resource tags with computed values to all resources on creation and whenever their tags are updated.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"provider_version": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "Key of a resource tag whose value is the provider version.",
+										},
+										"resource_type": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "Key of a resource tag whose value is the Terraform resource type, e.g. `aws_s3_bucket`.",
+										},
+									},
+								},
+							},
 							"tags": {
 								Type:     schema.TypeMap,
 								Optional: true,
@@ -124,6 +144,18 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. " +
 						"Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 				},
+				"iam_preflight": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Set this to true to warn at plan time of any IAM actions used by planned resource changes " +
+						"that the caller is not allowed to perform. Uses the IAM policy simulator.",
+				},
+				"iam_preflight_warn_unchecked": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Set this to true to warn, once per resource type, of planned changes to resource types " +
+						"that are not checked by `iam_preflight`.",
+				},
 				"ignore_tags": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -173,12 +205,67 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"read_only": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Set this to true to reject any AWS API operation which may modify AWS resources. " +
+						"Only read operations, such as those in the Describe, Get, List and Head families, are permitted.",
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: "The region where AWS operations will take place. Examples\n" +
 						"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 				},
+				"resource_defaults": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration block with settings to default operation timeouts and retry behavior for a resource type or service.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"create": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Default timeout for Create operations, e.g. `2h`.",
+							},
+							"delete": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Default timeout for Delete operations, e.g. `2h`.",
+							},
+							"max_retries": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "The maximum number of times an AWS API request is being executed.",
+							},
+							"read": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Default timeout for Read operations, e.g. `2h`.",
+							},
+							"resource_type": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Terraform resource type to which the defaults apply, e.g. `aws_db_instance`. Exactly one of `resource_type` or `service` must be set.",
+							},
+							"retry_mode": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`.",
+							},
+							"service": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Service to whose resources the defaults apply, e.g. `rds`. Exactly one of `resource_type` or `service` must be set.",
+							},
+							"update": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Default timeout for Update operations, e.g. `2h`.",
+							},
+						},
+					},
+				},
 				"retry_mode": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -339,9 +426,12 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
+		IAMPreflight:                   d.Get("iam_preflight").(bool),
+		IAMPreflightWarnUnchecked:      d.Get("iam_preflight_warn_unchecked").(bool),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
+		ReadOnly:                       d.Get("read_only").(bool),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
@@ -469,6 +559,15 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("resource_defaults"); ok && len(v.([]any)) > 0 {
+		resourceDefaultsConfig, dx := expandResourceDefaults(ctx, v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ResourceDefaultsConfig = resourceDefaultsConfig
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -498,9 +597,42 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		return nil, diags
 	}
 
+	p.setResourceDefaultTimeouts(ctx, c)
+
 	return c, diags
 }
 
+// setResourceDefaultTimeouts applies any provider-level default operation timeouts to Terraform Plugin SDK v2-style resources.
+// A resource's `timeouts` configuration block is decoded using these defaults, so any configured timeouts take precedence.
+func (p *sdkProvider) setResourceDefaultTimeouts(ctx context.Context, c *conns.AWSClient) {
+	for _, sp := range p.servicePackages {
+		servicePackageName := sp.ServicePackageName()
+
+		for _, resource := range sp.SDKResources(ctx) {
+			r, ok := p.provider.ResourcesMap[resource.TypeName]
+			if !ok || r.Timeouts == nil {
+				continue
+			}
+
+			v := c.ResourceDefaults(ctx, servicePackageName, resource.TypeName)
+			// Only override timeouts that the resource supports.
+			for _, t := range []struct {
+				timeout **time.Duration
+				value   time.Duration
+			}{
+				{&r.Timeouts.Create, v.CreateTimeout},
+				{&r.Timeouts.Read, v.ReadTimeout},
+				{&r.Timeouts.Update, v.UpdateTimeout},
+				{&r.Timeouts.Delete, v.DeleteTimeout},
+			} {
+				if *t.timeout != nil && t.value > 0 {
+					*t.timeout = aws.Duration(t.value)
+				}
+			}
+		}
+	}
+}
+
 // initialize is called from `New` to perform any Terraform Plugin SDK v2-style initialization.
 func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServicePackage, error) {
 	log.Printf("Initializing Terraform AWS Provider (SDKv2-style)...")
@@ -586,7 +718,7 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 						}
 					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName, overrideRegion)
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -749,7 +881,7 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 						}
 					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, overrideRegion)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, typeName, overrideRegion)
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -757,8 +889,9 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 					return ctx, nil
 				},
-				interceptors: interceptors,
-				typeName:     typeName,
+				interceptors:    interceptors,
+				typeName:        typeName,
+				creationPending: resource.CreationPending,
 			}
 			wrapResource(r, opts)
 			p.provider.ResourcesMap[typeName] = r
@@ -1089,15 +1222,111 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 		maps.Copy(tags, cfgTags)
 	}
 
-	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+	var computed *tftags.ComputedDefaultConfig
+	if v, ok := tfMap["computed"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		computed = &tftags.ComputedDefaultConfig{
+			ProviderVersionKey: tfMap["provider_version"].(string),
+			ResourceTypeKey:    tfMap["resource_type"].(string),
 		}
 	}
 
+	if len(tags) > 0 || computed != nil {
+		config := &tftags.DefaultConfig{
+			Computed: computed,
+		}
+		if len(tags) > 0 {
+			config.Tags = tftags.New(ctx, tags)
+		}
+		return config
+	}
+
 	return nil
 }
 
+func expandResourceDefaults(_ context.Context, tfList []any) (*conns.ResourceDefaultsConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resourceDefaultsPath := cty.GetAttrPath("resource_defaults")
+	apiObject := &conns.ResourceDefaultsConfig{
+		ResourceTypes: make(map[string]conns.ResourceDefaults),
+		Services:      make(map[string]conns.ResourceDefaults),
+	}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := resourceDefaultsPath.IndexInt(i)
+
+		var defaults conns.ResourceDefaults
+
+		for _, v := range []struct {
+			key     string
+			timeout *time.Duration
+		}{
+			{"create", &defaults.CreateTimeout},
+			{"read", &defaults.ReadTimeout},
+			{"update", &defaults.UpdateTimeout},
+			{"delete", &defaults.DeleteTimeout},
+		} {
+			if s, ok := tfMap[v.key].(string); ok && s != "" {
+				timeout, err := time.ParseDuration(s)
+				if err != nil {
+					diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath.GetAttr(v.key), "parsing timeout: %s", err))
+					continue
+				}
+				*v.timeout = timeout
+			}
+		}
+
+		if v, ok := tfMap["max_retries"].(int); ok {
+			defaults.MaxRetries = v
+		}
+
+		if v, ok := tfMap["retry_mode"].(string); ok && v != "" {
+			mode, err := aws.ParseRetryMode(v)
+			if err != nil {
+				diags = append(diags, errs.NewInvalidValueAttributeError(elementPath.GetAttr("retry_mode"), err.Error()))
+			} else {
+				defaults.RetryMode = mode
+			}
+		}
+
+		resourceType, _ := tfMap["resource_type"].(string)
+		service, _ := tfMap["service"].(string)
+
+		switch {
+		case resourceType != "" && service == "":
+			if _, ok := apiObject.ResourceTypes[resourceType]; ok {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath.GetAttr("resource_type"), "duplicate resource type: %s", resourceType))
+				continue
+			}
+			apiObject.ResourceTypes[resourceType] = defaults
+		case resourceType == "" && service != "":
+			if !slices.Contains(names.ProviderPackages(), service) {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath.GetAttr("service"), "unknown service: %s", service))
+				continue
+			}
+			if _, ok := apiObject.Services[service]; ok {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath.GetAttr("service"), "duplicate service: %s", service))
+				continue
+			}
+			apiObject.Services[service] = defaults
+		default:
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath,
+				"Invalid Attribute Combination",
+				"Exactly one of `resource_type` or `service` must be specified",
+			))
+		}
+	}
+
+	return apiObject, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
 
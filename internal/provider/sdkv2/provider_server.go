@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// NewProviderServer returns a protocol v5 provider server factory for the specified Plugin SDK v2 provider.
+// Resources that opt in with the @CreationPending annotation and whose creation is interrupted after their identifier is known
+// are marked in private state as pending creation.
+// Planned changes are checked against the caller's IAM permissions if IAM permission preflight checks are enabled.
+func NewProviderServer(p *schema.Provider) func() tfprotov5.ProviderServer {
+	iamActions := make(map[string]inttypes.ServicePackageResourceIAMActions)
+	for _, sp := range servicePackages(context.Background()) {
+		for _, r := range sp.SDKResources(context.Background()) {
+			if !r.IAMActions.IsEmpty() {
+				iamActions[r.TypeName] = r.IAMActions
+			}
+		}
+	}
+
+	return func() tfprotov5.ProviderServer {
+		return creationPendingProviderServer{
+			provider: p,
+			ProviderServer: iamPreflightProviderServer{
+				ProviderServer: p.GRPCProvider(),
+				provider:       p,
+				iamActions:     iamActions,
+			},
+		}
+	}
+}
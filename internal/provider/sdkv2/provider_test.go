@@ -7,11 +7,15 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -309,6 +313,77 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandDefaultTags_computed(t *testing.T) { //nolint:paralleltest
+	ctx := t.Context()
+	testcases := map[string]struct {
+		tags                   map[string]any
+		computed               []any
+		expectedTags           map[string]string
+		expectedComputedConfig *tftags.ComputedDefaultConfig
+	}{
+		"computed only": {
+			computed: []any{
+				map[string]any{
+					"provider_version": "ProviderVersion",
+					"resource_type":    "ResourceType",
+				},
+			},
+			expectedTags: map[string]string{},
+			expectedComputedConfig: &tftags.ComputedDefaultConfig{
+				ProviderVersionKey: "ProviderVersion",
+				ResourceTypeKey:    "ResourceType",
+			},
+		},
+		"tags and computed": {
+			tags: map[string]any{
+				"Owner": "my-team",
+			},
+			computed: []any{
+				map[string]any{
+					"provider_version": "",
+					"resource_type":    "ResourceType",
+				},
+			},
+			expectedTags: map[string]string{
+				"Owner": "my-team",
+			},
+			expectedComputedConfig: &tftags.ComputedDefaultConfig{
+				ResourceTypeKey: "ResourceType",
+			},
+		},
+		"no computed": {
+			tags: map[string]any{
+				"Owner": "my-team",
+			},
+			expectedTags: map[string]string{
+				"Owner": "my-team",
+			},
+		},
+	}
+
+	for name, testcase := range testcases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			oldEnv := stashEnv()
+			defer popEnv(oldEnv)
+
+			results := expandDefaultTags(ctx, map[string]any{
+				"computed": testcase.computed,
+				"tags":     testcase.tags,
+			})
+
+			if results == nil {
+				t.Fatal("Expected default tags config, got nil")
+			}
+			if diff := cmp.Diff(results.Tags.Map(), testcase.expectedTags); diff != "" {
+				t.Errorf("unexpected tags diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(results.Computed, testcase.expectedComputedConfig); diff != "" {
+				t.Errorf("unexpected computed config diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := t.Context()
 	testcases := map[string]struct {
@@ -425,6 +500,125 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandResourceDefaults(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfList        []any
+		expected      *conns.ResourceDefaultsConfig
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfList: []any{},
+			expected: &conns.ResourceDefaultsConfig{
+				ResourceTypes: map[string]conns.ResourceDefaults{},
+				Services:      map[string]conns.ResourceDefaults{},
+			},
+		},
+		"resource type and service": {
+			tfList: []any{
+				map[string]any{
+					"resource_type": "aws_db_instance",
+					"create":        "2h",
+					"delete":        "90m",
+				},
+				map[string]any{
+					"service":     names.RDS,
+					"update":      "1h",
+					"max_retries": 50,
+					"retry_mode":  "adaptive",
+				},
+			},
+			expected: &conns.ResourceDefaultsConfig{
+				ResourceTypes: map[string]conns.ResourceDefaults{
+					"aws_db_instance": {
+						CreateTimeout: 2 * time.Hour,
+						DeleteTimeout: 90 * time.Minute,
+					},
+				},
+				Services: map[string]conns.ResourceDefaults{
+					names.RDS: {
+						UpdateTimeout: time.Hour,
+						MaxRetries:    50,
+						RetryMode:     aws.RetryModeAdaptive,
+					},
+				},
+			},
+		},
+		"neither resource type nor service": {
+			tfList: []any{
+				map[string]any{
+					"create": "2h",
+				},
+			},
+			expected: &conns.ResourceDefaultsConfig{
+				ResourceTypes: map[string]conns.ResourceDefaults{},
+				Services:      map[string]conns.ResourceDefaults{},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("resource_defaults").IndexInt(0),
+					"Invalid Attribute Combination",
+					"Exactly one of `resource_type` or `service` must be specified",
+				),
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{
+					"service": "unknown",
+				},
+			},
+			expected: &conns.ResourceDefaultsConfig{
+				ResourceTypes: map[string]conns.ResourceDefaults{},
+				Services:      map[string]conns.ResourceDefaults{},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(cty.GetAttrPath("resource_defaults").IndexInt(0).GetAttr("service"), "unknown service: unknown"),
+			},
+		},
+		"duplicate resource type": {
+			tfList: []any{
+				map[string]any{
+					"resource_type": "aws_db_instance",
+					"create":        "2h",
+				},
+				map[string]any{
+					"resource_type": "aws_db_instance",
+					"create":        "3h",
+				},
+			},
+			expected: &conns.ResourceDefaultsConfig{
+				ResourceTypes: map[string]conns.ResourceDefaults{
+					"aws_db_instance": {
+						CreateTimeout: 2 * time.Hour,
+					},
+				},
+				Services: map[string]conns.ResourceDefaults{},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(cty.GetAttrPath("resource_defaults").IndexInt(1).GetAttr("resource_type"), "duplicate resource type: aws_db_instance"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandResourceDefaults(t.Context(), testcase.tfList)
+
+			if diff := cmp.Diff(testcase.expectedDiags, diags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("Unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testcase.expected, results); diff != "" {
+				t.Errorf("Unexpected resource_defaults diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -16,6 +16,7 @@ import (
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
 // tagsResourceCRUDInterceptor implements transparent tagging on CRUD operations for resources.
@@ -48,6 +49,9 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 		case Create, Update:
 			// Merge the resource's configured tags with any provider configured default_tags.
 			tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)))
+			// Add any provider configured computed default_tags.
+			// These are also passed to service APIs that replace a resource's entire set of tags on update.
+			tags = c.DefaultTagsConfig(ctx).MergeComputedTags(ctx, interceptors.TypeNameFromContext(ctx), version.ProviderVersion, tags)
 			// Remove system tags.
 			tags = tags.IgnoreSystem(sp.ServicePackageName())
 
@@ -63,6 +67,8 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 					// https://github.com/hashicorp/terraform-provider-aws/issues/31180
 					if identifier := r.GetIdentifierSDKv2(ctx, d); identifier != "" {
 						o, n := d.GetChange(names.AttrTagsAll)
+						// Refresh any provider configured computed default_tags.
+						n = c.DefaultTagsConfig(ctx).MergeComputedTags(ctx, interceptors.TypeNameFromContext(ctx), version.ProviderVersion, tftags.New(ctx, n))
 
 						if err := r.UpdateTags(ctx, sp, c, identifier, o, n); err != nil {
 							return sdkdiag.AppendErrorf(diags, "updating tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
@@ -95,8 +101,9 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 				}
 			}
 
-			// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+			// Remove any provider configured ignore_tags, computed default_tags and system tags from those returned from the service API.
+			// Computed default_tags whose keys are also configured on the resource are retained.
+			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx)).RemoveComputedDefaultConfig(c.DefaultTagsConfig(ctx), tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)))
 
 			// The resource's configured tags can now include duplicate tags that have been configured on the provider.
 			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), d, names.AttrTags, nil).Map()); err != nil {
@@ -142,6 +149,8 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 				// if tags_all was computed because not wholly known
 				// Merge the resource's configured tags with any provider configured default_tags.
 				newTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, configTags))
+				// Refresh any provider configured computed default_tags.
+				newTags = c.DefaultTagsConfig(ctx).MergeComputedTags(ctx, interceptors.TypeNameFromContext(ctx), version.ProviderVersion, newTags)
 				// Remove system tags.
 				newTags = newTags.IgnoreSystem(sp.ServicePackageName())
 
@@ -153,8 +162,8 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 					return sdkdiag.AppendErrorf(diags, "listing tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
 				}
 
-				// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-				toAdd := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+				// Remove any provider configured ignore_tags, computed default_tags and system tags from those returned from the service API.
+				toAdd := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx)).RemoveComputedDefaultConfig(c.DefaultTagsConfig(ctx), tftags.New(ctx, configTags))
 
 				// The resource's configured tags can now include duplicate tags that have been configured on the provider.
 				if err := d.Set(names.AttrTags, toAdd.ResolveDuplicates(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), d, names.AttrTags, nil).Map()); err != nil {
@@ -46,6 +46,8 @@ type wrappedResourceOptions struct {
 	bootstrapContext contextFunc
 	interceptors     interceptorInvocations
 	typeName         string
+	// creationPending indicates that the resource resumes waiting on interrupted creates.
+	creationPending bool
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin SDK v2 resource.
@@ -58,6 +60,11 @@ func wrapResource(r *schema.Resource, opts wrappedResourceOptions) {
 		opts: opts,
 	}
 
+	if opts.creationPending {
+		r.CreateWithoutTimeout = createWithCreationPending(r.CreateWithoutTimeout, r.ReadWithoutTimeout)
+		r.ReadWithoutTimeout = readWithCreationPending(r.ReadWithoutTimeout)
+	}
+
 	r.CreateWithoutTimeout = w.create(r.CreateWithoutTimeout)
 	r.ReadWithoutTimeout = w.read(r.ReadWithoutTimeout)
 	r.UpdateWithoutTimeout = w.update(r.UpdateWithoutTimeout)
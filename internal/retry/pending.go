@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"sync"
+)
+
+type interruptedWaitRecorderKey struct{}
+
+// InterruptedWaitRecorder records the first wait for a state change that timed out or was canceled,
+// and the first wait for a state change that ended in an unexpected state.
+type InterruptedWaitRecorder struct {
+	mu              sync.Mutex
+	err             error
+	unexpectedState error
+}
+
+// Err returns the error from the first interrupted wait, or nil if no wait was interrupted.
+func (r *InterruptedWaitRecorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.err
+}
+
+// UnexpectedStateErr returns the error from the first wait that ended in an unexpected state, or nil if no wait did.
+// A create whose wait ends in an unexpected state has typically failed, e.g. the resource's status is "failed".
+func (r *InterruptedWaitRecorder) UnexpectedStateErr() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.unexpectedState
+}
+
+func (r *InterruptedWaitRecorder) record(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err == nil && err != nil {
+		r.err = err
+	}
+}
+
+func (r *InterruptedWaitRecorder) recordUnexpectedState(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.unexpectedState == nil && err != nil {
+		r.unexpectedState = err
+	}
+}
+
+// WithInterruptedWaitRecorder returns a derived context that records any interrupted (timed out or canceled) waits
+// made using that context, and the recorder.
+// The resource CRUD wrappers use this to distinguish a resource that was created but has not yet become ready
+// from a resource that failed to be created.
+func WithInterruptedWaitRecorder(ctx context.Context) (context.Context, *InterruptedWaitRecorder) {
+	r := &InterruptedWaitRecorder{}
+
+	return context.WithValue(ctx, interruptedWaitRecorderKey{}, r), r
+}
+
+func recordInterruptedWait(ctx context.Context, err error) {
+	if r, ok := ctx.Value(interruptedWaitRecorderKey{}).(*InterruptedWaitRecorder); ok {
+		r.record(err)
+	}
+}
+
+func recordUnexpectedState(ctx context.Context, err error) {
+	if r, ok := ctx.Value(interruptedWaitRecorderKey{}).(*InterruptedWaitRecorder); ok {
+		r.recordUnexpectedState(err)
+	}
+}
+
+type creationPendingKey struct{}
+
+// WithCreationPending returns a derived context indicating that a previous create of the resource
+// was interrupted before the resource became ready.
+func WithCreationPending(ctx context.Context) context.Context {
+	return context.WithValue(ctx, creationPendingKey{}, true)
+}
+
+// CreationPending returns whether a previous create of the resource was interrupted before the resource became ready.
+// A resource's Read handler should resume waiting for creation to complete if CreationPending returns true.
+func CreationPending(ctx context.Context) bool {
+	v, ok := ctx.Value(creationPendingKey{}).(bool)
+
+	return ok && v
+}
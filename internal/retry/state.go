@@ -67,6 +67,9 @@ type StateChangeConf = StateChangeConfOf[any, string]
 //
 // Cancellation of the passed in context will cancel the refresh loop.
 //
+// A timeout, cancellation or unexpected state is recorded in any
+// InterruptedWaitRecorder associated with the passed in context.
+//
 // When VCR testing is enabled in replay mode, the DelayFunc is overridden to
 // allow interactions to be replayed with no delay between state change refreshes.
 func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T, error) {
@@ -145,11 +148,14 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 			}
 
 			if !found && len(conf.Pending) > 0 {
-				return t, &UnexpectedStateError{
+				err := &UnexpectedStateError{
 					LastError:     err,
 					State:         string(currentState),
 					ExpectedState: tfslices.Strings(conf.Target),
 				}
+				recordUnexpectedState(ctx, err)
+
+				return t, err
 			}
 
 			// Wait between refreshes using exponential backoff, except when
@@ -164,15 +170,21 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 	if l.Remaining() == 0 {
 		var zero T
 
-		return zero, &TimeoutError{
+		err := &TimeoutError{
 			LastError:     err,
 			LastState:     string(currentState),
 			Timeout:       conf.Timeout,
 			ExpectedState: tfslices.Strings(conf.Target),
 		}
+		recordInterruptedWait(ctx, err)
+
+		return zero, err
 	}
 
-	return t, context.Cause(ctx)
+	err = context.Cause(ctx)
+	recordInterruptedWait(ctx, err)
+
+	return t, err
 }
 
 func (conf *StateChangeConfOf[T, S]) refreshWithTimeout(ctx context.Context, timeout time.Duration) (T, S, error) {
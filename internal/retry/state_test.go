@@ -188,6 +188,56 @@ func TestWaitForState_timeout(t *testing.T) {
 	}
 }
 
+func TestWaitForState_timeoutRecorded(t *testing.T) {
+	t.Parallel()
+
+	conf := &StateChangeConf{
+		Pending: []string{"pending", "incomplete"},
+		Target:  []string{"running"},
+		Refresh: TimeoutStateRefreshFunc(),
+		Timeout: 1 * time.Second,
+	}
+
+	ctx, recorder := WithInterruptedWaitRecorder(t.Context())
+	_, err := conf.WaitForStateContext(ctx)
+
+	if err == nil {
+		t.Fatal("Expected timeout error. No error returned.")
+	}
+
+	if got, want := recorder.Err(), err; got != want { //nolint:errorlint // Explicitly comparing error identity
+		t.Fatalf("Recorded error doesn't match.\nExpected: %v\nGiven: %v\n", want, got)
+	}
+}
+
+func TestWaitForState_unexpectedStateRecorded(t *testing.T) {
+	t.Parallel()
+
+	conf := &StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"running"},
+		Refresh: func(context.Context) (any, string, error) {
+			return struct{}{}, "failed", nil
+		},
+		Timeout: 1 * time.Second,
+	}
+
+	ctx, recorder := WithInterruptedWaitRecorder(t.Context())
+	_, err := conf.WaitForStateContext(ctx)
+
+	if err == nil {
+		t.Fatal("Expected unexpected state error. No error returned.")
+	}
+
+	if got := recorder.Err(); got != nil {
+		t.Fatalf("Expected no interrupted wait, got: %v", got)
+	}
+
+	if got, want := recorder.UnexpectedStateErr(), err; got != want { //nolint:errorlint // Explicitly comparing error identity
+		t.Fatalf("Recorded error doesn't match.\nExpected: %v\nGiven: %v\n", want, got)
+	}
+}
+
 func TestWaitForState_success(t *testing.T) {
 	t.Parallel()
 
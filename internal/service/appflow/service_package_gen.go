@@ -38,6 +38,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrName,
 				inttypes.WithV6_0SDKv2Fix(),
+				inttypes.WithARNFormat("connectorprofile/{name}"),
 			),
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
@@ -53,6 +54,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrName,
 				inttypes.WithV6_0SDKv2Fix(),
+				inttypes.WithARNFormat("flow/{name}"),
 			),
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
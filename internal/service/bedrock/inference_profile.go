@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -349,23 +348,11 @@ type resourceInferenceProfileModelModel struct {
 }
 
 var (
-	_ flex.Expander  = inferenceProfileModelModelSource{}
-	_ flex.Flattener = &inferenceProfileModelModelSource{}
+	_ flex.UnionModel = inferenceProfileModelModelSource{}
 )
 
-func (m inferenceProfileModelModelSource) Expand(ctx context.Context) (any, diag.Diagnostics) {
-	return &awstypes.InferenceProfileModelSourceMemberCopyFrom{
-		Value: flex.StringValueFromFramework(ctx, m.CopyFrom),
-	}, nil
-}
-
-func (m *inferenceProfileModelModelSource) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch val := v.(type) {
-	case *awstypes.InferenceProfileModelSourceMemberCopyFrom:
-		m.CopyFrom = flex.StringValueToFramework(ctx, val.Value)
-		return diags
-
-	default:
-		return diags
+func (inferenceProfileModelModelSource) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.InferenceProfileModelSourceMemberCopyFrom{},
 	}
 }
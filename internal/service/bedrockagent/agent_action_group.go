@@ -514,37 +514,14 @@ type actionGroupExecutorModel struct {
 }
 
 var (
-	_ fwflex.Expander  = actionGroupExecutorModel{}
-	_ fwflex.Flattener = &actionGroupExecutorModel{}
+	_ fwflex.UnionModel = actionGroupExecutorModel{}
 )
 
-func (m actionGroupExecutorModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
-	switch {
-	case !m.CustomControl.IsNull():
-		return &awstypes.ActionGroupExecutorMemberCustomControl{
-			Value: m.CustomControl.ValueEnum(),
-		}, diags
-	case !m.Lambda.IsNull():
-		return &awstypes.ActionGroupExecutorMemberLambda{
-			Value: m.Lambda.ValueString(),
-		}, diags
+func (actionGroupExecutorModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.ActionGroupExecutorMemberCustomControl{},
+		&awstypes.ActionGroupExecutorMemberLambda{},
 	}
-
-	return nil, diags
-}
-
-func (m *actionGroupExecutorModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch t := v.(type) {
-	case awstypes.ActionGroupExecutorMemberCustomControl:
-		m.CustomControl = fwtypes.StringEnumValue(t.Value)
-
-	case awstypes.ActionGroupExecutorMemberLambda:
-		m.Lambda = fwtypes.ARNValue(t.Value)
-
-		return diags
-	}
-
-	return diags
 }
 
 type apiSchemaModel struct {
@@ -553,52 +530,14 @@ type apiSchemaModel struct {
 }
 
 var (
-	_ fwflex.Expander  = apiSchemaModel{}
-	_ fwflex.Flattener = &apiSchemaModel{}
+	_ fwflex.UnionModel = apiSchemaModel{}
 )
 
-func (m apiSchemaModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
-	switch {
-	case !m.Payload.IsNull():
-		return &awstypes.APISchemaMemberPayload{
-			Value: m.Payload.ValueString(),
-		}, diags
-
-	case !m.S3.IsNull():
-		s3IdentifierModel := fwdiag.Must(m.S3.ToPtr(ctx))
-
-		return &awstypes.APISchemaMemberS3{
-			Value: awstypes.S3Identifier{
-				S3BucketName: fwflex.StringFromFramework(ctx, s3IdentifierModel.S3BucketName),
-				S3ObjectKey:  fwflex.StringFromFramework(ctx, s3IdentifierModel.S3ObjectKey),
-			},
-		}, diags
-	}
-
-	return nil, diags
-}
-
-func (m *apiSchemaModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch t := v.(type) {
-	case awstypes.APISchemaMemberPayload:
-		m.Payload = fwflex.StringToFramework(ctx, &t.Value)
-
-		return diags
-
-	case awstypes.APISchemaMemberS3:
-		var model s3IdentifierModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
+func (apiSchemaModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.APISchemaMemberPayload{},
+		&awstypes.APISchemaMemberS3{},
 	}
-
-	return diags
 }
 
 type s3IdentifierModel struct {
@@ -1181,68 +1181,15 @@ type flowDataConnectionConfigurationModel struct {
 	TargetInput  types.String `tfsdk:"target_input"`
 }
 
-func (m *flowConnectionConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch t := v.(type) {
-	case awstypes.FlowConnectionConfigurationMemberData:
-		var model flowDataConnectionConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Data = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowConnectionConfigurationMemberConditional:
-		var model flowConditionalConnectionConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Conditional = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	default:
-		return diags
-	}
-}
-
-func (m flowConnectionConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
-	switch {
-	case !m.Data.IsNull():
-		flowConnectionConfigurationData, d := m.Data.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowConnectionConfigurationMemberData
-		diags.Append(fwflex.Expand(ctx, flowConnectionConfigurationData, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.Conditional.IsNull():
-		flowConnectionConfigurationConditional, d := m.Conditional.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowConnectionConfigurationMemberConditional
-		diags.Append(fwflex.Expand(ctx, flowConnectionConfigurationConditional, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
+var (
+	_ fwflex.UnionModel = flowConnectionConfigurationModel{}
+)
 
-		return &r, diags
+func (flowConnectionConfigurationModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.FlowConnectionConfigurationMemberConditional{},
+		&awstypes.FlowConnectionConfigurationMemberData{},
 	}
-
-	return nil, diags
 }
 
 type flowNodeModel struct {
@@ -1271,343 +1218,26 @@ type flowNodeConfigurationModel struct {
 	Storage   fwtypes.ListNestedObjectValueOf[storageFlowNodeConfigurationModel]   `tfsdk:"storage"`
 }
 
-func (m *flowNodeConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch t := v.(type) {
-	case awstypes.FlowNodeConfigurationMemberAgent:
-		var model agentFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Agent = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberCollector:
-		var model collectorFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Collector = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberCondition:
-		var model conditionFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Condition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberInlineCode:
-		var model inlineCodeFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.InlineCode = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberInput:
-		var model inputFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Input = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberIterator:
-		var model iteratorFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Iterator = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberKnowledgeBase:
-		var model knowledgeBaseFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.KnowledgeBase = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberLambdaFunction:
-		var model lambdaFunctionFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.LambdaFunction = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberLex:
-		var model lexFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Lex = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberOutput:
-		var model outputFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Output = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberPrompt:
-		var model promptFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Prompt = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberRetrieval:
-		var model retrievalFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Retrieval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	case awstypes.FlowNodeConfigurationMemberStorage:
-		var model storageFlowNodeConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Storage = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	default:
-		return diags
-	}
-}
-
-func (m flowNodeConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
-	switch {
-	case !m.Agent.IsNull():
-		flowNodeConfigurationAgent, d := m.Agent.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberAgent
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationAgent, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.Collector.IsNull():
-		flowNodeConfigurationCollector, d := m.Collector.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberCollector
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationCollector, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.Condition.IsNull():
-		flowNodeConfigurationCondition, d := m.Condition.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberCondition
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationCondition, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.InlineCode.IsNull():
-		flowNodeConfigurationInlineCode, d := m.InlineCode.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberInlineCode
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationInlineCode, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.Input.IsNull():
-		flowNodeConfigurationInput, d := m.Input.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberInput
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationInput, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.Iterator.IsNull():
-		flowNodeConfigurationIterator, d := m.Iterator.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberIterator
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationIterator, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.KnowledgeBase.IsNull():
-		flowNodeConfigurationKnowledgeBase, d := m.KnowledgeBase.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberKnowledgeBase
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationKnowledgeBase, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.LambdaFunction.IsNull():
-		flowNodeConfigurationLambdaFunction, d := m.LambdaFunction.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberLambdaFunction
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationLambdaFunction, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.Lex.IsNull():
-		flowNodeConfigurationLex, d := m.Lex.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberLex
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationLex, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.Output.IsNull():
-		flowNodeConfigurationOutput, d := m.Output.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberOutput
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationOutput, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.Prompt.IsNull():
-		flowNodeConfigurationPrompt, d := m.Prompt.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberPrompt
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationPrompt, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.Retrieval.IsNull():
-		flowNodeConfigurationRetrieval, d := m.Retrieval.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberRetrieval
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationRetrieval, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	case !m.Storage.IsNull():
-		flowNodeConfigurationStorage, d := m.Storage.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.FlowNodeConfigurationMemberStorage
-		diags.Append(fwflex.Expand(ctx, flowNodeConfigurationStorage, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
+var (
+	_ fwflex.UnionModel = flowNodeConfigurationModel{}
+)
 
-		return &r, diags
+func (flowNodeConfigurationModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.FlowNodeConfigurationMemberAgent{},
+		&awstypes.FlowNodeConfigurationMemberCollector{},
+		&awstypes.FlowNodeConfigurationMemberCondition{},
+		&awstypes.FlowNodeConfigurationMemberInlineCode{},
+		&awstypes.FlowNodeConfigurationMemberInput{},
+		&awstypes.FlowNodeConfigurationMemberIterator{},
+		&awstypes.FlowNodeConfigurationMemberKnowledgeBase{},
+		&awstypes.FlowNodeConfigurationMemberLambdaFunction{},
+		&awstypes.FlowNodeConfigurationMemberLex{},
+		&awstypes.FlowNodeConfigurationMemberOutput{},
+		&awstypes.FlowNodeConfigurationMemberPrompt{},
+		&awstypes.FlowNodeConfigurationMemberRetrieval{},
+		&awstypes.FlowNodeConfigurationMemberStorage{},
 	}
-
-	return nil, diags
 }
 
 type agentFlowNodeConfigurationModel struct {
@@ -1787,43 +1417,14 @@ type retrievalFlowNodeServiceConfigurationModel struct {
 	S3 fwtypes.ListNestedObjectValueOf[retrievalFlowNodeS3ConfigurationModel] `tfsdk:"s3"`
 }
 
-func (m *retrievalFlowNodeServiceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch t := v.(type) {
-	case awstypes.RetrievalFlowNodeServiceConfigurationMemberS3:
-		var model retrievalFlowNodeS3ConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	default:
-		return diags
-	}
-}
-
-func (m retrievalFlowNodeServiceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
-	switch {
-	case !m.S3.IsNull():
-		retrievalFlowNodeServiceConfigurationS3, d := m.S3.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.RetrievalFlowNodeServiceConfigurationMemberS3
-		diags.Append(fwflex.Expand(ctx, retrievalFlowNodeServiceConfigurationS3, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
+var (
+	_ fwflex.UnionModel = retrievalFlowNodeServiceConfigurationModel{}
+)
 
-		return &r, diags
+func (retrievalFlowNodeServiceConfigurationModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.RetrievalFlowNodeServiceConfigurationMemberS3{},
 	}
-
-	return nil, diags
 }
 
 type retrievalFlowNodeS3ConfigurationModel struct {
@@ -1839,43 +1440,14 @@ type storageFlowNodeServiceConfigurationModel struct {
 	S3 fwtypes.ListNestedObjectValueOf[storageFlowNodeS3ConfigurationModel] `tfsdk:"s3"`
 }
 
-func (m *storageFlowNodeServiceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch t := v.(type) {
-	case awstypes.StorageFlowNodeServiceConfigurationMemberS3:
-		var model storageFlowNodeS3ConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	default:
-		return diags
-	}
-}
-
-func (m storageFlowNodeServiceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
-	switch {
-	case !m.S3.IsNull():
-		storageFlowNodeServiceConfigurationS3, d := m.S3.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.StorageFlowNodeServiceConfigurationMemberS3
-		diags.Append(fwflex.Expand(ctx, storageFlowNodeServiceConfigurationS3, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
+var (
+	_ fwflex.UnionModel = storageFlowNodeServiceConfigurationModel{}
+)
 
-		return &r, diags
+func (storageFlowNodeServiceConfigurationModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.StorageFlowNodeServiceConfigurationMemberS3{},
 	}
-
-	return nil, diags
 }
 
 type storageFlowNodeS3ConfigurationModel struct {
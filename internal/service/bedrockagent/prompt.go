@@ -745,49 +745,13 @@ type promptGenAiResourceModel struct {
 }
 
 var (
-	_ fwflex.Expander  = promptGenAiResourceModel{}
-	_ fwflex.Flattener = &promptGenAiResourceModel{}
+	_ fwflex.UnionModel = promptGenAiResourceModel{}
 )
 
-func (m promptGenAiResourceModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
-	var result any
-	var diags diag.Diagnostics
-
-	switch {
-	case !m.Agent.IsNull():
-		agent, d := m.Agent.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.PromptGenAiResourceMemberAgent
-		diags.Append(fwflex.Expand(ctx, agent, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
+func (promptGenAiResourceModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.PromptGenAiResourceMemberAgent{},
 	}
-
-	return result, diags
-}
-
-func (m *promptGenAiResourceModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	switch v := v.(type) {
-	case awstypes.PromptGenAiResourceMemberAgent:
-		var agent promptAgentResourceModel
-		diags.Append(fwflex.Flatten(ctx, v.Value, &agent)...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Agent = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &agent)
-	}
-
-	return diags
 }
 
 type promptAgentResourceModel struct {
@@ -799,49 +763,13 @@ type promptInferenceConfigurationModel struct {
 }
 
 var (
-	_ fwflex.Expander  = promptInferenceConfigurationModel{}
-	_ fwflex.Flattener = &promptInferenceConfigurationModel{}
+	_ fwflex.UnionModel = promptInferenceConfigurationModel{}
 )
 
-func (m promptInferenceConfigurationModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
-	var result any
-	var diags diag.Diagnostics
-
-	switch {
-	case !m.Text.IsNull():
-		text, d := m.Text.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.PromptInferenceConfigurationMemberText
-		diags.Append(fwflex.Expand(ctx, text, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
-	}
-
-	return result, diags
-}
-
-func (m *promptInferenceConfigurationModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	switch v := v.(type) {
-	case awstypes.PromptInferenceConfigurationMemberText:
-		var text promptModelInferenceConfigurationModel
-		diags.Append(fwflex.Flatten(ctx, v.Value, &text)...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Text = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &text)
+func (promptInferenceConfigurationModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.PromptInferenceConfigurationMemberText{},
 	}
-
-	return diags
 }
 
 type promptModelInferenceConfigurationModel struct {
@@ -862,71 +790,14 @@ type promptTemplateConfigurationModel struct {
 }
 
 var (
-	_ fwflex.Expander  = promptTemplateConfigurationModel{}
-	_ fwflex.Flattener = &promptTemplateConfigurationModel{}
+	_ fwflex.UnionModel = promptTemplateConfigurationModel{}
 )
 
-func (m promptTemplateConfigurationModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
-	var result any
-	var diags diag.Diagnostics
-
-	switch {
-	case !m.Chat.IsNull():
-		chat, d := m.Chat.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.PromptTemplateConfigurationMemberChat
-		diags.Append(fwflex.Expand(ctx, chat, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
-	case !m.Text.IsNull():
-		text, d := m.Text.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.PromptTemplateConfigurationMemberText
-		diags.Append(fwflex.Expand(ctx, text, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
+func (promptTemplateConfigurationModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.PromptTemplateConfigurationMemberChat{},
+		&awstypes.PromptTemplateConfigurationMemberText{},
 	}
-
-	return result, diags
-}
-
-func (m *promptTemplateConfigurationModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	switch v := v.(type) {
-	case awstypes.PromptTemplateConfigurationMemberChat:
-		var chat chatPromptTemplateConfigurationModel
-		diags.Append(fwflex.Flatten(ctx, v.Value, &chat)...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Chat = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &chat)
-	case awstypes.PromptTemplateConfigurationMemberText:
-		var text textPromptTemplateConfigurationModel
-		diags.Append(fwflex.Flatten(ctx, v.Value, &text)...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Text = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &text)
-	}
-
-	return diags
 }
 
 type chatPromptTemplateConfigurationModel struct {
@@ -951,65 +822,14 @@ type contentBlockModel struct {
 }
 
 var (
-	_ fwflex.Expander  = contentBlockModel{}
-	_ fwflex.Flattener = &contentBlockModel{}
+	_ fwflex.UnionModel = contentBlockModel{}
 )
 
-func (m contentBlockModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
-	var result any
-	var diags diag.Diagnostics
-
-	switch {
-	case !m.CachePoint.IsNull():
-		cachePoint, d := m.CachePoint.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.ContentBlockMemberCachePoint
-		diags.Append(fwflex.Expand(ctx, cachePoint, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
-	case !m.Text.IsNull():
-		text, d := m.Text.ToStringValue(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.ContentBlockMemberText
-		diags.Append(fwflex.Expand(ctx, text, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
-	}
-
-	return result, diags
-}
-
-func (m *contentBlockModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	switch v := v.(type) {
-	case awstypes.ContentBlockMemberCachePoint:
-		var cachePoint cachePointBlockModel
-		diags.Append(fwflex.Flatten(ctx, v.Value, &cachePoint)...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.CachePoint = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cachePoint)
-	case awstypes.ContentBlockMemberText:
-		m.Text = types.StringValue(v.Value)
+func (contentBlockModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.ContentBlockMemberCachePoint{},
+		&awstypes.ContentBlockMemberText{},
 	}
-
-	return diags
 }
 
 type cachePointBlockModel struct {
@@ -1022,65 +842,14 @@ type systemContentBlockModel struct {
 }
 
 var (
-	_ fwflex.Expander  = systemContentBlockModel{}
-	_ fwflex.Flattener = &systemContentBlockModel{}
+	_ fwflex.UnionModel = systemContentBlockModel{}
 )
 
-func (m systemContentBlockModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
-	var result any
-	var diags diag.Diagnostics
-
-	switch {
-	case !m.CachePoint.IsNull():
-		cachePoint, d := m.CachePoint.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.SystemContentBlockMemberCachePoint
-		diags.Append(fwflex.Expand(ctx, cachePoint, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
-	case !m.Text.IsNull():
-		text, d := m.Text.ToStringValue(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.SystemContentBlockMemberText
-		diags.Append(fwflex.Expand(ctx, text, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
+func (systemContentBlockModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.SystemContentBlockMemberCachePoint{},
+		&awstypes.SystemContentBlockMemberText{},
 	}
-
-	return result, diags
-}
-
-func (m *systemContentBlockModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	switch v := v.(type) {
-	case awstypes.SystemContentBlockMemberCachePoint:
-		var cachePoint cachePointBlockModel
-		diags.Append(fwflex.Flatten(ctx, v.Value, &cachePoint)...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.CachePoint = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cachePoint)
-	case awstypes.SystemContentBlockMemberText:
-		m.Text = types.StringValue(v.Value)
-	}
-
-	return diags
 }
 
 type toolConfigurationModel struct {
@@ -1094,71 +863,14 @@ type toolModel struct {
 }
 
 var (
-	_ fwflex.Expander  = toolModel{}
-	_ fwflex.Flattener = &toolModel{}
+	_ fwflex.UnionModel = toolModel{}
 )
 
-func (m toolModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
-	var result any
-	var diags diag.Diagnostics
-
-	switch {
-	case !m.CachePoint.IsNull():
-		cachePoint, d := m.CachePoint.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.ToolMemberCachePoint
-		diags.Append(fwflex.Expand(ctx, cachePoint, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
-	case !m.ToolSpec.IsNull():
-		toolSpec, d := m.ToolSpec.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.ToolMemberToolSpec
-		diags.Append(fwflex.Expand(ctx, toolSpec, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
+func (toolModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.ToolMemberCachePoint{},
+		&awstypes.ToolMemberToolSpec{},
 	}
-
-	return result, diags
-}
-
-func (m *toolModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	switch v := v.(type) {
-	case awstypes.ToolMemberCachePoint:
-		var cachePoint cachePointBlockModel
-		diags.Append(fwflex.Flatten(ctx, v.Value, &cachePoint)...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.CachePoint = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cachePoint)
-	case awstypes.ToolMemberToolSpec:
-		var toolSpec toolSpecificationModel
-		diags.Append(fwflex.Flatten(ctx, v.Value, &toolSpec)...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.ToolSpec = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &toolSpec)
-	}
-
-	return diags
 }
 
 type toolSpecificationModel struct {
@@ -1231,93 +943,15 @@ type toolChoiceModel struct {
 }
 
 var (
-	_ fwflex.Expander  = toolChoiceModel{}
-	_ fwflex.Flattener = &toolChoiceModel{}
+	_ fwflex.UnionModel = toolChoiceModel{}
 )
 
-func (m toolChoiceModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
-	var result any
-	var diags diag.Diagnostics
-
-	switch {
-	case !m.Any.IsNull():
-		any_, d := m.Any.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.ToolChoiceMemberAny
-		diags.Append(fwflex.Expand(ctx, any_, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
-	case !m.Auto.IsNull():
-		auto, d := m.Auto.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.ToolChoiceMemberAuto
-		diags.Append(fwflex.Expand(ctx, auto, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
-	case !m.Tool.IsNull():
-		tool, d := m.Tool.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.ToolChoiceMemberTool
-		diags.Append(fwflex.Expand(ctx, tool, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		result = &r
-	}
-
-	return result, diags
-}
-
-func (m *toolChoiceModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	switch v := v.(type) {
-	case awstypes.ToolChoiceMemberAny:
-		var any_ anyToolChoiceModel
-		diags.Append(fwflex.Flatten(ctx, v.Value, &any_)...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Any = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &any_)
-	case awstypes.ToolChoiceMemberAuto:
-		var auto autoToolChoiceModel
-		diags.Append(fwflex.Flatten(ctx, v.Value, &auto)...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Auto = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &auto)
-	case awstypes.ToolChoiceMemberTool:
-		var tool specificToolChoiceModel
-		diags.Append(fwflex.Flatten(ctx, v.Value, &tool)...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.Tool = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tool)
+func (toolChoiceModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.ToolChoiceMemberAny{},
+		&awstypes.ToolChoiceMemberAuto{},
+		&awstypes.ToolChoiceMemberTool{},
 	}
-
-	return diags
 }
 
 type anyToolChoiceModel struct{}
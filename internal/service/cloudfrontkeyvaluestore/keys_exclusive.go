@@ -5,6 +5,7 @@ package cloudfrontkeyvaluestore
 
 import (
 	"context"
+	"fmt"
 	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -25,7 +26,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -42,12 +42,14 @@ const (
 
 // @FrameworkResource("aws_cloudfrontkeyvaluestore_keys_exclusive", name="Keys  Exclusive")
 func newKeysExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &keysExclusiveResource{}, nil
+	r := &keysExclusiveResource{}
+	r.SetExclusiveCollection(r)
+
+	return r, nil
 }
 
 type keysExclusiveResource struct {
-	framework.ResourceWithModel[keysExclusiveResourceModel]
-	framework.WithNoOpDelete
+	framework.ResourceWithExclusiveCollection[keysExclusiveResourceModel, awstypes.ListKeysResponseListItem]
 }
 
 func (r *keysExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
@@ -98,10 +100,74 @@ func (r *keysExclusiveResource) Schema(ctx context.Context, request resource.Sch
 	}
 }
 
-func (r *keysExclusiveResource) syncKeyValuePairs(ctx context.Context, plan *keysExclusiveResourceModel) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (r *keysExclusiveResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state keysExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The key value store's size only changes if the keys are synchronized.
+	if plan.ResourceKeyValuePair.Equal(state.ResourceKeyValuePair) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("total_size_in_bytes"), state.TotalSizeInBytes)...)
+	}
+}
+
+func (r *keysExclusiveResource) ConfiguredMembers(ctx context.Context, data *keysExclusiveResourceModel) ([]awstypes.ListKeysResponseListItem, diag.Diagnostics) {
+	var keyValuePairs []awstypes.ListKeysResponseListItem
+	diags := flex.Expand(ctx, data.ResourceKeyValuePair, &keyValuePairs)
+
+	return keyValuePairs, diags
+}
+
+func (r *keysExclusiveResource) SetMembers(ctx context.Context, data *keysExclusiveResourceModel, keyValuePairs []awstypes.ListKeysResponseListItem) diag.Diagnostics {
+	return flex.Flatten(ctx, keyValuePairs, &data.ResourceKeyValuePair)
+}
+
+func (r *keysExclusiveResource) ErrorSummary(data *keysExclusiveResourceModel, action string, err error) string {
+	return create.ProblemStandardMessage(names.CloudFrontKeyValueStore, action, ResNameKeysExclusive, data.KvsARN.String(), err)
+}
+
+func (r *keysExclusiveResource) ListMembers(ctx context.Context, data *keysExclusiveResourceModel) ([]awstypes.ListKeysResponseListItem, error) {
 	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
-	kvsARN := plan.KvsARN.ValueString()
+
+	kvs, keyValuePairs, err := FindResourceKeyValuePairsForKeyValueStore(ctx, conn, data.KvsARN.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	data.KvsARN = fwtypes.ARNValue(aws.ToString(kvs.KvsARN))
+	data.TotalSizeInBytes = types.Int64Value(aws.ToInt64(kvs.TotalSizeInBytes))
+
+	if data.MaximumBatchSize.IsNull() || data.MaximumBatchSize.ValueInt64() == 0 {
+		data.MaximumBatchSize = types.Int64Value(maxBatchSizeDefault)
+	}
+
+	return keyValuePairs, nil
+}
+
+func (r *keysExclusiveResource) DiffMembers(have, want []awstypes.ListKeysResponseListItem) ([]awstypes.ListKeysResponseListItem, []awstypes.ListKeysResponseListItem) {
+	add, remove, _ := intflex.DiffSlices(have, want, resourceKeyValuePairEqual)
+
+	return add, remove
+}
+
+func (r *keysExclusiveResource) AddMembers(ctx context.Context, data *keysExclusiveResourceModel, keyValuePairs []awstypes.ListKeysResponseListItem) error {
+	return r.ChangeMembers(ctx, data, keyValuePairs, nil)
+}
+
+func (r *keysExclusiveResource) RemoveMembers(ctx context.Context, data *keysExclusiveResourceModel, keyValuePairs []awstypes.ListKeysResponseListItem) error {
+	return r.ChangeMembers(ctx, data, nil, keyValuePairs)
+}
+
+func (r *keysExclusiveResource) ChangeMembers(ctx context.Context, data *keysExclusiveResourceModel, add, remove []awstypes.ListKeysResponseListItem) error {
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+	kvsARN := data.KvsARN.ValueString()
 
 	// Making key changes the etag of the key value store.
 	// Use a mutex serialize actions
@@ -109,29 +175,22 @@ func (r *keysExclusiveResource) syncKeyValuePairs(ctx context.Context, plan *key
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-	kvs, have, err := FindResourceKeyValuePairsForKeyValueStore(ctx, conn, kvsARN)
+	kvs, err := FindKeyValueStoreByARN(ctx, conn, kvsARN)
 	if err != nil {
-		diags.AddError(
-			create.ProblemStandardMessage(names.CloudFrontKeyValueStore, create.ErrActionReading, ResNameKeyValueStore, kvsARN, err),
-			err.Error(),
-		)
-		return diags
-	}
-
-	var want []awstypes.ListKeysResponseListItem
-	diags.Append(flex.Expand(ctx, plan.ResourceKeyValuePair, &want)...)
-	if diags.HasError() {
-		return diags
+		return fmt.Errorf("reading CloudFront KeyValueStore (%s): %w", kvsARN, err)
 	}
 
-	put, del, modify, _ := intflex.DiffSlicesWithModify(have, want, resourceKeyValuePairEqual, resourceKeyValuePairKeyEqual)
-	put = append(put, modify...)
+	// Keys whose values are modified are put, not deleted.
+	put := add
+	del := slices.DeleteFunc(slices.Clone(remove), func(v awstypes.ListKeysResponseListItem) bool {
+		return slices.ContainsFunc(put, func(w awstypes.ListKeysResponseListItem) bool { return resourceKeyValuePairKeyEqual(v, w) })
+	})
 
 	// We need to perform a batched operation in the event of many Key Value Pairs
 	// to stay within AWS service limits
 	//
 	// https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html#limits-keyvaluestores
-	batchSize := int(plan.MaximumBatchSize.ValueInt64())
+	batchSize := int(data.MaximumBatchSize.ValueInt64())
 	etag := kvs.ETag
 	totalSizeInBytes := kvs.TotalSizeInBytes
 
@@ -144,11 +203,7 @@ func (r *keysExclusiveResource) syncKeyValuePairs(ctx context.Context, plan *key
 
 		out, err := conn.UpdateKeys(ctx, &input)
 		if err != nil {
-			diags.AddError(
-				create.ProblemStandardMessage(names.CloudFrontKeyValueStore, create.ErrActionSynchronizing, ResNameKeysExclusive, kvsARN, err),
-				err.Error(),
-			)
-			return diags
+			return err
 		}
 		etag = out.ETag
 		totalSizeInBytes = out.TotalSizeInBytes
@@ -163,88 +218,15 @@ func (r *keysExclusiveResource) syncKeyValuePairs(ctx context.Context, plan *key
 
 		out, err := conn.UpdateKeys(ctx, &input)
 		if err != nil {
-			diags.AddError(
-				create.ProblemStandardMessage(names.CloudFrontKeyValueStore, create.ErrActionSynchronizing, ResNameKeysExclusive, kvsARN, err),
-				err.Error(),
-			)
-			return diags
+			return err
 		}
 		etag = out.ETag
 		totalSizeInBytes = out.TotalSizeInBytes
 	}
 
-	plan.TotalSizeInBytes = flex.Int64ToFramework(ctx, totalSizeInBytes)
-
-	return diags
-}
-
-func (r *keysExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
-	var plan keysExclusiveResourceModel
-	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
-	if response.Diagnostics.HasError() {
-		return
-	}
-
-	response.Diagnostics.Append(r.syncKeyValuePairs(ctx, &plan)...)
-	if response.Diagnostics.HasError() {
-		return
-	}
-
-	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
-}
-
-func (r *keysExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
-	var data keysExclusiveResourceModel
-	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
-	if response.Diagnostics.HasError() {
-		return
-	}
-
-	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
-	kvs, keyPairs, err := FindResourceKeyValuePairsForKeyValueStore(ctx, conn, data.KvsARN.ValueString())
-
-	if tfresource.NotFound(err) {
-		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
-		response.State.RemoveResource(ctx)
-		return
-	}
-
-	if err != nil {
-		response.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.CloudFrontKeyValueStore, create.ErrActionReading, ResNameKeysExclusive, data.KvsARN.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	data.KvsARN = fwtypes.ARNValue(aws.ToString(kvs.KvsARN))
-	data.TotalSizeInBytes = types.Int64Value(aws.ToInt64(kvs.TotalSizeInBytes))
-
-	if data.MaximumBatchSize.IsNull() || data.MaximumBatchSize.ValueInt64() == 0 {
-		data.MaximumBatchSize = types.Int64Value(maxBatchSizeDefault)
-	}
-
-	response.Diagnostics.Append(flex.Flatten(ctx, keyPairs, &data.ResourceKeyValuePair)...)
-	if response.Diagnostics.HasError() {
-		return
-	}
-
-	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
-}
-
-func (r *keysExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
-	var plan keysExclusiveResourceModel
-	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
-	if response.Diagnostics.HasError() {
-		return
-	}
-
-	response.Diagnostics.Append(r.syncKeyValuePairs(ctx, &plan)...)
-	if response.Diagnostics.HasError() {
-		return
-	}
+	data.TotalSizeInBytes = flex.Int64ToFramework(ctx, totalSizeInBytes)
 
-	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+	return nil
 }
 
 func (r *keysExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
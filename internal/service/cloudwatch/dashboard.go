@@ -5,6 +5,7 @@ package cloudwatch
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 
 	"github.com/YakDriver/smarterr"
@@ -47,7 +48,7 @@ func resourceDashboard() *schema.Resource {
 				Type:                  schema.TypeString,
 				Required:              true,
 				ValidateFunc:          validation.StringIsJSON,
-				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressFunc:      suppressEquivalentDashboardBodyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v any) string {
 					json, _ := structure.NormalizeJsonString(v)
@@ -151,3 +152,42 @@ func findDashboardByName(ctx context.Context, conn *cloudwatch.Client, name stri
 
 	return output, nil
 }
+
+// suppressEquivalentDashboardBodyDiffs suppresses differences between semantically equivalent dashboard bodies.
+// CloudWatch does not store properties with null values, so they are ignored.
+func suppressEquivalentDashboardBodyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return verify.JSONStringsEqual(normalizeDashboardBody(old), normalizeDashboardBody(new))
+}
+
+func normalizeDashboardBody(body string) string {
+	var v any
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return body
+	}
+
+	bytes, err := json.Marshal(removeDashboardBodyNulls(v))
+	if err != nil {
+		return body
+	}
+
+	return string(bytes)
+}
+
+func removeDashboardBodyNulls(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if e == nil {
+				delete(v, k)
+				continue
+			}
+			v[k] = removeDashboardBodyNulls(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = removeDashboardBodyNulls(e)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,1220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Dashboard body structure reference:
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html
+
+const (
+	dashboardGridWidth           = 24
+	dashboardWidgetDefaultWidth  = 6
+	dashboardWidgetDefaultHeight = 6
+)
+
+const (
+	dashboardWidgetTypeAlarm    = "alarm"
+	dashboardWidgetTypeExplorer = "explorer"
+	dashboardWidgetTypeLog      = "log"
+	dashboardWidgetTypeMetric   = "metric"
+	dashboardWidgetTypeText     = "text"
+)
+
+var (
+	// Metric math IDs must start with a lowercase letter.
+	dashboardMetricIDRegexp = regexache.MustCompile(`^[a-z][0-9A-Za-z_]*$`)
+	// Metric math function names are upper case, so lower case identifiers in an expression refer to other metrics.
+	dashboardMetricExpressionIDRegexp = regexache.MustCompile(`\b[a-z][0-9A-Za-z_]*\b`)
+	// String literals, such as SEARCH expressions, are not parsed for metric references.
+	dashboardMetricExpressionStringRegexp = regexache.MustCompile(`'[^']*'|"[^"]*"`)
+)
+
+// @FrameworkDataSource("aws_cloudwatch_dashboard_document", name="Dashboard Document")
+// @Region(overrideEnabled=false)
+func newDashboardDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dashboardDocumentDataSource{}, nil
+}
+
+type dashboardDocumentDataSource struct {
+	framework.DataSourceWithModel[dashboardDocumentDataSourceModel]
+}
+
+func (d *dashboardDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	widgetTypeBlockValidators := func(widgetType string) []validator.List {
+		var others []path.Expression
+		for _, v := range []string{dashboardWidgetTypeAlarm, dashboardWidgetTypeExplorer, dashboardWidgetTypeLog, dashboardWidgetTypeMetric, dashboardWidgetTypeText} {
+			if v != widgetType {
+				others = append(others, path.MatchRelative().AtParent().AtName(v))
+			}
+		}
+
+		return []validator.List{
+			listvalidator.SizeAtMost(1),
+			listvalidator.ExactlyOneOf(others...),
+		}
+	}
+	legendPositionAttribute := schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("bottom", "right", "hidden"),
+		},
+	}
+	periodAttribute := schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+	yAxisSideAttribute := schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("left", "right"),
+		},
+	}
+	yAxisBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentYAxisModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"label": schema.StringAttribute{
+						Optional: true,
+					},
+					"max": schema.Float64Attribute{
+						Optional: true,
+					},
+					"min": schema.Float64Attribute{
+						Optional: true,
+					},
+					"show_units": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"end": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			"period_override": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "inherit"),
+				},
+			},
+			"start": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"widget": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentWidgetModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(500),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"height": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 1000),
+							},
+						},
+						"new_row": schema.BoolAttribute{
+							Optional: true,
+						},
+						"width": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, dashboardGridWidth),
+							},
+						},
+						"x": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, dashboardGridWidth-1),
+							},
+						},
+						"y": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						dashboardWidgetTypeAlarm: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentAlarmWidgetModel](ctx),
+							Validators: widgetTypeBlockValidators(dashboardWidgetTypeAlarm),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"alarms": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeBetween(1, 100),
+										},
+									},
+									"sort_by": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("default", "stateUpdatedTimestamp", "timestamp"),
+										},
+									},
+									"states": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.List{
+											listvalidator.ValueStringsAre(stringvalidator.OneOf("ALARM", "INSUFFICIENT_DATA", "OK")),
+										},
+									},
+									"title": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						dashboardWidgetTypeExplorer: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentExplorerWidgetModel](ctx),
+							Validators: widgetTypeBlockValidators(dashboardWidgetTypeExplorer),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"aggregate_by_function": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("AVG", "MAX", "MIN", "SUM"),
+											stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("aggregate_by_key")),
+										},
+									},
+									"aggregate_by_key": schema.StringAttribute{
+										Optional: true,
+									},
+									"legend_position": legendPositionAttribute,
+									"period":          periodAttribute,
+									"rows_per_page": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									"split_by": schema.StringAttribute{
+										Optional: true,
+									},
+									"stacked": schema.BoolAttribute{
+										Optional: true,
+									},
+									"title": schema.StringAttribute{
+										Optional: true,
+									},
+									"view": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("timeSeries", "bar", "pie"),
+										},
+									},
+									"widgets_per_row": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"label": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentExplorerLabelModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrValue: schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+									"metric": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentExplorerMetricModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrMetricName: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrResourceType: schema.StringAttribute{
+													Required: true,
+												},
+												"stat": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						dashboardWidgetTypeLog: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentLogWidgetModel](ctx),
+							Validators: widgetTypeBlockValidators(dashboardWidgetTypeLog),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"log_group_names": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(50),
+										},
+									},
+									"query": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrRegion: schema.StringAttribute{
+										Required: true,
+									},
+									"stacked": schema.BoolAttribute{
+										Optional: true,
+									},
+									"title": schema.StringAttribute{
+										Optional: true,
+									},
+									"view": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("table", "timeSeries", "bar", "pie"),
+										},
+									},
+								},
+							},
+						},
+						dashboardWidgetTypeMetric: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentMetricWidgetModel](ctx),
+							Validators: widgetTypeBlockValidators(dashboardWidgetTypeMetric),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrAccountID: schema.StringAttribute{
+										Optional: true,
+									},
+									"legend_position": legendPositionAttribute,
+									"live_data": schema.BoolAttribute{
+										Optional: true,
+									},
+									"period": periodAttribute,
+									names.AttrRegion: schema.StringAttribute{
+										Required: true,
+									},
+									"set_period_to_time_range": schema.BoolAttribute{
+										Optional: true,
+									},
+									"sparkline": schema.BoolAttribute{
+										Optional: true,
+									},
+									"stacked": schema.BoolAttribute{
+										Optional: true,
+									},
+									"stat": schema.StringAttribute{
+										Optional: true,
+									},
+									"timezone": schema.StringAttribute{
+										Optional: true,
+									},
+									"title": schema.StringAttribute{
+										Optional: true,
+									},
+									"view": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("timeSeries", "singleValue", "gauge", "bar", "pie", "table"),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"horizontal_annotation": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentHorizontalAnnotationModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"color": schema.StringAttribute{
+													Optional: true,
+												},
+												"fill": schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.OneOf("above", "below"),
+													},
+												},
+												"label": schema.StringAttribute{
+													Optional: true,
+												},
+												names.AttrValue: schema.Float64Attribute{
+													Required: true,
+												},
+												"visible": schema.BoolAttribute{
+													Optional: true,
+												},
+												"y_axis": yAxisSideAttribute,
+											},
+										},
+									},
+									"metric_query": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentMetricQueryModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(500),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrAccountID: schema.StringAttribute{
+													Optional: true,
+												},
+												"color": schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.RegexMatches(regexache.MustCompile(`^#[0-9A-Fa-f]{6}$`), "must be a hexadecimal color code"),
+													},
+												},
+												names.AttrExpression: schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 1024),
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("metric")),
+													},
+												},
+												names.AttrID: schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 255),
+														stringvalidator.RegexMatches(dashboardMetricIDRegexp, "must start with a lowercase letter and contain only letters, numbers and underscores"),
+													},
+												},
+												"label": schema.StringAttribute{
+													Optional: true,
+												},
+												"period": periodAttribute,
+												names.AttrRegion: schema.StringAttribute{
+													Optional: true,
+												},
+												"stat": schema.StringAttribute{
+													Optional: true,
+												},
+												"visible": schema.BoolAttribute{
+													Optional: true,
+												},
+												"y_axis": yAxisSideAttribute,
+											},
+											Blocks: map[string]schema.Block{
+												"metric": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentMetricModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"dimensions": schema.MapAttribute{
+																CustomType:  fwtypes.MapOfStringType,
+																ElementType: types.StringType,
+																Optional:    true,
+															},
+															names.AttrMetricName: schema.StringAttribute{
+																Required: true,
+																Validators: []validator.String{
+																	stringvalidator.LengthBetween(1, 255),
+																},
+															},
+															names.AttrNamespace: schema.StringAttribute{
+																Required: true,
+																Validators: []validator.String{
+																	stringvalidator.LengthBetween(1, 255),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"vertical_annotation": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentVerticalAnnotationModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"color": schema.StringAttribute{
+													Optional: true,
+												},
+												"fill": schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.OneOf("before", "after"),
+													},
+												},
+												"label": schema.StringAttribute{
+													Optional: true,
+												},
+												names.AttrValue: schema.StringAttribute{
+													Required: true,
+												},
+												"visible": schema.BoolAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+									"y_axis": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentYAxesModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"left":  yAxisBlock(),
+												"right": yAxisBlock(),
+											},
+										},
+									},
+								},
+							},
+						},
+						dashboardWidgetTypeText: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentTextWidgetModel](ctx),
+							Validators: widgetTypeBlockValidators(dashboardWidgetTypeText),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"background": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("solid", "transparent"),
+										},
+									},
+									"markdown": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dashboardDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dashboardDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := expandDashboardBody(ctx, data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bytes, err := json.MarshalIndent(body, "", "  ")
+
+	if err != nil {
+		response.Diagnostics.AddError("Marshalling dashboard body to JSON", err.Error())
+
+		return
+	}
+
+	data.JSON = types.StringValue(string(bytes))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func expandDashboardBody(ctx context.Context, data dashboardDocumentDataSourceModel) (*dashboardBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	widgets, d := data.Widgets.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject := &dashboardBody{
+		End:            data.End.ValueString(),
+		PeriodOverride: data.PeriodOverride.ValueString(),
+		Start:          data.Start.ValueString(),
+		Widgets:        make([]*dashboardWidget, 0, len(widgets)),
+	}
+
+	layout := &dashboardLayout{}
+	for i, v := range widgets {
+		widget, d := expandDashboardWidget(ctx, v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if err := layout.place(widget, v); err != nil {
+			diags.AddError("Invalid dashboard body", fmt.Sprintf("widget %d: %s", i, err))
+			return nil, diags
+		}
+
+		apiObject.Widgets = append(apiObject.Widgets, widget)
+	}
+
+	return apiObject, diags
+}
+
+func expandDashboardWidget(ctx context.Context, tfObject *dashboardDocumentWidgetModel) (*dashboardWidget, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiObject := &dashboardWidget{}
+
+	if v, d := tfObject.Alarm.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject.Type = dashboardWidgetTypeAlarm
+		apiObject.Properties = &dashboardAlarmWidgetProperties{
+			Alarms: fwflex.ExpandFrameworkStringValueList(ctx, v.Alarms),
+			SortBy: v.SortBy.ValueString(),
+			States: fwflex.ExpandFrameworkStringValueList(ctx, v.States),
+			Title:  v.Title.ValueString(),
+		}
+	}
+
+	if v, d := tfObject.Explorer.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		properties, d := expandDashboardExplorerWidgetProperties(ctx, v)
+		diags.Append(d...)
+		apiObject.Type = dashboardWidgetTypeExplorer
+		apiObject.Properties = properties
+	}
+
+	if v, d := tfObject.Log.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject.Type = dashboardWidgetTypeLog
+		apiObject.Properties = &dashboardLogWidgetProperties{
+			Query:   expandDashboardLogQuery(fwflex.ExpandFrameworkStringValueList(ctx, v.LogGroupNames), v.Query.ValueString()),
+			Region:  v.Region.ValueString(),
+			Stacked: v.Stacked.ValueBoolPointer(),
+			Title:   v.Title.ValueString(),
+			View:    v.View.ValueString(),
+		}
+	}
+
+	if v, d := tfObject.Metric.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		properties, d := expandDashboardMetricWidgetProperties(ctx, v)
+		diags.Append(d...)
+		apiObject.Type = dashboardWidgetTypeMetric
+		apiObject.Properties = properties
+	}
+
+	if v, d := tfObject.Text.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject.Type = dashboardWidgetTypeText
+		apiObject.Properties = &dashboardTextWidgetProperties{
+			Background: v.Background.ValueString(),
+			Markdown:   v.Markdown.ValueString(),
+		}
+	}
+
+	return apiObject, diags
+}
+
+func expandDashboardExplorerWidgetProperties(ctx context.Context, tfObject *dashboardDocumentExplorerWidgetModel) (*dashboardExplorerWidgetProperties, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiObject := &dashboardExplorerWidgetProperties{
+		Period:  tfObject.Period.ValueInt64Pointer(),
+		SplitBy: tfObject.SplitBy.ValueString(),
+		Title:   tfObject.Title.ValueString(),
+	}
+
+	if v := tfObject.AggregateByKey.ValueString(); v != "" {
+		apiObject.AggregateBy = &dashboardExplorerAggregateBy{
+			Func: tfObject.AggregateByFunction.ValueString(),
+			Key:  v,
+		}
+	}
+
+	labels, d := tfObject.Labels.ToSlice(ctx)
+	diags.Append(d...)
+	for _, v := range labels {
+		apiObject.Labels = append(apiObject.Labels, &dashboardExplorerLabel{
+			Key:   v.Key.ValueString(),
+			Value: v.Value.ValueString(),
+		})
+	}
+
+	metrics, d := tfObject.Metrics.ToSlice(ctx)
+	diags.Append(d...)
+	for _, v := range metrics {
+		apiObject.Metrics = append(apiObject.Metrics, &dashboardExplorerMetric{
+			MetricName:   v.MetricName.ValueString(),
+			ResourceType: v.ResourceType.ValueString(),
+			Stat:         v.Stat.ValueString(),
+		})
+	}
+
+	widgetOptions := &dashboardExplorerWidgetOptions{
+		RowsPerPage:   tfObject.RowsPerPage.ValueInt64Pointer(),
+		Stacked:       tfObject.Stacked.ValueBoolPointer(),
+		View:          tfObject.View.ValueString(),
+		WidgetsPerRow: tfObject.WidgetsPerRow.ValueInt64Pointer(),
+	}
+	if v := tfObject.LegendPosition.ValueString(); v != "" {
+		widgetOptions.Legend = &dashboardLegend{
+			Position: v,
+		}
+	}
+	if *widgetOptions != (dashboardExplorerWidgetOptions{}) {
+		apiObject.WidgetOptions = widgetOptions
+	}
+
+	return apiObject, diags
+}
+
+// expandDashboardLogQuery prefixes a CloudWatch Logs Insights query with the log groups to query.
+func expandDashboardLogQuery(logGroupNames []string, query string) string {
+	parts := make([]string, 0, len(logGroupNames)+1)
+	for _, v := range logGroupNames {
+		parts = append(parts, fmt.Sprintf("SOURCE '%s'", v))
+	}
+	parts = append(parts, query)
+
+	return strings.Join(parts, " | ")
+}
+
+func expandDashboardMetricWidgetProperties(ctx context.Context, tfObject *dashboardDocumentMetricWidgetModel) (*dashboardMetricWidgetProperties, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiObject := &dashboardMetricWidgetProperties{
+		AccountID:            tfObject.AccountID.ValueString(),
+		LiveData:             tfObject.LiveData.ValueBoolPointer(),
+		Period:               tfObject.Period.ValueInt64Pointer(),
+		Region:               tfObject.Region.ValueString(),
+		SetPeriodToTimeRange: tfObject.SetPeriodToTimeRange.ValueBoolPointer(),
+		Sparkline:            tfObject.Sparkline.ValueBoolPointer(),
+		Stacked:              tfObject.Stacked.ValueBoolPointer(),
+		Stat:                 tfObject.Stat.ValueString(),
+		Timezone:             tfObject.Timezone.ValueString(),
+		Title:                tfObject.Title.ValueString(),
+		View:                 tfObject.View.ValueString(),
+	}
+
+	if v := tfObject.LegendPosition.ValueString(); v != "" {
+		apiObject.Legend = &dashboardLegend{
+			Position: v,
+		}
+	}
+
+	queries, d := tfObject.MetricQueries.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if err := validateDashboardMetricQueries(queries); err != nil {
+		diags.AddError("Invalid dashboard body", err.Error())
+		return nil, diags
+	}
+
+	for _, v := range queries {
+		metric, d := expandDashboardMetricQuery(ctx, v)
+		diags.Append(d...)
+		apiObject.Metrics = append(apiObject.Metrics, metric)
+	}
+
+	horizontalAnnotations, d := tfObject.HorizontalAnnotations.ToSlice(ctx)
+	diags.Append(d...)
+	verticalAnnotations, d := tfObject.VerticalAnnotations.ToSlice(ctx)
+	diags.Append(d...)
+	if len(horizontalAnnotations) > 0 || len(verticalAnnotations) > 0 {
+		apiObject.Annotations = &dashboardAnnotations{}
+
+		for _, v := range horizontalAnnotations {
+			apiObject.Annotations.Horizontal = append(apiObject.Annotations.Horizontal, &dashboardAnnotation{
+				Color:   v.Color.ValueString(),
+				Fill:    v.Fill.ValueString(),
+				Label:   v.Label.ValueString(),
+				Value:   v.Value.ValueFloat64(),
+				Visible: v.Visible.ValueBoolPointer(),
+				YAxis:   v.YAxis.ValueString(),
+			})
+		}
+
+		for _, v := range verticalAnnotations {
+			apiObject.Annotations.Vertical = append(apiObject.Annotations.Vertical, &dashboardAnnotation{
+				Color:   v.Color.ValueString(),
+				Fill:    v.Fill.ValueString(),
+				Label:   v.Label.ValueString(),
+				Value:   v.Value.ValueString(),
+				Visible: v.Visible.ValueBoolPointer(),
+			})
+		}
+	}
+
+	if v, d := tfObject.YAxis.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject.YAxis = &dashboardYAxes{}
+
+		if v, d := v.Left.ToPtr(ctx); v != nil {
+			diags.Append(d...)
+			apiObject.YAxis.Left = expandDashboardYAxis(v)
+		}
+
+		if v, d := v.Right.ToPtr(ctx); v != nil {
+			diags.Append(d...)
+			apiObject.YAxis.Right = expandDashboardYAxis(v)
+		}
+	}
+
+	return apiObject, diags
+}
+
+// expandDashboardMetricQuery returns a metric widget's metric array entry.
+// A metric is rendered as [Namespace, MetricName, DimensionName, DimensionValue, ..., {rendering properties}]
+// and a metric math expression as [{"expression": ..., rendering properties}].
+func expandDashboardMetricQuery(ctx context.Context, tfObject *dashboardDocumentMetricQueryModel) ([]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	options := make(map[string]any)
+	if v := tfObject.AccountID.ValueString(); v != "" {
+		options["accountId"] = v
+	}
+	if v := tfObject.Color.ValueString(); v != "" {
+		options["color"] = v
+	}
+	if v := tfObject.Expression.ValueString(); v != "" {
+		options[names.AttrExpression] = v
+	}
+	if v := tfObject.ID.ValueString(); v != "" {
+		options[names.AttrID] = v
+	}
+	if v := tfObject.Label.ValueString(); v != "" {
+		options["label"] = v
+	}
+	if v := tfObject.Period.ValueInt64Pointer(); v != nil {
+		options["period"] = v
+	}
+	if v := tfObject.Region.ValueString(); v != "" {
+		options[names.AttrRegion] = v
+	}
+	if v := tfObject.Stat.ValueString(); v != "" {
+		options["stat"] = v
+	}
+	if v := tfObject.Visible.ValueBoolPointer(); v != nil {
+		options["visible"] = v
+	}
+	if v := tfObject.YAxis.ValueString(); v != "" {
+		options["yAxis"] = v
+	}
+
+	var apiObject []any
+
+	if v, d := tfObject.Metric.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject = append(apiObject, v.Namespace.ValueString(), v.MetricName.ValueString())
+
+		dimensions := fwflex.ExpandFrameworkStringValueMap(ctx, v.Dimensions)
+		keys := make([]string, 0, len(dimensions))
+		for k := range dimensions {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			apiObject = append(apiObject, k, dimensions[k])
+		}
+	}
+
+	if len(options) > 0 {
+		apiObject = append(apiObject, options)
+	}
+
+	return apiObject, diags
+}
+
+// validateDashboardMetricQueries checks that metric IDs are unique and that metric math expressions only reference metrics in the same widget.
+func validateDashboardMetricQueries(queries []*dashboardDocumentMetricQueryModel) error {
+	ids := make(map[string]struct{})
+	for _, v := range queries {
+		id := v.ID.ValueString()
+		if id == "" {
+			continue
+		}
+		if _, ok := ids[id]; ok {
+			return fmt.Errorf("duplicate metric ID %q", id)
+		}
+		ids[id] = struct{}{}
+	}
+
+	for _, v := range queries {
+		expression := v.Expression.ValueString()
+		if expression == "" {
+			continue
+		}
+
+		expression = dashboardMetricExpressionStringRegexp.ReplaceAllString(expression, "")
+		for _, ref := range dashboardMetricExpressionIDRegexp.FindAllString(expression, -1) {
+			if ref == v.ID.ValueString() {
+				return fmt.Errorf("metric math expression %q references itself", v.Expression.ValueString())
+			}
+			if _, ok := ids[ref]; !ok {
+				return fmt.Errorf("metric math expression %q references metric ID %q, which does not exist", v.Expression.ValueString(), ref)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandDashboardYAxis(tfObject *dashboardDocumentYAxisModel) *dashboardYAxis {
+	return &dashboardYAxis{
+		Label:     tfObject.Label.ValueString(),
+		Max:       tfObject.Max.ValueFloat64Pointer(),
+		Min:       tfObject.Min.ValueFloat64Pointer(),
+		ShowUnits: tfObject.ShowUnits.ValueBoolPointer(),
+	}
+}
+
+// dashboardLayout places widgets on the dashboard grid.
+// Widgets without an explicit position flow left to right, wrapping to a new row when the grid width is exceeded.
+type dashboardLayout struct {
+	x, y, rowHeight int64
+}
+
+func (l *dashboardLayout) place(apiObject *dashboardWidget, tfObject *dashboardDocumentWidgetModel) error {
+	width, height := int64(dashboardWidgetDefaultWidth), int64(dashboardWidgetDefaultHeight)
+	if v := tfObject.Width.ValueInt64Pointer(); v != nil {
+		width = *v
+	}
+	if v := tfObject.Height.ValueInt64Pointer(); v != nil {
+		height = *v
+	}
+
+	newRow := func() {
+		l.x, l.y, l.rowHeight = 0, l.y+l.rowHeight, 0
+	}
+
+	if tfObject.NewRow.ValueBool() && l.x > 0 {
+		newRow()
+	}
+
+	x := l.x
+	if v := tfObject.X.ValueInt64Pointer(); v != nil {
+		x = *v
+	}
+
+	if x+width > dashboardGridWidth {
+		if !tfObject.X.IsNull() {
+			return fmt.Errorf("x (%d) plus width (%d) must not exceed %d", x, width, dashboardGridWidth)
+		}
+		if !tfObject.Y.IsNull() {
+			return fmt.Errorf("width (%d) does not fit in the row at y (%d); specify x", width, tfObject.Y.ValueInt64())
+		}
+		newRow()
+		x = 0
+	}
+
+	y := l.y
+	if v := tfObject.Y.ValueInt64Pointer(); v != nil {
+		y = *v
+		if y != l.y {
+			l.y, l.rowHeight = y, 0
+		}
+	} else if x < l.x {
+		// An explicit position to the left of the previous widget starts a new row.
+		newRow()
+		y = l.y
+	}
+
+	apiObject.X, apiObject.Y, apiObject.Width, apiObject.Height = x, y, width, height
+	l.x, l.rowHeight = x+width, max(l.rowHeight, height)
+
+	return nil
+}
+
+type dashboardDocumentDataSourceModel struct {
+	End            types.String                                                  `tfsdk:"end"`
+	JSON           types.String                                                  `tfsdk:"json"`
+	PeriodOverride types.String                                                  `tfsdk:"period_override"`
+	Start          types.String                                                  `tfsdk:"start"`
+	Widgets        fwtypes.ListNestedObjectValueOf[dashboardDocumentWidgetModel] `tfsdk:"widget"`
+}
+
+type dashboardDocumentWidgetModel struct {
+	Alarm    fwtypes.ListNestedObjectValueOf[dashboardDocumentAlarmWidgetModel]    `tfsdk:"alarm"`
+	Explorer fwtypes.ListNestedObjectValueOf[dashboardDocumentExplorerWidgetModel] `tfsdk:"explorer"`
+	Height   types.Int64                                                           `tfsdk:"height"`
+	Log      fwtypes.ListNestedObjectValueOf[dashboardDocumentLogWidgetModel]      `tfsdk:"log"`
+	Metric   fwtypes.ListNestedObjectValueOf[dashboardDocumentMetricWidgetModel]   `tfsdk:"metric"`
+	NewRow   types.Bool                                                            `tfsdk:"new_row"`
+	Text     fwtypes.ListNestedObjectValueOf[dashboardDocumentTextWidgetModel]     `tfsdk:"text"`
+	Width    types.Int64                                                           `tfsdk:"width"`
+	X        types.Int64                                                           `tfsdk:"x"`
+	Y        types.Int64                                                           `tfsdk:"y"`
+}
+
+type dashboardDocumentAlarmWidgetModel struct {
+	Alarms fwtypes.ListValueOf[types.String] `tfsdk:"alarms"`
+	SortBy types.String                      `tfsdk:"sort_by"`
+	States fwtypes.ListValueOf[types.String] `tfsdk:"states"`
+	Title  types.String                      `tfsdk:"title"`
+}
+
+type dashboardDocumentExplorerWidgetModel struct {
+	AggregateByFunction types.String                                                          `tfsdk:"aggregate_by_function"`
+	AggregateByKey      types.String                                                          `tfsdk:"aggregate_by_key"`
+	Labels              fwtypes.ListNestedObjectValueOf[dashboardDocumentExplorerLabelModel]  `tfsdk:"label"`
+	LegendPosition      types.String                                                          `tfsdk:"legend_position"`
+	Metrics             fwtypes.ListNestedObjectValueOf[dashboardDocumentExplorerMetricModel] `tfsdk:"metric"`
+	Period              types.Int64                                                           `tfsdk:"period"`
+	RowsPerPage         types.Int64                                                           `tfsdk:"rows_per_page"`
+	SplitBy             types.String                                                          `tfsdk:"split_by"`
+	Stacked             types.Bool                                                            `tfsdk:"stacked"`
+	Title               types.String                                                          `tfsdk:"title"`
+	View                types.String                                                          `tfsdk:"view"`
+	WidgetsPerRow       types.Int64                                                           `tfsdk:"widgets_per_row"`
+}
+
+type dashboardDocumentExplorerLabelModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type dashboardDocumentExplorerMetricModel struct {
+	MetricName   types.String `tfsdk:"metric_name"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Stat         types.String `tfsdk:"stat"`
+}
+
+type dashboardDocumentLogWidgetModel struct {
+	LogGroupNames fwtypes.ListValueOf[types.String] `tfsdk:"log_group_names"`
+	Query         types.String                      `tfsdk:"query"`
+	Region        types.String                      `tfsdk:"region"`
+	Stacked       types.Bool                        `tfsdk:"stacked"`
+	Title         types.String                      `tfsdk:"title"`
+	View          types.String                      `tfsdk:"view"`
+}
+
+type dashboardDocumentMetricWidgetModel struct {
+	AccountID             types.String                                                                `tfsdk:"account_id"`
+	HorizontalAnnotations fwtypes.ListNestedObjectValueOf[dashboardDocumentHorizontalAnnotationModel] `tfsdk:"horizontal_annotation"`
+	LegendPosition        types.String                                                                `tfsdk:"legend_position"`
+	LiveData              types.Bool                                                                  `tfsdk:"live_data"`
+	MetricQueries         fwtypes.ListNestedObjectValueOf[dashboardDocumentMetricQueryModel]          `tfsdk:"metric_query"`
+	Period                types.Int64                                                                 `tfsdk:"period"`
+	Region                types.String                                                                `tfsdk:"region"`
+	SetPeriodToTimeRange  types.Bool                                                                  `tfsdk:"set_period_to_time_range"`
+	Sparkline             types.Bool                                                                  `tfsdk:"sparkline"`
+	Stacked               types.Bool                                                                  `tfsdk:"stacked"`
+	Stat                  types.String                                                                `tfsdk:"stat"`
+	Timezone              types.String                                                                `tfsdk:"timezone"`
+	Title                 types.String                                                                `tfsdk:"title"`
+	VerticalAnnotations   fwtypes.ListNestedObjectValueOf[dashboardDocumentVerticalAnnotationModel]   `tfsdk:"vertical_annotation"`
+	View                  types.String                                                                `tfsdk:"view"`
+	YAxis                 fwtypes.ListNestedObjectValueOf[dashboardDocumentYAxesModel]                `tfsdk:"y_axis"`
+}
+
+type dashboardDocumentHorizontalAnnotationModel struct {
+	Color   types.String  `tfsdk:"color"`
+	Fill    types.String  `tfsdk:"fill"`
+	Label   types.String  `tfsdk:"label"`
+	Value   types.Float64 `tfsdk:"value"`
+	Visible types.Bool    `tfsdk:"visible"`
+	YAxis   types.String  `tfsdk:"y_axis"`
+}
+
+type dashboardDocumentVerticalAnnotationModel struct {
+	Color   types.String `tfsdk:"color"`
+	Fill    types.String `tfsdk:"fill"`
+	Label   types.String `tfsdk:"label"`
+	Value   types.String `tfsdk:"value"`
+	Visible types.Bool   `tfsdk:"visible"`
+}
+
+type dashboardDocumentMetricQueryModel struct {
+	AccountID  types.String                                                  `tfsdk:"account_id"`
+	Color      types.String                                                  `tfsdk:"color"`
+	Expression types.String                                                  `tfsdk:"expression"`
+	ID         types.String                                                  `tfsdk:"id"`
+	Label      types.String                                                  `tfsdk:"label"`
+	Metric     fwtypes.ListNestedObjectValueOf[dashboardDocumentMetricModel] `tfsdk:"metric"`
+	Period     types.Int64                                                   `tfsdk:"period"`
+	Region     types.String                                                  `tfsdk:"region"`
+	Stat       types.String                                                  `tfsdk:"stat"`
+	Visible    types.Bool                                                    `tfsdk:"visible"`
+	YAxis      types.String                                                  `tfsdk:"y_axis"`
+}
+
+type dashboardDocumentMetricModel struct {
+	Dimensions fwtypes.MapOfString `tfsdk:"dimensions"`
+	MetricName types.String        `tfsdk:"metric_name"`
+	Namespace  types.String        `tfsdk:"namespace"`
+}
+
+type dashboardDocumentYAxesModel struct {
+	Left  fwtypes.ListNestedObjectValueOf[dashboardDocumentYAxisModel] `tfsdk:"left"`
+	Right fwtypes.ListNestedObjectValueOf[dashboardDocumentYAxisModel] `tfsdk:"right"`
+}
+
+type dashboardDocumentYAxisModel struct {
+	Label     types.String  `tfsdk:"label"`
+	Max       types.Float64 `tfsdk:"max"`
+	Min       types.Float64 `tfsdk:"min"`
+	ShowUnits types.Bool    `tfsdk:"show_units"`
+}
+
+type dashboardDocumentTextWidgetModel struct {
+	Background types.String `tfsdk:"background"`
+	Markdown   types.String `tfsdk:"markdown"`
+}
+
+type dashboardBody struct {
+	End            string             `json:"end,omitempty"`
+	PeriodOverride string             `json:"periodOverride,omitempty"`
+	Start          string             `json:"start,omitempty"`
+	Widgets        []*dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Type       string `json:"type"`
+	X          int64  `json:"x"`
+	Y          int64  `json:"y"`
+	Width      int64  `json:"width"`
+	Height     int64  `json:"height"`
+	Properties any    `json:"properties"`
+}
+
+type dashboardAlarmWidgetProperties struct {
+	Alarms []string `json:"alarms"`
+	SortBy string   `json:"sortBy,omitempty"`
+	States []string `json:"states,omitempty"`
+	Title  string   `json:"title,omitempty"`
+}
+
+type dashboardExplorerWidgetProperties struct {
+	AggregateBy   *dashboardExplorerAggregateBy   `json:"aggregateBy,omitempty"`
+	Labels        []*dashboardExplorerLabel       `json:"labels"`
+	Metrics       []*dashboardExplorerMetric      `json:"metrics"`
+	Period        *int64                          `json:"period,omitempty"`
+	SplitBy       string                          `json:"splitBy,omitempty"`
+	Title         string                          `json:"title,omitempty"`
+	WidgetOptions *dashboardExplorerWidgetOptions `json:"widgetOptions,omitempty"`
+}
+
+type dashboardExplorerAggregateBy struct {
+	Func string `json:"func,omitempty"`
+	Key  string `json:"key"`
+}
+
+type dashboardExplorerLabel struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+type dashboardExplorerMetric struct {
+	MetricName   string `json:"metricName"`
+	ResourceType string `json:"resourceType"`
+	Stat         string `json:"stat"`
+}
+
+type dashboardExplorerWidgetOptions struct {
+	Legend        *dashboardLegend `json:"legend,omitempty"`
+	RowsPerPage   *int64           `json:"rowsPerPage,omitempty"`
+	Stacked       *bool            `json:"stacked,omitempty"`
+	View          string           `json:"view,omitempty"`
+	WidgetsPerRow *int64           `json:"widgetsPerRow,omitempty"`
+}
+
+type dashboardLogWidgetProperties struct {
+	Query   string `json:"query"`
+	Region  string `json:"region"`
+	Stacked *bool  `json:"stacked,omitempty"`
+	Title   string `json:"title,omitempty"`
+	View    string `json:"view,omitempty"`
+}
+
+type dashboardMetricWidgetProperties struct {
+	AccountID            string                `json:"accountId,omitempty"`
+	Annotations          *dashboardAnnotations `json:"annotations,omitempty"`
+	Legend               *dashboardLegend      `json:"legend,omitempty"`
+	LiveData             *bool                 `json:"liveData,omitempty"`
+	Metrics              [][]any               `json:"metrics"`
+	Period               *int64                `json:"period,omitempty"`
+	Region               string                `json:"region"`
+	SetPeriodToTimeRange *bool                 `json:"setPeriodToTimeRange,omitempty"`
+	Sparkline            *bool                 `json:"sparkline,omitempty"`
+	Stacked              *bool                 `json:"stacked,omitempty"`
+	Stat                 string                `json:"stat,omitempty"`
+	Timezone             string                `json:"timezone,omitempty"`
+	Title                string                `json:"title,omitempty"`
+	View                 string                `json:"view,omitempty"`
+	YAxis                *dashboardYAxes       `json:"yAxis,omitempty"`
+}
+
+type dashboardAnnotations struct {
+	Horizontal []*dashboardAnnotation `json:"horizontal,omitempty"`
+	Vertical   []*dashboardAnnotation `json:"vertical,omitempty"`
+}
+
+type dashboardAnnotation struct {
+	Color   string `json:"color,omitempty"`
+	Fill    string `json:"fill,omitempty"`
+	Label   string `json:"label,omitempty"`
+	Value   any    `json:"value"`
+	Visible *bool  `json:"visible,omitempty"`
+	YAxis   string `json:"yAxis,omitempty"`
+}
+
+type dashboardLegend struct {
+	Position string `json:"position"`
+}
+
+type dashboardYAxes struct {
+	Left  *dashboardYAxis `json:"left,omitempty"`
+	Right *dashboardYAxis `json:"right,omitempty"`
+}
+
+type dashboardYAxis struct {
+	Label     string   `json:"label,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	Min       *float64 `json:"min,omitempty"`
+	ShowUnits *bool    `json:"showUnits,omitempty"`
+}
+
+type dashboardTextWidgetProperties struct {
+	Background string `json:"background,omitempty"`
+	Markdown   string `json:"markdown"`
+}
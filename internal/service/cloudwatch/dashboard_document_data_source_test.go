@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccDashboardDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalidExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_invalidExpression,
+				ExpectError: regexache.MustCompile(`references metric ID "m2", which does not exist`),
+			},
+		},
+	})
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  start = "-PT6H"
+
+  widget {
+    width = 12
+
+    metric {
+      title  = "CPU"
+      region = "us-west-2"
+      stat   = "Average"
+      period = 300
+
+      metric_query {
+        id      = "m1"
+        visible = false
+
+        metric {
+          namespace   = "AWS/EC2"
+          metric_name = "CPUUtilization"
+          dimensions = {
+            InstanceId = "i-1234567890abcdef0"
+          }
+        }
+      }
+
+      metric_query {
+        id         = "e1"
+        expression = "m1 * 100"
+        label      = "CPU (percent)"
+      }
+
+      horizontal_annotation {
+        value = 80
+        label = "High"
+      }
+
+      y_axis {
+        left {
+          min = 0
+          max = 100
+        }
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    text {
+      markdown = "# Hello"
+    }
+  }
+
+  widget {
+    log {
+      title           = "Logs"
+      region          = "us-west-2"
+      log_group_names = ["/aws/lambda/example"]
+      query           = "fields @timestamp, @message | sort @timestamp desc | limit 20"
+    }
+  }
+
+  widget {
+    alarm {
+      title  = "Alarms"
+      alarms = ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:example"]
+    }
+  }
+
+  widget {
+    width  = 24
+    height = 8
+
+    explorer {
+      title                 = "Instances"
+      aggregate_by_key      = "InstanceType"
+      aggregate_by_function = "AVG"
+      view                  = "timeSeries"
+
+      metric {
+        metric_name   = "CPUUtilization"
+        resource_type = "AWS::EC2::Instance"
+        stat          = "Average"
+      }
+
+      label {
+        key   = "Environment"
+        value = "production"
+      }
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceExpectedJSON_basic = `{
+  "start": "-PT6H",
+  "widgets": [
+    {
+      "type": "metric",
+      "x": 0,
+      "y": 0,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "annotations": {
+          "horizontal": [
+            {
+              "label": "High",
+              "value": 80
+            }
+          ]
+        },
+        "metrics": [
+          ["AWS/EC2", "CPUUtilization", "InstanceId", "i-1234567890abcdef0", {"id": "m1", "visible": false}],
+          [{"expression": "m1 * 100", "id": "e1", "label": "CPU (percent)"}]
+        ],
+        "period": 300,
+        "region": "us-west-2",
+        "stat": "Average",
+        "title": "CPU",
+        "yAxis": {
+          "left": {
+            "max": 100,
+            "min": 0
+          }
+        }
+      }
+    },
+    {
+      "type": "text",
+      "x": 12,
+      "y": 0,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "markdown": "# Hello"
+      }
+    },
+    {
+      "type": "log",
+      "x": 0,
+      "y": 6,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "query": "SOURCE '/aws/lambda/example' | fields @timestamp, @message | sort @timestamp desc | limit 20",
+        "region": "us-west-2",
+        "title": "Logs"
+      }
+    },
+    {
+      "type": "alarm",
+      "x": 6,
+      "y": 6,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "alarms": ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:example"],
+        "title": "Alarms"
+      }
+    },
+    {
+      "type": "explorer",
+      "x": 0,
+      "y": 12,
+      "width": 24,
+      "height": 8,
+      "properties": {
+        "aggregateBy": {
+          "func": "AVG",
+          "key": "InstanceType"
+        },
+        "labels": [
+          {
+            "key": "Environment",
+            "value": "production"
+          }
+        ],
+        "metrics": [
+          {
+            "metricName": "CPUUtilization",
+            "resourceType": "AWS::EC2::Instance",
+            "stat": "Average"
+          }
+        ],
+        "title": "Instances",
+        "widgetOptions": {
+          "view": "timeSeries"
+        }
+      }
+    }
+  ]
+}`
+
+const testAccDashboardDocumentDataSourceConfig_invalidExpression = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      region = "us-west-2"
+
+      metric_query {
+        id = "m1"
+
+        metric {
+          namespace   = "AWS/EC2"
+          metric_name = "CPUUtilization"
+        }
+      }
+
+      metric_query {
+        id         = "e1"
+        expression = "m2 * 2"
+      }
+    }
+  }
+}
+`
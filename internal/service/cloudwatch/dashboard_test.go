@@ -78,6 +78,33 @@ func TestAccCloudWatchDashboard_disappears(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchDashboard_document(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_document(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDashboardExists(ctx context.Context, n string, v *cloudwatch.GetDashboardOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -168,3 +195,55 @@ EOF
 }
 `, rName, body)
 }
+
+func testAccDashboardConfig_document(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width = 12
+
+    metric {
+      region = data.aws_region.current.region
+
+      metric_query {
+        id = "m1"
+
+        metric {
+          namespace   = "AWS/Lambda"
+          metric_name = "Errors"
+        }
+      }
+
+      metric_query {
+        id = "m2"
+
+        metric {
+          namespace   = "AWS/Lambda"
+          metric_name = "Invocations"
+        }
+      }
+
+      metric_query {
+        expression = "100 * m1 / m2"
+        label      = "Error rate"
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    text {
+      markdown = "Hi there from Terraform: CloudWatch"
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
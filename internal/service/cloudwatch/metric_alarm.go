@@ -32,6 +32,7 @@ import (
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cloudwatch/types;awstypes;awstypes.MetricAlarm")
 // @IdentityAttribute("alarm_name")
+// @ArnFormat("alarm:{alarm_name}", attribute="arn")
 // @Testing(idAttrDuplicates="alarm_name")
 // @Testing(preIdentityVersion="v6.7.0")
 func resourceMetricAlarm() *schema.Resource {
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connect_integration_association", name="Integration Association")
+// @IdentityAttribute("instance_id")
+// @IdentityAttribute("integration_association_id")
+// @ImportIDHandler("integrationAssociationImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/connect/types;awstypes;awstypes.IntegrationAssociationSummary")
+func newIntegrationAssociationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &integrationAssociationResource{}
+
+	return r, nil
+}
+
+type integrationAssociationResource struct {
+	framework.ResourceWithModel[integrationAssociationResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByIdentity
+}
+
+func (r *integrationAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrInstanceID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration_association_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IntegrationType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_application_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_application_url": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SourceType](),
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *integrationAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data integrationAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectClient(ctx)
+
+	instanceID, integrationARN := fwflex.StringValueFromFramework(ctx, data.InstanceID), fwflex.StringValueFromFramework(ctx, data.IntegrationARN)
+	var input connect.CreateIntegrationAssociationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateIntegrationAssociation(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Instance (%s) Integration (%s) Association", instanceID, integrationARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.IntegrationAssociationARN = fwflex.StringToFramework(ctx, output.IntegrationAssociationArn)
+	data.IntegrationAssociationID = fwflex.StringToFramework(ctx, output.IntegrationAssociationId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *integrationAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data integrationAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectClient(ctx)
+
+	instanceID, integrationAssociationID := fwflex.StringValueFromFramework(ctx, data.InstanceID), fwflex.StringValueFromFramework(ctx, data.IntegrationAssociationID)
+	output, err := findIntegrationAssociationByTwoPartKey(ctx, conn, instanceID, integrationAssociationID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Instance (%s) Integration Association (%s)", instanceID, integrationAssociationID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *integrationAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data integrationAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectClient(ctx)
+
+	instanceID, integrationAssociationID := fwflex.StringValueFromFramework(ctx, data.InstanceID), fwflex.StringValueFromFramework(ctx, data.IntegrationAssociationID)
+	input := connect.DeleteIntegrationAssociationInput{
+		InstanceId:               aws.String(instanceID),
+		IntegrationAssociationId: aws.String(integrationAssociationID),
+	}
+	_, err := conn.DeleteIntegrationAssociation(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Instance (%s) Integration Association (%s)", instanceID, integrationAssociationID), err.Error())
+
+		return
+	}
+}
+
+func findIntegrationAssociationByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, integrationAssociationID string) (*awstypes.IntegrationAssociationSummary, error) {
+	input := connect.ListIntegrationAssociationsInput{
+		InstanceId: aws.String(instanceID),
+	}
+
+	return findIntegrationAssociation(ctx, conn, &input, func(v *awstypes.IntegrationAssociationSummary) bool {
+		return aws.ToString(v.IntegrationAssociationId) == integrationAssociationID
+	})
+}
+
+func findIntegrationAssociation(ctx context.Context, conn *connect.Client, input *connect.ListIntegrationAssociationsInput, filter tfslices.Predicate[*awstypes.IntegrationAssociationSummary]) (*awstypes.IntegrationAssociationSummary, error) {
+	output, err := findIntegrationAssociations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findIntegrationAssociations(ctx context.Context, conn *connect.Client, input *connect.ListIntegrationAssociationsInput, filter tfslices.Predicate[*awstypes.IntegrationAssociationSummary]) ([]awstypes.IntegrationAssociationSummary, error) {
+	var output []awstypes.IntegrationAssociationSummary
+
+	pages := connect.NewListIntegrationAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.IntegrationAssociationSummaryList {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type integrationAssociationResourceModel struct {
+	framework.WithRegionModel
+	InstanceID                types.String                                 `tfsdk:"instance_id"`
+	IntegrationARN            fwtypes.ARN                                  `tfsdk:"integration_arn"`
+	IntegrationAssociationARN types.String                                 `tfsdk:"arn"`
+	IntegrationAssociationID  types.String                                 `tfsdk:"integration_association_id"`
+	IntegrationType           fwtypes.StringEnum[awstypes.IntegrationType] `tfsdk:"integration_type"`
+	SourceApplicationName     types.String                                 `tfsdk:"source_application_name"`
+	SourceApplicationURL      types.String                                 `tfsdk:"source_application_url"`
+	SourceType                fwtypes.StringEnum[awstypes.SourceType]      `tfsdk:"source_type"`
+}
+
+var _ inttypes.ImportIDParser = integrationAssociationImportID{}
+
+type integrationAssociationImportID struct{}
+
+func (integrationAssociationImportID) Parse(id string) (string, map[string]string, error) {
+	const (
+		integrationAssociationIDParts = 2
+	)
+	parts, err := intflex.ExpandResourceId(id, integrationAssociationIDParts, false)
+
+	if err != nil {
+		return "", nil, fmt.Errorf("id %q should be in the format <instance-id>%s<integration-association-id>", id, intflex.ResourceIdSeparator)
+	}
+
+	result := map[string]string{
+		names.AttrInstanceID:         parts[0],
+		"integration_association_id": parts[1],
+	}
+
+	return id, result, nil
+}
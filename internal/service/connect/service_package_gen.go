@@ -23,6 +23,20 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
 	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newIntegrationAssociationResource,
+			TypeName: "aws_connect_integration_association",
+			Name:     "Integration Association",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalParameterizedIdentity([]inttypes.IdentityAttribute{
+				inttypes.StringIdentityAttribute(names.AttrInstanceID, true),
+				inttypes.StringIdentityAttribute("integration_association_id", true),
+			}),
+			Import: inttypes.FrameworkImport{
+				WrappedImport: true,
+				ImportID:      integrationAssociationImportID{},
+			},
+		},
 		{
 			Factory:  newPhoneNumberContactFlowAssociationResource,
 			TypeName: "aws_connect_phone_number_contact_flow_association",
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Serialized acceptance tests due to Connect Cases domain limits.
+func TestAccConnectCases_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"Domain": {
+			acctest.CtBasic:      testAccDomain_basic,
+			acctest.CtDisappears: testAccDomain_disappears,
+			"tags":               testAccDomain_tags,
+		},
+		"Field": {
+			acctest.CtBasic:      testAccField_basic,
+			acctest.CtDisappears: testAccField_disappears,
+			"options":            testAccField_options,
+		},
+		"Layout": {
+			acctest.CtBasic:      testAccLayout_basic,
+			acctest.CtDisappears: testAccLayout_disappears,
+			"update":             testAccLayout_update,
+		},
+		"Template": {
+			acctest.CtBasic:      testAccTemplate_basic,
+			acctest.CtDisappears: testAccTemplate_disappears,
+			"requiredFields":     testAccTemplate_requiredFields,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccDomainScopedImportStateIDFunc(n, attrName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["domain_id"], rs.Primary.Attributes[attrName]), nil
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_domain", name="Domain")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("domain_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/connectcases;connectcases.GetDomainOutput")
+func newDomainResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &domainResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type domainResource struct {
+	framework.ResourceWithModel[domainResourceModel]
+	framework.WithImportByIdentity
+	framework.WithTimeouts
+}
+
+func (r *domainResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"domain_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DomainStatus](),
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *domainResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data domainResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	name := data.Name.ValueString()
+	var input connectcases.CreateDomainInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateDomain(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Domain (%s)", name), err.Error())
+
+		return
+	}
+
+	domainID := aws.ToString(output.DomainId)
+	domain, err := waitDomainCreated(ctx, conn, domainID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("domain_id"), domainID) // Set 'domain_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Connect Cases Domain (%s) create", domainID), err.Error())
+
+		return
+	}
+
+	if err := createTags(ctx, conn, aws.ToString(output.DomainArn), getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root("domain_id"), domainID) // Set 'domain_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("setting Connect Cases Domain (%s) tags", domainID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.DomainARN = fwflex.StringToFramework(ctx, domain.DomainArn)
+	data.DomainID = fwflex.StringToFramework(ctx, domain.DomainId)
+	data.DomainStatus = fwtypes.StringEnumValue(domain.DomainStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *domainResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data domainResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID := data.DomainID.ValueString()
+	output, err := findDomainByID(ctx, conn, domainID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Domain (%s)", domainID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *domainResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data domainResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID := data.DomainID.ValueString()
+	input := connectcases.DeleteDomainInput{
+		DomainId: aws.String(domainID),
+	}
+	_, err := conn.DeleteDomain(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Domain (%s)", domainID), err.Error())
+
+		return
+	}
+
+	if _, err := waitDomainDeleted(ctx, conn, domainID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Connect Cases Domain (%s) delete", domainID), err.Error())
+
+		return
+	}
+}
+
+func findDomainByID(ctx context.Context, conn *connectcases.Client, id string) (*connectcases.GetDomainOutput, error) {
+	input := connectcases.GetDomainInput{
+		DomainId: aws.String(id),
+	}
+	output, err := conn.GetDomain(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDomain(conn *connectcases.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findDomainByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DomainStatus), nil
+	}
+}
+
+func waitDomainCreated(ctx context.Context, conn *connectcases.Client, id string, timeout time.Duration) (*connectcases.GetDomainOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DomainStatusCreationInProgress),
+		Target:  enum.Slice(awstypes.DomainStatusActive),
+		Refresh: statusDomain(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectcases.GetDomainOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDomainDeleted(ctx context.Context, conn *connectcases.Client, id string, timeout time.Duration) (*connectcases.GetDomainOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DomainStatusActive, awstypes.DomainStatusCreationInProgress, awstypes.DomainStatusCreationFailed),
+		Target:  []string{},
+		Refresh: statusDomain(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectcases.GetDomainOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type domainResourceModel struct {
+	framework.WithRegionModel
+	DomainARN    types.String                              `tfsdk:"arn"`
+	DomainID     types.String                              `tfsdk:"domain_id"`
+	DomainStatus fwtypes.StringEnum[awstypes.DomainStatus] `tfsdk:"domain_status"`
+	Name         types.String                              `tfsdk:"name"`
+	Tags         tftags.Map                                `tfsdk:"tags"`
+	TagsAll      tftags.Map                                `tfsdk:"tags_all"`
+	Timeouts     timeouts.Value                            `tfsdk:"timeouts"`
+}
@@ -0,0 +1,520 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	fieldOptionsMaxBatchSize = 50
+)
+
+// @FrameworkResource("aws_connectcases_field", name="Field")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("domain_id")
+// @IdentityAttribute("field_id")
+// @ImportIDHandler("fieldImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/connectcases/types;awstypes;awstypes.GetFieldResponse")
+func newFieldResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &fieldResource{}
+
+	return r, nil
+}
+
+type fieldResource struct {
+	framework.ResourceWithModel[fieldResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *fieldResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FieldNamespace](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FieldType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"option": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[fieldOptionModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(500),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"active": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *fieldResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data fieldResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsUnknown() || data.Option.IsUnknown() {
+		return
+	}
+
+	if len(data.Option.Elements()) > 0 && data.Type.ValueEnum() != awstypes.FieldTypeSingleSelect {
+		response.Diagnostics.AddAttributeError(
+			path.Root("option"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Options can only be specified for fields of type %q", awstypes.FieldTypeSingleSelect),
+		)
+	}
+}
+
+func (r *fieldResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data fieldResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, name := data.DomainID.ValueString(), data.Name.ValueString()
+	var input connectcases.CreateFieldInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateField(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Domain (%s) Field (%s)", domainID, name), err.Error())
+
+		return
+	}
+
+	fieldID := aws.ToString(output.FieldId)
+	data.FieldARN = fwflex.StringToFramework(ctx, output.FieldArn)
+	data.FieldID = fwflex.StringValueToFramework(ctx, fieldID)
+
+	if err := createTags(ctx, conn, aws.ToString(output.FieldArn), getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root("domain_id"), domainID) // Set 'domain_id' and 'field_id' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root("field_id"), fieldID)
+		response.Diagnostics.AddError(fmt.Sprintf("setting Connect Cases Domain (%s) Field (%s) tags", domainID, fieldID), err.Error())
+
+		return
+	}
+
+	var options []awstypes.FieldOption
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.Option, &options)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putFieldOptions(ctx, conn, domainID, fieldID, options); err != nil {
+		response.State.SetAttribute(ctx, path.Root("domain_id"), domainID) // Set 'domain_id' and 'field_id' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root("field_id"), fieldID)
+		response.Diagnostics.AddError(fmt.Sprintf("putting Connect Cases Domain (%s) Field (%s) options", domainID, fieldID), err.Error())
+
+		return
+	}
+
+	field, err := findFieldByTwoPartKey(ctx, conn, domainID, fieldID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Domain (%s) Field (%s)", domainID, fieldID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Namespace = fwtypes.StringEnumValue(field.Namespace)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *fieldResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fieldResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, fieldID := data.DomainID.ValueString(), data.FieldID.ValueString()
+	output, err := findFieldByTwoPartKey(ctx, conn, domainID, fieldID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Domain (%s) Field (%s)", domainID, fieldID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if output.Type == awstypes.FieldTypeSingleSelect {
+		var stateOptions []awstypes.FieldOption
+		response.Diagnostics.Append(fwflex.Expand(ctx, data.Option, &stateOptions)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		options, err := findFieldOptionsByTwoPartKey(ctx, conn, domainID, fieldID)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Domain (%s) Field (%s) options", domainID, fieldID), err.Error())
+
+			return
+		}
+
+		// Options cannot be deleted, only deactivated.
+		// Ignore inactive options that are not already being tracked.
+		options = tfslices.Filter(options, func(v awstypes.FieldOption) bool {
+			return aws.ToBool(v.Active) || slices.ContainsFunc(stateOptions, func(o awstypes.FieldOption) bool {
+				return aws.ToString(o.Value) == aws.ToString(v.Value)
+			})
+		})
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, options, &data.Option)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *fieldResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old fieldResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, fieldID := new.DomainID.ValueString(), new.FieldID.ValueString()
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) {
+		var input connectcases.UpdateFieldInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateField(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Connect Cases Domain (%s) Field (%s)", domainID, fieldID), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Option.Equal(old.Option) {
+		var newOptions, oldOptions []awstypes.FieldOption
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.Option, &newOptions)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, old.Option, &oldOptions)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Deactivate any removed options.
+		for _, v := range oldOptions {
+			if !slices.ContainsFunc(newOptions, func(o awstypes.FieldOption) bool {
+				return aws.ToString(o.Value) == aws.ToString(v.Value)
+			}) {
+				v.Active = aws.Bool(false)
+				newOptions = append(newOptions, v)
+			}
+		}
+
+		if err := putFieldOptions(ctx, conn, domainID, fieldID, newOptions); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("putting Connect Cases Domain (%s) Field (%s) options", domainID, fieldID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *fieldResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data fieldResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, fieldID := data.DomainID.ValueString(), data.FieldID.ValueString()
+	input := connectcases.DeleteFieldInput{
+		DomainId: aws.String(domainID),
+		FieldId:  aws.String(fieldID),
+	}
+	_, err := conn.DeleteField(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Domain (%s) Field (%s)", domainID, fieldID), err.Error())
+
+		return
+	}
+}
+
+func putFieldOptions(ctx context.Context, conn *connectcases.Client, domainID, fieldID string, options []awstypes.FieldOption) error {
+	for chunk := range slices.Chunk(options, fieldOptionsMaxBatchSize) {
+		input := connectcases.BatchPutFieldOptionsInput{
+			DomainId: aws.String(domainID),
+			FieldId:  aws.String(fieldID),
+			Options:  chunk,
+		}
+		output, err := conn.BatchPutFieldOptions(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		var optionErrs []error
+		for _, v := range output.Errors {
+			optionErrs = append(optionErrs, fmt.Errorf("%s: %s: %s", aws.ToString(v.Value), aws.ToString(v.ErrorCode), aws.ToString(v.Message)))
+		}
+		if err := errors.Join(optionErrs...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findFieldByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, fieldID string) (*awstypes.GetFieldResponse, error) {
+	input := connectcases.BatchGetFieldInput{
+		DomainId: aws.String(domainID),
+		Fields: []awstypes.FieldIdentifier{{
+			Id: aws.String(fieldID),
+		}},
+	}
+	output, err := conn.BatchGetField(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Errors {
+		if aws.ToString(v.Id) == fieldID {
+			err := fmt.Errorf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.Message))
+
+			if aws.ToString(v.ErrorCode) == "ResourceNotFoundException" {
+				return nil, &retry.NotFoundError{
+					LastError: err,
+				}
+			}
+
+			return nil, err
+		}
+	}
+
+	field, err := tfresource.AssertSingleValueResult(output.Fields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if field.Deleted {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return field, nil
+}
+
+func findFieldOptionsByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, fieldID string) ([]awstypes.FieldOption, error) {
+	input := connectcases.ListFieldOptionsInput{
+		DomainId: aws.String(domainID),
+		FieldId:  aws.String(fieldID),
+	}
+	var output []awstypes.FieldOption
+
+	pages := connectcases.NewListFieldOptionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Options...)
+	}
+
+	return output, nil
+}
+
+type fieldResourceModel struct {
+	framework.WithRegionModel
+	Description types.String                                     `tfsdk:"description"`
+	DomainID    types.String                                     `tfsdk:"domain_id"`
+	FieldARN    types.String                                     `tfsdk:"arn"`
+	FieldID     types.String                                     `tfsdk:"field_id"`
+	Name        types.String                                     `tfsdk:"name"`
+	Namespace   fwtypes.StringEnum[awstypes.FieldNamespace]      `tfsdk:"namespace"`
+	Option      fwtypes.SetNestedObjectValueOf[fieldOptionModel] `tfsdk:"option"`
+	Tags        tftags.Map                                       `tfsdk:"tags"`
+	TagsAll     tftags.Map                                       `tfsdk:"tags_all"`
+	Type        fwtypes.StringEnum[awstypes.FieldType]           `tfsdk:"type"`
+}
+
+type fieldOptionModel struct {
+	Active types.Bool   `tfsdk:"active"`
+	Name   types.String `tfsdk:"name"`
+	Value  types.String `tfsdk:"value"`
+}
+
+var _ inttypes.ImportIDParser = fieldImportID{}
+
+type fieldImportID struct{}
+
+func (fieldImportID) Parse(id string) (string, map[string]string, error) {
+	return parseDomainScopedImportID(id, "field_id")
+}
+
+// parseDomainScopedImportID parses an import ID of the form <domain-id>,<resource-id>.
+func parseDomainScopedImportID(id, attrName string) (string, map[string]string, error) {
+	const (
+		domainScopedIDParts = 2
+	)
+	parts, err := intflex.ExpandResourceId(id, domainScopedIDParts, false)
+
+	if err != nil {
+		return "", nil, fmt.Errorf("id %q should be in the format <domain-id>%s<%s>", id, intflex.ResourceIdSeparator, strings.ReplaceAll(attrName, "_", "-"))
+	}
+
+	result := map[string]string{
+		"domain_id": parts[0],
+		attrName:    parts[1],
+	}
+
+	return id, result, nil
+}
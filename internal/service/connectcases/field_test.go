@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfconnectcases "github.com/hashicorp/terraform-provider-aws/internal/service/connectcases"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccField_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.GetFieldResponse
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_field.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFieldDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldConfig_basic(rName, "Text"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFieldExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_connectcases_domain.test", "domain_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "field_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrNamespace, "Custom"),
+					resource.TestCheckResourceAttr(resourceName, "option.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "Text"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccDomainScopedImportStateIDFunc(resourceName, "field_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "field_id",
+			},
+		},
+	})
+}
+
+func testAccField_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.GetFieldResponse
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_field.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFieldDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldConfig_basic(rName, "Text"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFieldExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfconnectcases.ResourceField, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccField_options(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.GetFieldResponse
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_field.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFieldDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldConfig_options(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFieldExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Priority"),
+					resource.TestCheckResourceAttr(resourceName, "option.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "option.*", map[string]string{
+						"active":        acctest.CtTrue,
+						names.AttrName:  "High",
+						names.AttrValue: "high",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "option.*", map[string]string{
+						"active":        acctest.CtTrue,
+						names.AttrName:  "Low",
+						names.AttrValue: "low",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "SingleSelect"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccDomainScopedImportStateIDFunc(resourceName, "field_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "field_id",
+			},
+			{
+				Config: testAccFieldConfig_optionsUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFieldExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Case priority"),
+					resource.TestCheckResourceAttr(resourceName, "option.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "option.*", map[string]string{
+						"active":        acctest.CtTrue,
+						names.AttrName:  "High",
+						names.AttrValue: "high",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "option.*", map[string]string{
+						"active":        acctest.CtFalse,
+						names.AttrName:  "Medium",
+						names.AttrValue: "medium",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFieldDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ConnectCasesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connectcases_field" {
+				continue
+			}
+
+			_, err := tfconnectcases.FindFieldByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["field_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Cases Field %s still exists", rs.Primary.Attributes["field_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFieldExists(ctx context.Context, t *testing.T, n string, v *awstypes.GetFieldResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ConnectCasesClient(ctx)
+
+		output, err := tfconnectcases.FindFieldByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["field_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFieldConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connectcases_domain" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccFieldConfig_basic(rName, fieldType string) string {
+	return acctest.ConfigCompose(testAccFieldConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_field" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = %[1]q
+  type      = %[2]q
+}
+`, rName, fieldType))
+}
+
+func testAccFieldConfig_options(rName string) string {
+	return acctest.ConfigCompose(testAccFieldConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_field" "test" {
+  domain_id   = aws_connectcases_domain.test.domain_id
+  name        = %[1]q
+  description = "Priority"
+  type        = "SingleSelect"
+
+  option {
+    name  = "High"
+    value = "high"
+  }
+
+  option {
+    name  = "Low"
+    value = "low"
+  }
+}
+`, rName))
+}
+
+func testAccFieldConfig_optionsUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccFieldConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_field" "test" {
+  domain_id   = aws_connectcases_domain.test.domain_id
+  name        = %[1]q
+  description = "Case priority"
+  type        = "SingleSelect"
+
+  option {
+    name  = "High"
+    value = "high"
+  }
+
+  option {
+    active = false
+    name   = "Medium"
+    value  = "medium"
+  }
+}
+`, rName))
+}
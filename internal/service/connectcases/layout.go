@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_layout", name="Layout")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("domain_id")
+// @IdentityAttribute("layout_id")
+// @ImportIDHandler("layoutImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/connectcases;connectcases.GetLayoutOutput")
+func newLayoutResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &layoutResource{}
+
+	return r, nil
+}
+
+type layoutResource struct {
+	framework.ResourceWithModel[layoutResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *layoutResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	layoutSectionsBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[layoutSectionsModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Blocks: map[string]schema.Block{
+					"section": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[sectionModel](ctx),
+						NestedObject: schema.NestedBlockObject{
+							Blocks: map[string]schema.Block{
+								"field_group": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[fieldGroupModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											names.AttrName: schema.StringAttribute{
+												Optional: true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(100),
+												},
+											},
+										},
+										Blocks: map[string]schema.Block{
+											names.AttrField: schema.ListNestedBlock{
+												CustomType: fwtypes.NewListNestedObjectTypeOf[fieldItemModel](ctx),
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(220),
+												},
+												NestedObject: schema.NestedBlockObject{
+													Attributes: map[string]schema.Attribute{
+														names.AttrID: schema.StringAttribute{
+															Required: true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"layout_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrContent: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[layoutContentModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"basic": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[basicLayoutModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"more_info": layoutSectionsBlock(),
+									"top_panel": layoutSectionsBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *layoutResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data layoutResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, name := data.DomainID.ValueString(), data.Name.ValueString()
+	var input connectcases.CreateLayoutInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateLayout(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Domain (%s) Layout (%s)", domainID, name), err.Error())
+
+		return
+	}
+
+	layoutID := aws.ToString(output.LayoutId)
+
+	if err := createTags(ctx, conn, aws.ToString(output.LayoutArn), getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root("domain_id"), domainID) // Set 'domain_id' and 'layout_id' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root("layout_id"), layoutID)
+		response.Diagnostics.AddError(fmt.Sprintf("setting Connect Cases Domain (%s) Layout (%s) tags", domainID, layoutID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.LayoutARN = fwflex.StringToFramework(ctx, output.LayoutArn)
+	data.LayoutID = fwflex.StringValueToFramework(ctx, layoutID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *layoutResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data layoutResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, layoutID := data.DomainID.ValueString(), data.LayoutID.ValueString()
+	output, err := findLayoutByTwoPartKey(ctx, conn, domainID, layoutID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Domain (%s) Layout (%s)", domainID, layoutID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *layoutResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old layoutResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	if !new.Content.Equal(old.Content) || !new.Name.Equal(old.Name) {
+		domainID, layoutID := new.DomainID.ValueString(), new.LayoutID.ValueString()
+		var input connectcases.UpdateLayoutInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateLayout(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Connect Cases Domain (%s) Layout (%s)", domainID, layoutID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *layoutResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data layoutResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, layoutID := data.DomainID.ValueString(), data.LayoutID.ValueString()
+	input := connectcases.DeleteLayoutInput{
+		DomainId: aws.String(domainID),
+		LayoutId: aws.String(layoutID),
+	}
+	_, err := conn.DeleteLayout(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Domain (%s) Layout (%s)", domainID, layoutID), err.Error())
+
+		return
+	}
+}
+
+func findLayoutByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, layoutID string) (*connectcases.GetLayoutOutput, error) {
+	input := connectcases.GetLayoutInput{
+		DomainId: aws.String(domainID),
+		LayoutId: aws.String(layoutID),
+	}
+	output, err := conn.GetLayout(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if output.Deleted {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return output, nil
+}
+
+type layoutResourceModel struct {
+	framework.WithRegionModel
+	Content   fwtypes.ListNestedObjectValueOf[layoutContentModel] `tfsdk:"content"`
+	DomainID  types.String                                        `tfsdk:"domain_id"`
+	LayoutARN types.String                                        `tfsdk:"arn"`
+	LayoutID  types.String                                        `tfsdk:"layout_id"`
+	Name      types.String                                        `tfsdk:"name"`
+	Tags      tftags.Map                                          `tfsdk:"tags"`
+	TagsAll   tftags.Map                                          `tfsdk:"tags_all"`
+}
+
+type layoutContentModel struct {
+	Basic fwtypes.ListNestedObjectValueOf[basicLayoutModel] `tfsdk:"basic"`
+}
+
+var (
+	_ fwflex.Expander  = layoutContentModel{}
+	_ fwflex.Flattener = &layoutContentModel{}
+)
+
+func (m layoutContentModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Basic.IsNull():
+		basicLayoutData, d := m.Basic.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.LayoutContentMemberBasic
+		diags.Append(fwflex.Expand(ctx, basicLayoutData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *layoutContentModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.LayoutContentMemberBasic:
+		var model basicLayoutModel
+		d := fwflex.Flatten(ctx, t.Value, &model)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Basic = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type basicLayoutModel struct {
+	MoreInfo fwtypes.ListNestedObjectValueOf[layoutSectionsModel] `tfsdk:"more_info"`
+	TopPanel fwtypes.ListNestedObjectValueOf[layoutSectionsModel] `tfsdk:"top_panel"`
+}
+
+type layoutSectionsModel struct {
+	Sections fwtypes.ListNestedObjectValueOf[sectionModel] `tfsdk:"section"`
+}
+
+type sectionModel struct {
+	FieldGroup fwtypes.ListNestedObjectValueOf[fieldGroupModel] `tfsdk:"field_group"`
+}
+
+var (
+	_ fwflex.Expander  = sectionModel{}
+	_ fwflex.Flattener = &sectionModel{}
+)
+
+func (m sectionModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.FieldGroup.IsNull():
+		fieldGroupData, d := m.FieldGroup.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.SectionMemberFieldGroup
+		diags.Append(fwflex.Expand(ctx, fieldGroupData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *sectionModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.SectionMemberFieldGroup:
+		var model fieldGroupModel
+		d := fwflex.Flatten(ctx, t.Value, &model)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.FieldGroup = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type fieldGroupModel struct {
+	Fields fwtypes.ListNestedObjectValueOf[fieldItemModel] `tfsdk:"field"`
+	Name   types.String                                    `tfsdk:"name"`
+}
+
+type fieldItemModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+var _ inttypes.ImportIDParser = layoutImportID{}
+
+type layoutImportID struct{}
+
+func (layoutImportID) Parse(id string) (string, map[string]string, error) {
+	return parseDomainScopedImportID(id, "layout_id")
+}
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfconnectcases "github.com/hashicorp/terraform-provider-aws/internal/service/connectcases"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccLayout_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectcases.GetLayoutOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_layout.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayoutDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayoutConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "content.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.0.section.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.0.section.0.field_group.0.name", "Summary"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.0.section.0.field_group.0.field.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "content.0.basic.0.top_panel.0.section.0.field_group.0.field.0.id", "aws_connectcases_field.test", "field_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_connectcases_domain.test", "domain_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "layout_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccDomainScopedImportStateIDFunc(resourceName, "layout_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "layout_id",
+			},
+		},
+	})
+}
+
+func testAccLayout_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectcases.GetLayoutOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_layout.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayoutDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayoutConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfconnectcases.ResourceLayout, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccLayout_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectcases.GetLayoutOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_layout.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayoutDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayoutConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.#", "0"),
+				),
+			},
+			{
+				Config: testAccLayoutConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.0.section.0.field_group.0.name", "Details"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.0.section.0.field_group.0.field.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLayoutDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ConnectCasesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connectcases_layout" {
+				continue
+			}
+
+			_, err := tfconnectcases.FindLayoutByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["layout_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Cases Layout %s still exists", rs.Primary.Attributes["layout_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLayoutExists(ctx context.Context, t *testing.T, n string, v *connectcases.GetLayoutOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ConnectCasesClient(ctx)
+
+		output, err := tfconnectcases.FindLayoutByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["layout_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLayoutConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connectcases_domain" "test" {
+  name = %[1]q
+}
+
+resource "aws_connectcases_field" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = %[1]q
+  type      = "Text"
+}
+`, rName)
+}
+
+func testAccLayoutConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLayoutConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_layout" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = %[1]q
+
+  content {
+    basic {
+      top_panel {
+        section {
+          field_group {
+            name = "Summary"
+
+            field {
+              id = aws_connectcases_field.test.field_id
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccLayoutConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccLayoutConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_field" "test2" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = "%[1]s-2"
+  type      = "Number"
+}
+
+resource "aws_connectcases_layout" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = "%[1]s-updated"
+
+  content {
+    basic {
+      top_panel {
+        section {
+          field_group {
+            name = "Summary"
+
+            field {
+              id = aws_connectcases_field.test.field_id
+            }
+
+            field {
+              id = aws_connectcases_field.test2.field_id
+            }
+          }
+        }
+      }
+
+      more_info {
+        section {
+          field_group {
+            name = "Details"
+
+            field {
+              id = aws_connectcases_field.test2.field_id
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
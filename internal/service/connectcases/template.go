@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_template", name="Template")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("domain_id")
+// @IdentityAttribute("template_id")
+// @ImportIDHandler("templateImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/connectcases;connectcases.GetTemplateOutput")
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateResource{}
+
+	return r, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithModel[templateResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TemplateStatus](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.TemplateStatusActive)),
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"layout_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[layoutConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"default_layout": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"required_field": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requiredFieldModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(100),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateRuleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"case_rule_id": schema.StringAttribute{
+							Required: true,
+						},
+						"field_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, name := data.DomainID.ValueString(), data.Name.ValueString()
+	var input connectcases.CreateTemplateInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateTemplate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Domain (%s) Template (%s)", domainID, name), err.Error())
+
+		return
+	}
+
+	templateID := aws.ToString(output.TemplateId)
+
+	if err := createTags(ctx, conn, aws.ToString(output.TemplateArn), getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root("domain_id"), domainID) // Set 'domain_id' and 'template_id' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root("template_id"), templateID)
+		response.Diagnostics.AddError(fmt.Sprintf("setting Connect Cases Domain (%s) Template (%s) tags", domainID, templateID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TemplateARN = fwflex.StringToFramework(ctx, output.TemplateArn)
+	data.TemplateID = fwflex.StringValueToFramework(ctx, templateID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, templateID := data.DomainID.ValueString(), data.TemplateID.ValueString()
+	output, err := findTemplateByTwoPartKey(ctx, conn, domainID, templateID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Domain (%s) Template (%s)", domainID, templateID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		domainID, templateID := new.DomainID.ValueString(), new.TemplateID.ValueString()
+		var input connectcases.UpdateTemplateInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateTemplate(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Connect Cases Domain (%s) Template (%s)", domainID, templateID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, templateID := data.DomainID.ValueString(), data.TemplateID.ValueString()
+	input := connectcases.DeleteTemplateInput{
+		DomainId:   aws.String(domainID),
+		TemplateId: aws.String(templateID),
+	}
+	_, err := conn.DeleteTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Domain (%s) Template (%s)", domainID, templateID), err.Error())
+
+		return
+	}
+}
+
+func findTemplateByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, templateID string) (*connectcases.GetTemplateOutput, error) {
+	input := connectcases.GetTemplateInput{
+		DomainId:   aws.String(domainID),
+		TemplateId: aws.String(templateID),
+	}
+	output, err := conn.GetTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if output.Deleted {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return output, nil
+}
+
+type templateResourceModel struct {
+	framework.WithRegionModel
+	Description         types.String                                              `tfsdk:"description"`
+	DomainID            types.String                                              `tfsdk:"domain_id"`
+	LayoutConfiguration fwtypes.ListNestedObjectValueOf[layoutConfigurationModel] `tfsdk:"layout_configuration"`
+	Name                types.String                                              `tfsdk:"name"`
+	RequiredFields      fwtypes.ListNestedObjectValueOf[requiredFieldModel]       `tfsdk:"required_field"`
+	Rules               fwtypes.ListNestedObjectValueOf[templateRuleModel]        `tfsdk:"rule"`
+	Status              fwtypes.StringEnum[awstypes.TemplateStatus]               `tfsdk:"status"`
+	Tags                tftags.Map                                                `tfsdk:"tags"`
+	TagsAll             tftags.Map                                                `tfsdk:"tags_all"`
+	TemplateARN         types.String                                              `tfsdk:"arn"`
+	TemplateID          types.String                                              `tfsdk:"template_id"`
+}
+
+type layoutConfigurationModel struct {
+	DefaultLayout types.String `tfsdk:"default_layout"`
+}
+
+type requiredFieldModel struct {
+	FieldID types.String `tfsdk:"field_id"`
+}
+
+type templateRuleModel struct {
+	CaseRuleID types.String `tfsdk:"case_rule_id"`
+	FieldID    types.String `tfsdk:"field_id"`
+}
+
+var _ inttypes.ImportIDParser = templateImportID{}
+
+type templateImportID struct{}
+
+func (templateImportID) Parse(id string) (string, map[string]string, error) {
+	return parseDomainScopedImportID(id, "template_id")
+}
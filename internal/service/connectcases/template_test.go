@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfconnectcases "github.com/hashicorp/terraform-provider-aws/internal/service/connectcases"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectcases.GetTemplateOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_connectcases_domain.test", "domain_id"),
+					resource.TestCheckResourceAttr(resourceName, "layout_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "required_field.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Active"),
+					resource.TestCheckResourceAttrSet(resourceName, "template_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccDomainScopedImportStateIDFunc(resourceName, "template_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "template_id",
+			},
+		},
+	})
+}
+
+func testAccTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectcases.GetTemplateOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfconnectcases.ResourceTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTemplate_requiredFields(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectcases.GetTemplateOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_requiredFields(rName, "Active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Escalations"),
+					resource.TestCheckResourceAttr(resourceName, "layout_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "layout_configuration.0.default_layout", "aws_connectcases_layout.test", "layout_id"),
+					resource.TestCheckResourceAttr(resourceName, "required_field.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "required_field.0.field_id", "aws_connectcases_field.test", "field_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Active"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccDomainScopedImportStateIDFunc(resourceName, "template_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "template_id",
+			},
+			{
+				Config: testAccTemplateConfig_requiredFields(rName, "Inactive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Inactive"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ConnectCasesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connectcases_template" {
+				continue
+			}
+
+			_, err := tfconnectcases.FindTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["template_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Cases Template %s still exists", rs.Primary.Attributes["template_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateExists(ctx context.Context, t *testing.T, n string, v *connectcases.GetTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ConnectCasesClient(ctx)
+
+		output, err := tfconnectcases.FindTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["template_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTemplateConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connectcases_domain" "test" {
+  name = %[1]q
+}
+
+resource "aws_connectcases_template" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = %[1]q
+}
+`, rName)
+}
+
+func testAccTemplateConfig_requiredFields(rName, status string) string {
+	return acctest.ConfigCompose(testAccLayoutConfig_basic(rName), fmt.Sprintf(`
+resource "aws_connectcases_template" "test" {
+  domain_id   = aws_connectcases_domain.test.domain_id
+  name        = %[1]q
+  description = "Escalations"
+  status      = %[2]q
+
+  layout_configuration {
+    default_layout = aws_connectcases_layout.test.layout_id
+  }
+
+  required_field {
+    field_id = aws_connectcases_field.test.field_id
+  }
+}
+`, rName, status))
+}
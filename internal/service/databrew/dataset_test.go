@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewDataset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeDatasetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_dataset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, t, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "databrew", "dataset/"+rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "input.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input.0.s3_input_definition.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input.0.s3_input_definition.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "input.0.s3_input_definition.0.key", "data.csv"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSource, "S3"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewDataset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeDatasetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_dataset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceDataset, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewDataset_formatAndPathOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeDatasetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_dataset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_formatAndPathOptions(rName, ";", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "format_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "format_options.0.csv.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "format_options.0.csv.0.delimiter", ";"),
+					resource.TestCheckResourceAttr(resourceName, "format_options.0.csv.0.header_row", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "path_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "path_options.0.files_limit.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "path_options.0.files_limit.0.max_files", "2"),
+					resource.TestCheckResourceAttr(resourceName, "path_options.0.parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "path_options.0.parameter.*", map[string]string{
+						names.AttrName: names.AttrRegion,
+						names.AttrType: "String",
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+			{
+				Config: testAccDatasetConfig_formatAndPathOptions(rName, "|", 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "format_options.0.csv.0.delimiter", "|"),
+					resource.TestCheckResourceAttr(resourceName, "path_options.0.files_limit.0.max_files", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataBrewDataset_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeDatasetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_dataset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccDatasetConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccDatasetConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatasetDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_dataset" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindDatasetByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Dataset %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDatasetExists(ctx context.Context, t *testing.T, n string, v *databrew.DescribeDatasetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindDatasetByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDatasetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data.csv"
+  content = "id,name,region\n1,alpha,us-east-1\n2,beta,us-west-2\n"
+}
+`, rName)
+}
+
+func testAccDatasetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_dataset" "test" {
+  name   = %[1]q
+  format = "CSV"
+
+  input {
+    s3_input_definition {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDatasetConfig_formatAndPathOptions(rName, delimiter string, maxFiles int) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_dataset" "test" {
+  name   = %[1]q
+  format = "CSV"
+
+  input {
+    s3_input_definition {
+      bucket = aws_s3_object.test.bucket
+      key    = "<region>/data.csv"
+    }
+  }
+
+  format_options {
+    csv {
+      delimiter  = %[2]q
+      header_row = true
+    }
+  }
+
+  path_options {
+    files_limit {
+      max_files = %[3]d
+    }
+
+    parameter {
+      name = "region"
+      type = "String"
+    }
+  }
+}
+`, rName, delimiter, maxFiles))
+}
+
+func testAccDatasetConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_dataset" "test" {
+  name   = %[1]q
+  format = "CSV"
+
+  input {
+    s3_input_definition {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDatasetConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_dataset" "test" {
+  name   = %[1]q
+  format = "CSV"
+
+  input {
+    s3_input_definition {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+// Exports for use in tests only.
+var (
+	ResourceDataset       = newDatasetResource
+	ResourceProfileJob    = newProfileJobResource
+	ResourceRecipe        = newRecipeResource
+	ResourceRecipeJob     = newRecipeJobResource
+	ResourceRecipeVersion = newRecipeVersionResource
+	ResourceRuleset       = newRulesetResource
+	ResourceSchedule      = newScheduleResource
+
+	FindDatasetByName      = findDatasetByName
+	FindJobByTwoPartKey    = findJobByTwoPartKey
+	FindRecipeByTwoPartKey = findRecipeByTwoPartKey
+	FindRulesetByName      = findRulesetByName
+	FindScheduleByName     = findScheduleByName
+)
+
+const (
+	RecipeVersionLatestWorking = recipeVersionLatestWorking
+)
@@ -0,0 +1,534 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_databrew_profile_job", name="Profile Job")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeJobOutput")
+func newProfileJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &profileJobResource{}
+
+	return r, nil
+}
+
+type profileJobResource struct {
+	framework.ResourceWithModel[profileJobResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *profileJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	statisticsConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[statisticsConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"included_statistics": schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"override": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[statisticOverrideModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrParameters: schema.MapAttribute{
+								CustomType:  fwtypes.MapOfStringType,
+								ElementType: types.StringType,
+								Required:    true,
+							},
+							"statistic": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"dataset_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"encryption_key_arn": encryptionKeyARNAttribute(),
+			"encryption_mode":    encryptionModeAttribute(),
+			"log_subscription":   logSubscriptionAttribute(),
+			"max_capacity":       optionalComputedInt32Attribute(),
+			"max_retries":        optionalComputedInt32Attribute(),
+			names.AttrName:       nameAttribute(),
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTimeout: optionalComputedInt32Attribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[profileConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"column_statistics_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[columnStatisticsConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"selector": schema.ListNestedBlock{
+										CustomType:   fwtypes.NewListNestedObjectTypeOf[columnSelectorModel](ctx),
+										NestedObject: columnSelectorNestedObject(),
+									},
+									"statistics": statisticsConfigurationBlock,
+								},
+							},
+						},
+						"dataset_statistics_configuration": statisticsConfigurationBlock,
+						"entity_detector_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[entityDetectorConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"entity_types": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"allowed_statistics": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[allowedStatisticsModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"statistics": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"profile_column": schema.ListNestedBlock{
+							CustomType:   fwtypes.NewListNestedObjectTypeOf[columnSelectorModel](ctx),
+							NestedObject: columnSelectorNestedObject(),
+						},
+					},
+				},
+			},
+			"job_sample": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[jobSampleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrMode: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SampleMode](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrSize: schema.Int64Attribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"output_location": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[s3LocationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: s3LocationNestedObject(),
+			},
+			"validation_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[validationConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ruleset_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"validation_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ValidationMode](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *profileJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data profileJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	var input databrew.CreateProfileJobInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateProfileJob(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Profile Job (%s)", name), err.Error())
+
+		return
+	}
+
+	output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeProfile)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrName), name) // Set 'name' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Profile Job (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *profileJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data profileJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeProfile)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Profile Job (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *profileJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old profileJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		name := new.Name.ValueString()
+		var input databrew.UpdateProfileJobInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateProfileJob(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Profile Job (%s)", name), err.Error())
+
+			return
+		}
+
+		output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeProfile)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Profile Job (%s)", name), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(new.flatten(ctx, output)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *profileJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data profileJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	err := deleteJob(ctx, conn, name)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Profile Job (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func deleteJob(ctx context.Context, conn *databrew.Client, name string) error {
+	input := databrew.DeleteJobInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.DeleteJob(ctx, &input)
+
+	return err
+}
+
+// findJobByTwoPartKey returns the named job, treating a job of another type as not found.
+func findJobByTwoPartKey(ctx context.Context, conn *databrew.Client, name string, jobType awstypes.JobType) (*databrew.DescribeJobOutput, error) {
+	output, err := findJobByName(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Type != jobType {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("job type is %s, expected %s", output.Type, jobType),
+		}
+	}
+
+	return output, nil
+}
+
+func findJobByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeJobOutput, error) {
+	input := databrew.DescribeJobInput{
+		Name: aws.String(name),
+	}
+	output, err := conn.DescribeJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+func encryptionKeyARNAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.ARNType,
+		Optional:   true,
+	}
+}
+
+func encryptionModeAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.EncryptionMode](),
+		Optional:   true,
+	}
+}
+
+func logSubscriptionAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.LogSubscription](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func optionalComputedInt32Attribute() schema.Attribute {
+	return schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Int32{
+			int32planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+type profileJobResourceModel struct {
+	framework.WithRegionModel
+	ARN                      types.String                                                  `tfsdk:"arn"`
+	Configuration            fwtypes.ListNestedObjectValueOf[profileConfigurationModel]    `tfsdk:"configuration"`
+	DatasetName              types.String                                                  `tfsdk:"dataset_name"`
+	EncryptionKeyARN         fwtypes.ARN                                                   `tfsdk:"encryption_key_arn"`
+	EncryptionMode           fwtypes.StringEnum[awstypes.EncryptionMode]                   `tfsdk:"encryption_mode"`
+	JobSample                fwtypes.ListNestedObjectValueOf[jobSampleModel]               `tfsdk:"job_sample"`
+	LogSubscription          fwtypes.StringEnum[awstypes.LogSubscription]                  `tfsdk:"log_subscription"`
+	MaxCapacity              types.Int32                                                   `tfsdk:"max_capacity"`
+	MaxRetries               types.Int32                                                   `tfsdk:"max_retries"`
+	Name                     types.String                                                  `tfsdk:"name"`
+	OutputLocation           fwtypes.ListNestedObjectValueOf[s3LocationModel]              `tfsdk:"output_location"`
+	RoleARN                  fwtypes.ARN                                                   `tfsdk:"role_arn"`
+	Tags                     tftags.Map                                                    `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                                    `tfsdk:"tags_all"`
+	Timeout                  types.Int32                                                   `tfsdk:"timeout"`
+	ValidationConfigurations fwtypes.ListNestedObjectValueOf[validationConfigurationModel] `tfsdk:"validation_configuration"`
+}
+
+// flatten copies a DescribeJob response into the model.
+// The job's profile configuration is returned as ProfileConfiguration, and its output location
+// isn't returned at all, so the configured value is kept.
+func (m *profileJobResourceModel) flatten(ctx context.Context, output *databrew.DescribeJobOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, m)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(fwflex.Flatten(ctx, output.ProfileConfiguration, &m.Configuration)...)
+	if diags.HasError() {
+		return diags
+	}
+	m.ARN = fwflex.StringToFramework(ctx, output.ResourceArn)
+
+	return diags
+}
+
+type profileConfigurationModel struct {
+	ColumnStatisticsConfigurations fwtypes.ListNestedObjectValueOf[columnStatisticsConfigurationModel] `tfsdk:"column_statistics_configuration"`
+	DatasetStatisticsConfiguration fwtypes.ListNestedObjectValueOf[statisticsConfigurationModel]       `tfsdk:"dataset_statistics_configuration"`
+	EntityDetectorConfiguration    fwtypes.ListNestedObjectValueOf[entityDetectorConfigurationModel]   `tfsdk:"entity_detector_configuration"`
+	ProfileColumns                 fwtypes.ListNestedObjectValueOf[columnSelectorModel]                `tfsdk:"profile_column"`
+}
+
+type columnStatisticsConfigurationModel struct {
+	Selectors  fwtypes.ListNestedObjectValueOf[columnSelectorModel]          `tfsdk:"selector"`
+	Statistics fwtypes.ListNestedObjectValueOf[statisticsConfigurationModel] `tfsdk:"statistics"`
+}
+
+type statisticsConfigurationModel struct {
+	IncludedStatistics fwtypes.ListOfString                                    `tfsdk:"included_statistics"`
+	Overrides          fwtypes.ListNestedObjectValueOf[statisticOverrideModel] `tfsdk:"override"`
+}
+
+type statisticOverrideModel struct {
+	Parameters fwtypes.MapOfString `tfsdk:"parameters"`
+	Statistic  types.String        `tfsdk:"statistic"`
+}
+
+type entityDetectorConfigurationModel struct {
+	AllowedStatistics fwtypes.ListNestedObjectValueOf[allowedStatisticsModel] `tfsdk:"allowed_statistics"`
+	EntityTypes       fwtypes.ListOfString                                    `tfsdk:"entity_types"`
+}
+
+type allowedStatisticsModel struct {
+	Statistics fwtypes.ListOfString `tfsdk:"statistics"`
+}
+
+type jobSampleModel struct {
+	Mode fwtypes.StringEnum[awstypes.SampleMode] `tfsdk:"mode"`
+	Size types.Int64                             `tfsdk:"size"`
+}
+
+type validationConfigurationModel struct {
+	RulesetARN     fwtypes.ARN                                 `tfsdk:"ruleset_arn"`
+	ValidationMode fwtypes.StringEnum[awstypes.ValidationMode] `tfsdk:"validation_mode"`
+}
@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewProfileJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_profile_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, t, "aws_databrew_profile_job", awstypes.JobTypeProfile),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_basic(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, t, resourceName, awstypes.JobTypeProfile, &v),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "databrew", "job/"+rName),
+					resource.TestCheckResourceAttrPair(resourceName, "dataset_name", "aws_databrew_dataset.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "5"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "output_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "output_location.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+				ImportStateVerifyIgnore:              []string{"output_location"},
+			},
+			{
+				Config: testAccProfileJobConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, t, resourceName, awstypes.JobTypeProfile, &v),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataBrewProfileJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_profile_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, t, "aws_databrew_profile_job", awstypes.JobTypeProfile),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_basic(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, t, resourceName, awstypes.JobTypeProfile, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceProfileJob, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewProfileJob_validationConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_profile_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, t, "aws_databrew_profile_job", awstypes.JobTypeProfile),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_validationConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, t, resourceName, awstypes.JobTypeProfile, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.profile_column.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_sample.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_sample.0.mode", "CUSTOM_ROWS"),
+					resource.TestCheckResourceAttr(resourceName, "job_sample.0.size", "100"),
+					resource.TestCheckResourceAttr(resourceName, "validation_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "validation_configuration.0.ruleset_arn", "aws_databrew_ruleset.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobDestroy(ctx context.Context, t *testing.T, resourceType string, jobType awstypes.JobType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			_, err := tfdatabrew.FindJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrName], jobType)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Job %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobExists(ctx context.Context, t *testing.T, n string, jobType awstypes.JobType, v *databrew.DescribeJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrName], jobType)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_basic(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "databrew.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AwsGlueDataBrewDataAccessPolicy"
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetObject",
+        "s3:PutObject",
+        "s3:ListBucket",
+        "s3:DeleteObject",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccProfileJobConfig_basic(rName string, maxCapacity int) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_profile_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  role_arn     = aws_iam_role.test.arn
+  max_capacity = %[2]d
+
+  output_location {
+    bucket = aws_s3_bucket.test.bucket
+    key    = "profile/"
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_iam_role_policy_attachment.test]
+}
+`, rName, maxCapacity))
+}
+
+func testAccProfileJobConfig_validationConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_ruleset" "test" {
+  name       = %[1]q
+  target_arn = aws_databrew_dataset.test.arn
+
+  rule {
+    name             = "no-missing-names"
+    check_expression = "AGG(MISSING_VALUES_PERCENTAGE) == :val1"
+
+    substitution_map = {
+      ":val1" = "0"
+    }
+
+    column_selector {
+      name = "name"
+    }
+  }
+}
+
+resource "aws_databrew_profile_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  role_arn     = aws_iam_role.test.arn
+
+  output_location {
+    bucket = aws_s3_bucket.test.bucket
+    key    = "profile/"
+  }
+
+  configuration {
+    profile_column {
+      name = "name"
+    }
+  }
+
+  job_sample {
+    mode = "CUSTOM_ROWS"
+    size = 100
+  }
+
+  validation_configuration {
+    ruleset_arn = aws_databrew_ruleset.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
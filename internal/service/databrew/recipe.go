@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// recipeVersionLatestWorking is the version of a recipe's editable working copy.
+	recipeVersionLatestWorking = "LATEST_WORKING"
+)
+
+// @FrameworkResource("aws_databrew_recipe", name="Recipe")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeRecipeOutput")
+func newRecipeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recipeResource{}
+
+	return r, nil
+}
+
+// recipeResource manages the working copy (LATEST_WORKING) of a recipe.
+// Published versions are managed by aws_databrew_recipe_version, so editing the steps here never
+// changes the version used by a job that references a published version.
+type recipeResource struct {
+	framework.ResourceWithModel[recipeResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *recipeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrName:    nameAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"step": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recipeStepModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrAction: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recipeActionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operation": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrParameters: schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"condition_expression": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[conditionExpressionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCondition: schema.StringAttribute{
+										Required: true,
+									},
+									"target_column": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrValue: schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	var input databrew.CreateRecipeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRecipe(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrName), name) // Set 'name' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.ResourceArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *recipeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ARN = fwflex.StringToFramework(ctx, output.ResourceArn)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old recipeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		name := new.Name.ValueString()
+		var input databrew.UpdateRecipeInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		if input.Description == nil {
+			input.Description = aws.String("")
+		}
+
+		_, err := conn.UpdateRecipe(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recipeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	err := deleteRecipeVersion(ctx, conn, name, recipeVersionLatestWorking)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func deleteRecipeVersion(ctx context.Context, conn *databrew.Client, name, version string) error {
+	input := databrew.DeleteRecipeVersionInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(version),
+	}
+	_, err := conn.DeleteRecipeVersion(ctx, &input)
+
+	return err
+}
+
+// findRecipeByTwoPartKey returns the specified version of a recipe.
+// An empty version returns the latest published version.
+func findRecipeByTwoPartKey(ctx context.Context, conn *databrew.Client, name, version string) (*databrew.DescribeRecipeOutput, error) {
+	input := databrew.DescribeRecipeInput{
+		Name: aws.String(name),
+	}
+	if version != "" {
+		input.RecipeVersion = aws.String(version)
+	}
+	output, err := conn.DescribeRecipe(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+type recipeResourceModel struct {
+	framework.WithRegionModel
+	ARN         types.String                                     `tfsdk:"arn"`
+	Description types.String                                     `tfsdk:"description"`
+	Name        types.String                                     `tfsdk:"name"`
+	Steps       fwtypes.ListNestedObjectValueOf[recipeStepModel] `tfsdk:"step"`
+	Tags        tftags.Map                                       `tfsdk:"tags"`
+	TagsAll     tftags.Map                                       `tfsdk:"tags_all"`
+}
+
+type recipeStepModel struct {
+	Action               fwtypes.ListNestedObjectValueOf[recipeActionModel]        `tfsdk:"action"`
+	ConditionExpressions fwtypes.ListNestedObjectValueOf[conditionExpressionModel] `tfsdk:"condition_expression"`
+}
+
+type recipeActionModel struct {
+	Operation  types.String        `tfsdk:"operation"`
+	Parameters fwtypes.MapOfString `tfsdk:"parameters"`
+}
+
+type conditionExpressionModel struct {
+	Condition    types.String `tfsdk:"condition"`
+	TargetColumn types.String `tfsdk:"target_column"`
+	Value        types.String `tfsdk:"value"`
+}
@@ -0,0 +1,505 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_databrew_recipe_job", name="Recipe Job")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeJobOutput")
+func newRecipeJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recipeJobResource{}
+
+	return r, nil
+}
+
+type recipeJobResource struct {
+	framework.ResourceWithModel[recipeJobResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *recipeJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	databaseTableOutputOptionsBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[databaseTableOutputOptionsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrTableName: schema.StringAttribute{
+					Required: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"temp_directory": s3LocationBlock(ctx),
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"dataset_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("project_name")),
+					stringvalidator.AlsoRequires(path.MatchRoot("recipe_reference")),
+				},
+			},
+			"encryption_key_arn": encryptionKeyARNAttribute(),
+			"encryption_mode":    encryptionModeAttribute(),
+			"log_subscription":   logSubscriptionAttribute(),
+			"max_capacity":       optionalComputedInt32Attribute(),
+			"max_retries":        optionalComputedInt32Attribute(),
+			names.AttrName:       nameAttribute(),
+			"project_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTimeout: optionalComputedInt32Attribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"data_catalog_output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataCatalogOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrCatalogID: schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrDatabaseName: schema.StringAttribute{
+							Required: true,
+						},
+						"overwrite": optionalComputedBoolAttribute(),
+						names.AttrTableName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"database_options": databaseTableOutputOptionsBlock,
+						"s3_options": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3TableOutputOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrLocation: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3LocationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: s3LocationNestedObject(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"database_output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[databaseOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"database_output_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DatabaseOutputMode](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"glue_connection_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"database_options": databaseTableOutputOptionsBlock,
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[outputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"compression_format": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CompressionFormat](),
+							Optional:   true,
+						},
+						names.AttrFormat: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.OutputFormat](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"max_output_files": schema.Int32Attribute{
+							Optional: true,
+							Validators: []validator.Int32{
+								int32validator.Between(1, 999),
+							},
+						},
+						"overwrite": optionalComputedBoolAttribute(),
+						"partition_columns": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"format_options": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[outputFormatOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"csv": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[csvOutputOptionsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"delimiter": schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 1),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrLocation: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3LocationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: s3LocationNestedObject(),
+						},
+					},
+				},
+			},
+			"recipe_reference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recipeReferenceModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"recipe_version": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recipeJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	var input databrew.CreateRecipeJobInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRecipeJob(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Recipe Job (%s)", name), err.Error())
+
+		return
+	}
+
+	output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeRecipe)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrName), name) // Set 'name' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe Job (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, data.flattenOptions()...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ARN = fwflex.StringToFramework(ctx, output.ResourceArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *recipeJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recipeJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeRecipe)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe Job (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, data.flattenOptions()...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ARN = fwflex.StringToFramework(ctx, output.ResourceArn)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old recipeJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		name := new.Name.ValueString()
+		var input databrew.UpdateRecipeJobInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRecipeJob(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Recipe Job (%s)", name), err.Error())
+
+			return
+		}
+
+		output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeRecipe)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe Job (%s)", name), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new, new.flattenOptions()...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recipeJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recipeJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	err := deleteJob(ctx, conn, name)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe Job (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func optionalComputedBoolAttribute() schema.Attribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+type recipeJobResourceModel struct {
+	framework.WithRegionModel
+	ARN                types.String                                            `tfsdk:"arn"`
+	DataCatalogOutputs fwtypes.ListNestedObjectValueOf[dataCatalogOutputModel] `tfsdk:"data_catalog_output"`
+	DatabaseOutputs    fwtypes.ListNestedObjectValueOf[databaseOutputModel]    `tfsdk:"database_output"`
+	DatasetName        types.String                                            `tfsdk:"dataset_name"`
+	EncryptionKeyARN   fwtypes.ARN                                             `tfsdk:"encryption_key_arn"`
+	EncryptionMode     fwtypes.StringEnum[awstypes.EncryptionMode]             `tfsdk:"encryption_mode"`
+	LogSubscription    fwtypes.StringEnum[awstypes.LogSubscription]            `tfsdk:"log_subscription"`
+	MaxCapacity        types.Int32                                             `tfsdk:"max_capacity"`
+	MaxRetries         types.Int32                                             `tfsdk:"max_retries"`
+	Name               types.String                                            `tfsdk:"name"`
+	Outputs            fwtypes.ListNestedObjectValueOf[outputModel]            `tfsdk:"output"`
+	ProjectName        types.String                                            `tfsdk:"project_name"`
+	RecipeReference    fwtypes.ListNestedObjectValueOf[recipeReferenceModel]   `tfsdk:"recipe_reference"`
+	RoleARN            fwtypes.ARN                                             `tfsdk:"role_arn"`
+	Tags               tftags.Map                                              `tfsdk:"tags"`
+	TagsAll            tftags.Map                                              `tfsdk:"tags_all"`
+	Timeout            types.Int32                                             `tfsdk:"timeout"`
+}
+
+// flattenOptions returns AutoFlex options that leave the dataset and recipe reference untouched
+// for a job that runs against a project, as DataBrew reports the project's dataset and recipe.
+func (m recipeJobResourceModel) flattenOptions() []fwflex.AutoFlexOptionsFunc {
+	var opts []fwflex.AutoFlexOptionsFunc
+
+	if m.DatasetName.IsNull() {
+		opts = append(opts, fwflex.WithIgnoredFieldNamesAppend("DatasetName"))
+	}
+	if m.RecipeReference.IsNull() {
+		opts = append(opts, fwflex.WithIgnoredFieldNamesAppend("RecipeReference"))
+	}
+
+	return opts
+}
+
+type dataCatalogOutputModel struct {
+	CatalogID       types.String                                                     `tfsdk:"catalog_id"`
+	DatabaseName    types.String                                                     `tfsdk:"database_name"`
+	DatabaseOptions fwtypes.ListNestedObjectValueOf[databaseTableOutputOptionsModel] `tfsdk:"database_options"`
+	Overwrite       types.Bool                                                       `tfsdk:"overwrite"`
+	S3Options       fwtypes.ListNestedObjectValueOf[s3TableOutputOptionsModel]       `tfsdk:"s3_options"`
+	TableName       types.String                                                     `tfsdk:"table_name"`
+}
+
+type databaseTableOutputOptionsModel struct {
+	TableName     types.String                                     `tfsdk:"table_name"`
+	TempDirectory fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"temp_directory"`
+}
+
+type s3TableOutputOptionsModel struct {
+	Location fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"location"`
+}
+
+type databaseOutputModel struct {
+	DatabaseOptions    fwtypes.ListNestedObjectValueOf[databaseTableOutputOptionsModel] `tfsdk:"database_options"`
+	DatabaseOutputMode fwtypes.StringEnum[awstypes.DatabaseOutputMode]                  `tfsdk:"database_output_mode"`
+	GlueConnectionName types.String                                                     `tfsdk:"glue_connection_name"`
+}
+
+type outputModel struct {
+	CompressionFormat fwtypes.StringEnum[awstypes.CompressionFormat]            `tfsdk:"compression_format"`
+	Format            fwtypes.StringEnum[awstypes.OutputFormat]                 `tfsdk:"format"`
+	FormatOptions     fwtypes.ListNestedObjectValueOf[outputFormatOptionsModel] `tfsdk:"format_options"`
+	Location          fwtypes.ListNestedObjectValueOf[s3LocationModel]          `tfsdk:"location"`
+	MaxOutputFiles    types.Int32                                               `tfsdk:"max_output_files"`
+	Overwrite         types.Bool                                                `tfsdk:"overwrite"`
+	PartitionColumns  fwtypes.ListOfString                                      `tfsdk:"partition_columns"`
+}
+
+type outputFormatOptionsModel struct {
+	CSV fwtypes.ListNestedObjectValueOf[csvOutputOptionsModel] `tfsdk:"csv"`
+}
+
+type csvOutputOptionsModel struct {
+	Delimiter types.String `tfsdk:"delimiter"`
+}
+
+type recipeReferenceModel struct {
+	Name          types.String `tfsdk:"name"`
+	RecipeVersion types.String `tfsdk:"recipe_version"`
+}
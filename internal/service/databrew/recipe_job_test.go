@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRecipeJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, t, "aws_databrew_recipe_job", awstypes.JobTypeRecipe),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeJobConfig_basic(rName, "CSV"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, t, resourceName, awstypes.JobTypeRecipe, &v),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "databrew", "job/"+rName),
+					resource.TestCheckResourceAttrPair(resourceName, "dataset_name", "aws_databrew_dataset.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.format", "CSV"),
+					resource.TestCheckResourceAttrPair(resourceName, "output.0.location.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "recipe_reference.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "recipe_reference.0.name", "aws_databrew_recipe.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "recipe_reference.0.recipe_version", "aws_databrew_recipe_version.test", "recipe_version"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+			{
+				Config: testAccRecipeJobConfig_basic(rName, "PARQUET"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, t, resourceName, awstypes.JobTypeRecipe, &v),
+					resource.TestCheckResourceAttr(resourceName, "output.0.format", "PARQUET"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipeJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, t, "aws_databrew_recipe_job", awstypes.JobTypeRecipe),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeJobConfig_basic(rName, "CSV"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, t, resourceName, awstypes.JobTypeRecipe, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceRecipeJob, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRecipeJobConfig_basic(rName, format string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), testAccRecipeVersionConfig_basic(rName), fmt.Sprintf(`
+resource "aws_databrew_recipe_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  role_arn     = aws_iam_role.test.arn
+
+  recipe_reference {
+    name           = aws_databrew_recipe_version.test.recipe_name
+    recipe_version = aws_databrew_recipe_version.test.recipe_version
+  }
+
+  output {
+    format = %[2]q
+
+    location {
+      bucket = aws_s3_bucket.test.bucket
+      key    = "output/"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_iam_role_policy_attachment.test]
+}
+`, rName, format))
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRecipe_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRecipeOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_basic(rName, "name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, t, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "databrew", "recipe/"+rName),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "step.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.operation", "UPPER_CASE"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.parameters.sourceColumn", "name"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipe_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRecipeOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_basic(rName, "name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceRecipe, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipe_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRecipeOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_basic(rName, "name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "step.#", "1"),
+				),
+			},
+			{
+				Config: testAccRecipeConfig_updated(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "step.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "step.1.action.0.operation", "LOWER_CASE"),
+					resource.TestCheckResourceAttr(resourceName, "step.1.condition_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "step.1.condition_expression.0.condition", "IS_NOT_MISSING"),
+					resource.TestCheckResourceAttr(resourceName, "step.1.condition_expression.0.target_column", names.AttrRegion),
+				),
+			},
+			{
+				Config: testAccRecipeConfig_basic(rName, "name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "step.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecipeDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_recipe" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindRecipeByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrName], tfdatabrew.RecipeVersionLatestWorking)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Recipe %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecipeExists(ctx context.Context, t *testing.T, n string, v *databrew.DescribeRecipeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindRecipeByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrName], tfdatabrew.RecipeVersionLatestWorking)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRecipeConfig_basic(rName, sourceColumn string) string {
+	return fmt.Sprintf(`
+resource "aws_databrew_recipe" "test" {
+  name = %[1]q
+
+  step {
+    action {
+      operation = "UPPER_CASE"
+
+      parameters = {
+        sourceColumn = %[2]q
+      }
+    }
+  }
+}
+`, rName, sourceColumn)
+}
+
+func testAccRecipeConfig_updated(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_databrew_recipe" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  step {
+    action {
+      operation = "UPPER_CASE"
+
+      parameters = {
+        sourceColumn = "name"
+      }
+    }
+  }
+
+  step {
+    action {
+      operation = "LOWER_CASE"
+
+      parameters = {
+        sourceColumn = "region"
+      }
+    }
+
+    condition_expression {
+      condition     = "IS_NOT_MISSING"
+      target_column = "region"
+    }
+  }
+}
+`, rName, description)
+}
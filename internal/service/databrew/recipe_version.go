@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_databrew_recipe_version", name="Recipe Version")
+// @IdentityAttribute("recipe_name")
+// @IdentityAttribute("recipe_version")
+// @ImportIDHandler("recipeVersionImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeRecipeOutput")
+func newRecipeVersionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recipeVersionResource{}
+
+	return r, nil
+}
+
+// recipeVersionResource publishes the working copy of a recipe as a new, immutable version.
+// Every argument forces replacement, so a new version is only published when the configuration
+// explicitly asks for one (for example through `triggers`).
+type recipeVersionResource struct {
+	framework.ResourceWithModel[recipeVersionResourceModel]
+	framework.WithImportByIdentity
+	framework.WithNoUpdate
+}
+
+func (r *recipeVersionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"published_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"published_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"recipe_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recipe_version": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTriggers: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeVersionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recipeVersionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.RecipeName.ValueString()
+	input := databrew.PublishRecipeInput{
+		Description: fwflex.StringFromFramework(ctx, data.Description),
+		Name:        aws.String(name),
+	}
+	_, err := conn.PublishRecipe(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("publishing DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	// The version just published is the recipe's latest published version.
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, "")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) latest published version", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.PublishedBy = fwflex.StringToFramework(ctx, output.PublishedBy)
+	data.PublishedDate = timetypes.NewRFC3339TimePointerValue(output.PublishedDate)
+	data.RecipeVersion = fwflex.StringToFramework(ctx, output.RecipeVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *recipeVersionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recipeVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name, version := data.RecipeName.ValueString(), data.RecipeVersion.ValueString()
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, version)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) version (%s)", name, version), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeVersionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recipeVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name, version := data.RecipeName.ValueString(), data.RecipeVersion.ValueString()
+	err := deleteRecipeVersion(ctx, conn, name, version)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe (%s) version (%s)", name, version), err.Error())
+
+		return
+	}
+}
+
+var _ inttypes.ImportIDParser = recipeVersionImportID{}
+
+type recipeVersionImportID struct{}
+
+func (recipeVersionImportID) Parse(id string) (string, map[string]string, error) {
+	const (
+		partCount = 2
+	)
+	parts, err := intflex.ExpandResourceId(id, partCount, false)
+
+	if err != nil {
+		return "", nil, fmt.Errorf("id %q should be in the format <recipe-name>%s<recipe-version>", id, intflex.ResourceIdSeparator)
+	}
+
+	result := map[string]string{
+		"recipe_name":    parts[0],
+		"recipe_version": parts[1],
+	}
+
+	return id, result, nil
+}
+
+type recipeVersionResourceModel struct {
+	framework.WithRegionModel
+	Description   types.String        `tfsdk:"description"`
+	PublishedBy   types.String        `tfsdk:"published_by"`
+	PublishedDate timetypes.RFC3339   `tfsdk:"published_date"`
+	RecipeName    types.String        `tfsdk:"recipe_name"`
+	RecipeVersion types.String        `tfsdk:"recipe_version"`
+	Triggers      fwtypes.MapOfString `tfsdk:"triggers"`
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRecipeVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRecipeOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe_version.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeVersionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeVersionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeVersionExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttrSet(resourceName, "published_by"),
+					resource.TestCheckResourceAttrSet(resourceName, "published_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "recipe_name", "aws_databrew_recipe.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "recipe_version", "1.0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "recipe_name", "recipe_version"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "recipe_name",
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipeVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRecipeOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe_version.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeVersionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeVersionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeVersionExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceRecipeVersion, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// TestAccDataBrewRecipeVersion_explicitPublish verifies that editing a recipe leaves its published
+// version untouched and that a new version is only published when the triggers change.
+func TestAccDataBrewRecipeVersion_explicitPublish(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2, v3 databrew.DescribeRecipeOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe_version.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeVersionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeVersionConfig_triggers(rName, "name", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeVersionExists(ctx, t, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "recipe_version", "1.0"),
+				),
+			},
+			{
+				Config: testAccRecipeVersionConfig_triggers(rName, "region", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeVersionExists(ctx, t, resourceName, &v2),
+					resource.TestCheckResourceAttr(resourceName, "recipe_version", "1.0"),
+					resource.TestCheckResourceAttr("aws_databrew_recipe.test", "step.0.action.0.parameters.sourceColumn", names.AttrRegion),
+				),
+			},
+			{
+				Config: testAccRecipeVersionConfig_triggers(rName, "region", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeVersionExists(ctx, t, resourceName, &v3),
+					resource.TestCheckResourceAttr(resourceName, "recipe_version", "2.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecipeVersionDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_recipe_version" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindRecipeByTwoPartKey(ctx, conn, rs.Primary.Attributes["recipe_name"], rs.Primary.Attributes["recipe_version"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Recipe %s version %s still exists", rs.Primary.Attributes["recipe_name"], rs.Primary.Attributes["recipe_version"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecipeVersionExists(ctx context.Context, t *testing.T, n string, v *databrew.DescribeRecipeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindRecipeByTwoPartKey(ctx, conn, rs.Primary.Attributes["recipe_name"], rs.Primary.Attributes["recipe_version"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRecipeVersionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRecipeConfig_basic(rName, "name"), `
+resource "aws_databrew_recipe_version" "test" {
+  recipe_name = aws_databrew_recipe.test.name
+  description = "first"
+}
+`)
+}
+
+func testAccRecipeVersionConfig_triggers(rName, sourceColumn, release string) string {
+	return acctest.ConfigCompose(testAccRecipeConfig_basic(rName, sourceColumn), fmt.Sprintf(`
+resource "aws_databrew_recipe_version" "test" {
+  recipe_name = aws_databrew_recipe.test.name
+
+  triggers = {
+    release = %[1]q
+  }
+}
+`, release))
+}
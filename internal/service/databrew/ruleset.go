@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_databrew_ruleset", name="Ruleset")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeRulesetOutput")
+func newRulesetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &rulesetResource{}
+
+	return r, nil
+}
+
+type rulesetResource struct {
+	framework.ResourceWithModel[rulesetResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *rulesetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrName:    nameAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTargetARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ruleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"check_expression": schema.StringAttribute{
+							Required: true,
+						},
+						"disabled": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"substitution_map": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"column_selector": schema.ListNestedBlock{
+							CustomType:   fwtypes.NewListNestedObjectTypeOf[columnSelectorModel](ctx),
+							NestedObject: columnSelectorNestedObject(),
+						},
+						"threshold": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[thresholdModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ThresholdType](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrUnit: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ThresholdUnit](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrValue: schema.Float64Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *rulesetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data rulesetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	var input databrew.CreateRulesetInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRuleset(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Ruleset (%s)", name), err.Error())
+
+		return
+	}
+
+	output, err := findRulesetByName(ctx, conn, name)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrName), name) // Set 'name' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Ruleset (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ARN = fwflex.StringToFramework(ctx, output.ResourceArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *rulesetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data rulesetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findRulesetByName(ctx, conn, name)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Ruleset (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ARN = fwflex.StringToFramework(ctx, output.ResourceArn)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *rulesetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old rulesetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		name := new.Name.ValueString()
+		var input databrew.UpdateRulesetInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		if input.Description == nil {
+			input.Description = aws.String("")
+		}
+
+		_, err := conn.UpdateRuleset(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Ruleset (%s)", name), err.Error())
+
+			return
+		}
+
+		output, err := findRulesetByName(ctx, conn, name)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Ruleset (%s)", name), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *rulesetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data rulesetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	input := databrew.DeleteRulesetInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.DeleteRuleset(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Ruleset (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func findRulesetByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeRulesetOutput, error) {
+	input := databrew.DescribeRulesetInput{
+		Name: aws.String(name),
+	}
+	output, err := conn.DescribeRuleset(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+func columnSelectorNestedObject() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("regex")),
+				},
+			},
+			"regex": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+type rulesetResourceModel struct {
+	framework.WithRegionModel
+	ARN         types.String                               `tfsdk:"arn"`
+	Description types.String                               `tfsdk:"description"`
+	Name        types.String                               `tfsdk:"name"`
+	Rules       fwtypes.ListNestedObjectValueOf[ruleModel] `tfsdk:"rule"`
+	Tags        tftags.Map                                 `tfsdk:"tags"`
+	TagsAll     tftags.Map                                 `tfsdk:"tags_all"`
+	TargetARN   fwtypes.ARN                                `tfsdk:"target_arn"`
+}
+
+type ruleModel struct {
+	CheckExpression types.String                                         `tfsdk:"check_expression"`
+	ColumnSelectors fwtypes.ListNestedObjectValueOf[columnSelectorModel] `tfsdk:"column_selector"`
+	Disabled        types.Bool                                           `tfsdk:"disabled"`
+	Name            types.String                                         `tfsdk:"name"`
+	SubstitutionMap fwtypes.MapOfString                                  `tfsdk:"substitution_map"`
+	Threshold       fwtypes.ListNestedObjectValueOf[thresholdModel]      `tfsdk:"threshold"`
+}
+
+type columnSelectorModel struct {
+	Name  types.String `tfsdk:"name"`
+	Regex types.String `tfsdk:"regex"`
+}
+
+type thresholdModel struct {
+	Type  fwtypes.StringEnum[awstypes.ThresholdType] `tfsdk:"type"`
+	Unit  fwtypes.StringEnum[awstypes.ThresholdUnit] `tfsdk:"unit"`
+	Value types.Float64                              `tfsdk:"value"`
+}
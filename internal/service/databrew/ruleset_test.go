@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRuleset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRulesetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_ruleset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRulesetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesetConfig_basic(rName, "0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, t, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "databrew", "ruleset/"+rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.check_expression", "AGG(MISSING_VALUES_PERCENTAGE) == :val1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.column_selector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.column_selector.0.name", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.disabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "no-missing-names"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.substitution_map.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.substitution_map.:val1", "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTargetARN, "aws_databrew_dataset.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRuleset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRulesetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_ruleset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRulesetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesetConfig_basic(rName, "0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceRuleset, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRuleset_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRulesetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_ruleset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRulesetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesetConfig_basic(rName, "0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.substitution_map.:val1", "0"),
+				),
+			},
+			{
+				Config: testAccRulesetConfig_basic(rName, "10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.substitution_map.:val1", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRulesetDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_ruleset" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindRulesetByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Ruleset %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRulesetExists(ctx context.Context, t *testing.T, n string, v *databrew.DescribeRulesetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindRulesetByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRulesetConfig_basic(rName, threshold string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_basic(rName), fmt.Sprintf(`
+resource "aws_databrew_ruleset" "test" {
+  name       = %[1]q
+  target_arn = aws_databrew_dataset.test.arn
+
+  rule {
+    name             = "no-missing-names"
+    check_expression = "AGG(MISSING_VALUES_PERCENTAGE) == :val1"
+
+    substitution_map = {
+      ":val1" = %[2]q
+    }
+
+    column_selector {
+      name = "name"
+    }
+  }
+}
+`, rName, threshold))
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/authtoken"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+const (
+	authTokenDefaultExpiresIn = 15 * time.Minute
+)
+
+// @EphemeralResource("aws_dsql_auth_token", name="Auth Token")
+func newAuthTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin": schema.BoolAttribute{
+				Optional: true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 604800),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	action := "DbConnect"
+	if data.Admin.ValueBool() {
+		action = "DbConnectAdmin"
+	}
+
+	expiresIn := authTokenDefaultExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+
+	hostname := data.Hostname.ValueString()
+	token, err := authtoken.Presign(ctx, e.Meta().CredentialsProvider(ctx), authtoken.Request{
+		Scheme:    "https",
+		Host:      hostname,
+		Query:     url.Values{"Action": {action}},
+		Service:   "dsql",
+		Region:    e.Meta().Region(ctx),
+		ExpiresIn: expiresIn,
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("generating Aurora DSQL auth token (%s)", hostname), err.Error())
+
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(token.Expiration)
+	data.Token = types.StringValue(token.Value)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Admin      types.Bool        `tfsdk:"admin"`
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	ExpiresIn  types.Int64       `tfsdk:"expires_in"`
+	Hostname   types.String      `tfsdk:"hostname"`
+	Token      types.String      `tfsdk:"token"`
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSQLAuthTokenEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.DSQLServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthTokenEphemeralResourceConfig_basic,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token"), knownvalue.StringRegexp(regexache.MustCompile(`^abcdefghijklmnopqrstuvwxyz\.dsql\.[a-z0-9-]+\.on\.aws/\?Action=DbConnect&X-Amz-Algorithm=AWS4-HMAC-SHA256&.*X-Amz-Expires=900&`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDSQLAuthTokenEphemeral_admin(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.DSQLServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthTokenEphemeralResourceConfig_admin,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token"), knownvalue.StringRegexp(regexache.MustCompile(`^abcdefghijklmnopqrstuvwxyz\.dsql\.[a-z0-9-]+\.on\.aws/\?Action=DbConnectAdmin&X-Amz-Algorithm=AWS4-HMAC-SHA256&.*X-Amz-Expires=3600&`))),
+				},
+			},
+		},
+	})
+}
+
+var testAccAuthTokenEphemeralResourceConfig_basic = acctest.ConfigCompose(
+	acctest.ConfigWithEchoProvider("ephemeral.aws_dsql_auth_token.test"),
+	`
+data "aws_region" "current" {}
+
+ephemeral "aws_dsql_auth_token" "test" {
+  hostname = "abcdefghijklmnopqrstuvwxyz.dsql.${data.aws_region.current.region}.on.aws"
+}
+`)
+
+var testAccAuthTokenEphemeralResourceConfig_admin = acctest.ConfigCompose(
+	acctest.ConfigWithEchoProvider("ephemeral.aws_dsql_auth_token.test"),
+	`
+data "aws_region" "current" {}
+
+ephemeral "aws_dsql_auth_token" "test" {
+  hostname   = "abcdefghijklmnopqrstuvwxyz.dsql.${data.aws_region.current.region}.on.aws"
+  admin      = true
+  expires_in = 3600
+}
+`)
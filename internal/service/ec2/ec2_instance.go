@@ -48,6 +48,7 @@ import (
 // @SDKResource("aws_instance", name="Instance")
 // @Tags(identifierAttribute="id")
 // @IdentityAttribute("id")
+// @ArnFormat("instance/{id}", attribute="arn")
 // @CustomImport
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Instance")
 // @Testing(importIgnore="user_data_replace_on_change")
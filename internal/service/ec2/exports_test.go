@@ -208,6 +208,7 @@ var (
 	FindSecurityGroupByID                                       = findSecurityGroupByID
 	FindSecurityGroupEgressRuleByID                             = findSecurityGroupEgressRuleByID
 	FindSecurityGroupIngressRuleByID                            = findSecurityGroupIngressRuleByID
+	FindSecurityGroupRulesBySecurityGroupID                     = findSecurityGroupRulesBySecurityGroupID
 	FindSecurityGroupVPCAssociationByTwoPartKey                 = findSecurityGroupVPCAssociationByTwoPartKey
 	FindSnapshot                                                = findSnapshot
 	FindSnapshotByID                                            = findSnapshotByID
@@ -41,6 +41,7 @@ const (
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
 // @IdentityAttribute("id")
+// @ArnFormat("vpc-endpoint/{id}", attribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.VpcEndpoint")
 // @Testing(preIdentityVersion="v6.12.0")
 func resourceVPCEndpoint() *schema.Resource {
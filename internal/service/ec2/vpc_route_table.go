@@ -52,6 +52,7 @@ var routeTableValidTargets = []string{
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.RouteTable")
 // @Testing(generator=false)
 // @IdentityAttribute("id")
+// @ArnFormat("route-table/{id}", attribute="arn")
 // @Testing(preIdentityVersion="v6.9.0")
 func resourceRouteTable() *schema.Resource {
 	return &schema.Resource{
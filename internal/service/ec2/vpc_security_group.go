@@ -38,6 +38,7 @@ import (
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.SecurityGroup")
 // @Testing(importIgnore="revoke_rules_on_delete")
 // @IdentityAttribute("id")
+// @ArnFormat("security-group/{id}", attribute="arn")
 // @Testing(preIdentityVersion="v6.7.0")
 // @Testing(plannableImportAction="NoOp")
 func resourceSecurityGroup() *schema.Resource {
@@ -43,7 +43,9 @@ import (
 
 // @FrameworkResource("aws_vpc_security_group_ingress_rule", name="Security Group Ingress Rule")
 // @Tags(identifierAttribute="id")
+// @IAMActions(create="ec2:AuthorizeSecurityGroupIngress;ec2:CreateTags;ec2:DescribeSecurityGroupRules", update="ec2:ModifySecurityGroupRules;ec2:CreateTags;ec2:DeleteTags;ec2:DescribeSecurityGroupRules", delete="ec2:RevokeSecurityGroupIngress")
 // @IdentityAttribute("id")
+// @ArnFormat("security-group-rule/{id}", attribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.SecurityGroupRule")
 // @Testing(idAttrDuplicates="security_group_rule_id")
 // @Testing(preIdentityVersion="v6.12.0")
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newSecurityGroupRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupRulesExclusiveResource{}
+	r.SetExclusiveCollection(r)
+
+	return r, nil
+}
+
+const (
+	ResNameSecurityGroupRulesExclusive = "Security Group Rules Exclusive"
+)
+
+// securityGroupRulesExclusiveResource keeps the rules of a security group in sync with the configured rule IDs.
+//
+// Rules are created by other resources, such as aws_vpc_security_group_ingress_rule.
+// Rules in the security group but not configured on this resource are revoked.
+type securityGroupRulesExclusiveResource struct {
+	framework.ResourceWithExclusiveCollection[securityGroupRulesExclusiveResourceModel, securityGroupRuleMember]
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ingress_rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) ConfiguredMembers(ctx context.Context, data *securityGroupRulesExclusiveResourceModel) ([]securityGroupRuleMember, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var egressRuleIDs, ingressRuleIDs []string
+
+	diags.Append(data.EgressRuleIDs.ElementsAs(ctx, &egressRuleIDs, false)...)
+	diags.Append(data.IngressRuleIDs.ElementsAs(ctx, &ingressRuleIDs, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var members []securityGroupRuleMember
+	for _, v := range egressRuleIDs {
+		members = append(members, securityGroupRuleMember{ruleID: v, isEgress: true})
+	}
+	for _, v := range ingressRuleIDs {
+		members = append(members, securityGroupRuleMember{ruleID: v})
+	}
+
+	return members, diags
+}
+
+func (r *securityGroupRulesExclusiveResource) SetMembers(ctx context.Context, data *securityGroupRulesExclusiveResourceModel, members []securityGroupRuleMember) diag.Diagnostics {
+	var egressRuleIDs, ingressRuleIDs []string
+	for _, v := range members {
+		if v.isEgress {
+			egressRuleIDs = append(egressRuleIDs, v.ruleID)
+		} else {
+			ingressRuleIDs = append(ingressRuleIDs, v.ruleID)
+		}
+	}
+
+	data.EgressRuleIDs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, egressRuleIDs)
+	data.IngressRuleIDs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, ingressRuleIDs)
+
+	return nil
+}
+
+func (r *securityGroupRulesExclusiveResource) ListMembers(ctx context.Context, data *securityGroupRulesExclusiveResourceModel) ([]securityGroupRuleMember, error) {
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := data.SecurityGroupID.ValueString()
+	if _, err := findSecurityGroupByID(ctx, conn, groupID); err != nil {
+		return nil, err
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []securityGroupRuleMember
+	for _, v := range rules {
+		members = append(members, securityGroupRuleMember{
+			ruleID:   aws.ToString(v.SecurityGroupRuleId),
+			isEgress: aws.ToBool(v.IsEgress),
+		})
+	}
+
+	return members, nil
+}
+
+func (r *securityGroupRulesExclusiveResource) AddMembers(ctx context.Context, data *securityGroupRulesExclusiveResourceModel, members []securityGroupRuleMember) error {
+	// Rules can't be created from their IDs alone.
+	ruleIDs := tfslices.ApplyToAll(members, func(v securityGroupRuleMember) string {
+		return v.ruleID
+	})
+
+	return fmt.Errorf("VPC Security Group (%s) rules (%s) not found", data.SecurityGroupID.ValueString(), strings.Join(ruleIDs, ", "))
+}
+
+func (r *securityGroupRulesExclusiveResource) RemoveMembers(ctx context.Context, data *securityGroupRulesExclusiveResourceModel, members []securityGroupRuleMember) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	var egressRuleIDs, ingressRuleIDs []string
+	for _, v := range members {
+		if v.isEgress {
+			egressRuleIDs = append(egressRuleIDs, v.ruleID)
+		} else {
+			ingressRuleIDs = append(ingressRuleIDs, v.ruleID)
+		}
+	}
+
+	groupID := data.SecurityGroupID.ValueString()
+
+	if len(egressRuleIDs) > 0 {
+		input := ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: egressRuleIDs,
+		}
+
+		if _, err := conn.RevokeSecurityGroupEgress(ctx, &input); err != nil {
+			return fmt.Errorf("revoking VPC Security Group (%s) egress rules: %w", groupID, err)
+		}
+	}
+
+	if len(ingressRuleIDs) > 0 {
+		input := ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: ingressRuleIDs,
+		}
+
+		if _, err := conn.RevokeSecurityGroupIngress(ctx, &input); err != nil {
+			return fmt.Errorf("revoking VPC Security Group (%s) ingress rules: %w", groupID, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), request, response)
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	EgressRuleIDs   fwtypes.SetOfString `tfsdk:"egress_rule_ids"`
+	IngressRuleIDs  fwtypes.SetOfString `tfsdk:"ingress_rule_ids"`
+	SecurityGroupID types.String        `tfsdk:"security_group_id"`
+}
+
+type securityGroupRuleMember struct {
+	ruleID   string
+	isEgress bool
+}
@@ -32,6 +32,7 @@ import (
 // @SDKResource("aws_ecr_repository", name="Repository")
 // @Tags(identifierAttribute="arn")
 // @IdentityAttribute("name")
+// @ArnFormat("repository/{name}", attribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecr/types;types.Repository")
 // @Testing(preIdentityVersion="v6.10.0")
 // @Testing(idAttrDuplicates="name")
@@ -267,18 +267,12 @@ func findCapacityProvider(ctx context.Context, conn *ecs.Client, input *ecs.Desc
 func findCapacityProviders(ctx context.Context, conn *ecs.Client, input *ecs.DescribeCapacityProvidersInput) ([]awstypes.CapacityProvider, error) {
 	var output []awstypes.CapacityProvider
 
-	err := describeCapacityProvidersPages(ctx, conn, input, func(page *ecs.DescribeCapacityProvidersOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
+	for v, err := range describeCapacityProviders(ctx, conn, input) {
+		if err != nil {
+			return nil, err
 		}
 
-		output = append(output, page.CapacityProviders...)
-
-		return !lastPage
-	})
-
-	if err != nil {
-		return nil, err
+		output = append(output, v)
 	}
 
 	return output, nil
@@ -57,36 +57,28 @@ func sweepCapacityProviders(region string) error {
 	input := &ecs.DescribeCapacityProvidersInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeCapacityProvidersPages(ctx, conn, input, func(page *ecs.DescribeCapacityProvidersOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
+	for v, err := range describeCapacityProviders(ctx, conn, input) {
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping ECS Capacity Provider sweep for %s: %s", region, err)
+			return nil
 		}
 
-		for _, v := range page.CapacityProviders {
-			arn := aws.ToString(v.CapacityProviderArn)
-
-			if name := aws.ToString(v.Name); name == "FARGATE" || name == "FARGATE_SPOT" {
-				log.Printf("[INFO] Skipping AWS managed ECS Capacity Provider: %s", arn)
-				continue
-			}
+		if err != nil {
+			return fmt.Errorf("error listing ECS Capacity Providers (%s): %w", region, err)
+		}
 
-			r := resourceCapacityProvider()
-			d := r.Data(nil)
-			d.SetId(arn)
+		arn := aws.ToString(v.CapacityProviderArn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		if name := aws.ToString(v.Name); name == "FARGATE" || name == "FARGATE_SPOT" {
+			log.Printf("[INFO] Skipping AWS managed ECS Capacity Provider: %s", arn)
+			continue
 		}
 
-		return !lastPage
-	})
-
-	if awsv2.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping ECS Capacity Provider sweep for %s: %s", region, err)
-		return nil
-	}
+		r := resourceCapacityProvider()
+		d := r.Data(nil)
+		d.SetId(arn)
 
-	if err != nil {
-		return fmt.Errorf("error listing ECS Capacity Providers (%s): %w", region, err)
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
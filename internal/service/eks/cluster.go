@@ -15,7 +15,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -23,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -31,6 +31,7 @@ import (
 )
 
 // @SDKResource("aws_eks_cluster", name="Cluster")
+// @CreationPending
 // @Tags(identifierAttribute="arn")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
@@ -624,6 +625,13 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EKSClient(ctx)
 
+	// Resume waiting for a create that was interrupted or timed out.
+	if retry.CreationPending(ctx) {
+		if _, err := waitClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EKS Cluster (%s) create: %s", d.Id(), err)
+		}
+	}
+
 	cluster, err := findClusterByName(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -987,8 +995,7 @@ func findCluster(ctx context.Context, conn *eks.Client, input *eks.DescribeClust
 	// ClientException: No cluster found for name: tf-acc-test-0o1f8
 	if errs.IsA[*types.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*types.ClientException](err, "No cluster found for name:") {
 		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
+			LastError: err,
 		}
 	}
 
@@ -1059,8 +1066,7 @@ func findUpdate(ctx context.Context, conn *eks.Client, input *eks.DescribeUpdate
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
+			LastError: err,
 		}
 	}
 
@@ -1075,8 +1081,8 @@ func findUpdate(ctx context.Context, conn *eks.Client, input *eks.DescribeUpdate
 	return output.Update, nil
 }
 
-func statusCluster(ctx context.Context, conn *eks.Client, name string) retry.StateRefreshFunc {
-	return func() (any, string, error) {
+func statusCluster(conn *eks.Client, name string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
 		output, err := findClusterByName(ctx, conn, name)
 
 		if tfresource.NotFound(err) {
@@ -1091,8 +1097,8 @@ func statusCluster(ctx context.Context, conn *eks.Client, name string) retry.Sta
 	}
 }
 
-func statusUpdate(ctx context.Context, conn *eks.Client, name, id string) retry.StateRefreshFunc {
-	return func() (any, string, error) {
+func statusUpdate(conn *eks.Client, name, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
 		output, err := findUpdateByTwoPartKey(ctx, conn, name, id)
 
 		if tfresource.NotFound(err) {
@@ -1111,7 +1117,7 @@ func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, time
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating),
 		Target:  enum.Slice(types.ClusterStatusActive),
-		Refresh: statusCluster(ctx, conn, name),
+		Refresh: statusCluster(conn, name),
 		Timeout: timeout,
 	}
 
@@ -1128,7 +1134,7 @@ func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, time
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting),
 		Target:     []string{},
-		Refresh:    statusCluster(ctx, conn, name),
+		Refresh:    statusCluster(conn, name),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		// An attempt to avoid "ResourceInUseException: Cluster already exists with name: ..." errors
@@ -1149,7 +1155,7 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
-		Refresh: statusUpdate(ctx, conn, name, id),
+		Refresh: statusUpdate(conn, name, id),
 		Timeout: timeout,
 	}
 
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/authtoken"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @EphemeralResource("aws_elasticache_auth_token", name="Auth Token")
+func newAuthTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"replication_group_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"serverless_cache_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"user_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) ConfigValidators(context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("replication_group_id"),
+			path.MatchRoot("serverless_cache_name"),
+		),
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{"Action": {"connect"}, "User": {data.UserID.ValueString()}}
+	cacheName := data.ReplicationGroupID.ValueString()
+	if !data.ServerlessCacheName.IsNull() {
+		cacheName = data.ServerlessCacheName.ValueString()
+		query.Set("ResourceType", "ServerlessCache")
+	}
+
+	// The cache name is signed as the request's host and must be lowercase.
+	token, err := authtoken.Presign(ctx, e.Meta().CredentialsProvider(ctx), authtoken.Request{
+		Scheme:    "http",
+		Host:      strings.ToLower(cacheName),
+		Query:     query,
+		Service:   "elasticache",
+		Region:    e.Meta().Region(ctx),
+		ExpiresIn: 15 * time.Minute,
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("generating ElastiCache auth token (%s)", cacheName), err.Error())
+
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(token.Expiration)
+	data.Token = types.StringValue(token.Value)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Expiration          timetypes.RFC3339 `tfsdk:"expiration"`
+	ReplicationGroupID  types.String      `tfsdk:"replication_group_id"`
+	ServerlessCacheName types.String      `tfsdk:"serverless_cache_name"`
+	Token               types.String      `tfsdk:"token"`
+	UserID              types.String      `tfsdk:"user_id"`
+}
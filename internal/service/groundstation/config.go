@@ -0,0 +1,686 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_groundstation_config", name="Config")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/groundstation;groundstation.GetConfigOutput")
+func newConfigResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &configResource{}
+
+	return r, nil
+}
+
+type configResource struct {
+	framework.ResourceWithModel[configResourceModel]
+	framework.WithImportByID
+}
+
+func (r *configResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	configDataPaths := []path.Expression{
+		path.MatchRelative().AtParent().AtName("antenna_downlink_config"),
+		path.MatchRelative().AtParent().AtName("antenna_downlink_demod_decode_config"),
+		path.MatchRelative().AtParent().AtName("antenna_uplink_config"),
+		path.MatchRelative().AtParent().AtName("dataflow_endpoint_config"),
+		path.MatchRelative().AtParent().AtName("s3_recording_config"),
+		path.MatchRelative().AtParent().AtName("tracking_config"),
+		path.MatchRelative().AtParent().AtName("uplink_echo_config"),
+	}
+	configDataValidators := []validator.List{
+		listvalidator.SizeAtMost(1),
+		listvalidator.ExactlyOneOf(configDataPaths...),
+	}
+	configDataPlanModifiers := []planmodifier.List{
+		listplanmodifier.RequiresReplaceIf(requiresReplaceIfConfigTypeChanges, "Changing the type of a Ground Station Config requires replacement", "Changing the type of a Ground Station Config requires replacement"),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"config_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfigCapabilityType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"config_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[configDataModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"antenna_downlink_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[antennaDownlinkConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators,
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"spectrum_config": spectrumConfigBlock(ctx),
+								},
+							},
+						},
+						"antenna_downlink_demod_decode_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[antennaDownlinkDemodDecodeConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators,
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"decode_config":       unvalidatedJSONBlock(ctx),
+									"demodulation_config": unvalidatedJSONBlock(ctx),
+									"spectrum_config":     spectrumConfigBlock(ctx),
+								},
+							},
+						},
+						"antenna_uplink_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[antennaUplinkConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"transmit_disabled": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"spectrum_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[uplinkSpectrumConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"polarization": polarizationAttribute(),
+											},
+											Blocks: map[string]schema.Block{
+												"center_frequency": frequencyBlock(ctx),
+											},
+										},
+									},
+									"target_eirp": eirpBlock(ctx),
+								},
+							},
+						},
+						"dataflow_endpoint_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[dataflowEndpointConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"dataflow_endpoint_name": schema.StringAttribute{
+										Required: true,
+									},
+									"dataflow_endpoint_region": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+						"s3_recording_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[s3RecordingConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bucket_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrPrefix: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"tracking_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[trackingConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"autotrack": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Criticality](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"uplink_echo_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[uplinkEchoConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"antenna_uplink_config_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrEnabled: schema.BoolAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func spectrumConfigBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[spectrumConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"polarization": polarizationAttribute(),
+			},
+			Blocks: map[string]schema.Block{
+				"bandwidth":        frequencyBandwidthBlock(ctx),
+				"center_frequency": frequencyBlock(ctx),
+			},
+		},
+	}
+}
+
+func polarizationAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.Polarization](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func unvalidatedJSONBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[unvalidatedJSONModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"unvalidated_json": schema.StringAttribute{
+					CustomType: jsontypes.NormalizedType{},
+					Required:   true,
+				},
+			},
+		},
+	}
+}
+
+func frequencyBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[frequencyModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"units": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.FrequencyUnits](),
+					Required:   true,
+				},
+				names.AttrValue: schema.Float64Attribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func frequencyBandwidthBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[frequencyBandwidthModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"units": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.BandwidthUnits](),
+					Required:   true,
+				},
+				names.AttrValue: schema.Float64Attribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func eirpBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[eirpModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"units": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.EirpUnits](),
+					Required:   true,
+				},
+				names.AttrValue: schema.Float64Attribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *configResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input groundstation.CreateConfigInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateConfig(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Ground Station Config (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.ConfigArn)
+	data.ConfigID = fwflex.StringToFramework(ctx, output.ConfigId)
+	data.ConfigType = fwtypes.StringEnumValue(output.ConfigType)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID Ground Station Config", err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	config, err := findConfigByTwoPartKey(ctx, conn, data.ConfigID.ValueString(), data.ConfigType.ValueEnum())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, config, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *configResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findConfigByTwoPartKey(ctx, conn, data.ConfigID.ValueString(), data.ConfigType.ValueEnum())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ARN = fwflex.StringToFramework(ctx, output.ConfigArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		id := fwflex.StringValueFromFramework(ctx, new.ID)
+		var input groundstation.UpdateConfigInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateConfig(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Ground Station Config (%s)", id), err.Error())
+
+			return
+		}
+
+		output, err := findConfigByTwoPartKey(ctx, conn, new.ConfigID.ValueString(), new.ConfigType.ValueEnum())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", id), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *configResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	tflog.Debug(ctx, "deleting Ground Station Config", map[string]any{
+		names.AttrID: id,
+	})
+
+	input := groundstation.DeleteConfigInput{
+		ConfigId:   fwflex.StringFromFramework(ctx, data.ConfigID),
+		ConfigType: data.ConfigType.ValueEnum(),
+	}
+	_, err := conn.DeleteConfig(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Config (%s)", id), err.Error())
+
+		return
+	}
+}
+
+// requiresReplaceIfConfigTypeChanges forces replacement when a different config_data variant is configured.
+func requiresReplaceIfConfigTypeChanges(ctx context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	response.RequiresReplace = request.StateValue.IsNull() != request.PlanValue.IsNull()
+}
+
+func findConfigByTwoPartKey(ctx context.Context, conn *groundstation.Client, configID string, configType awstypes.ConfigCapabilityType) (*groundstation.GetConfigOutput, error) {
+	input := groundstation.GetConfigInput{
+		ConfigId:   aws.String(configID),
+		ConfigType: configType,
+	}
+	output, err := conn.GetConfig(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfigData == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+type configResourceModel struct {
+	framework.WithRegionModel
+	ARN        types.String                                      `tfsdk:"arn" autoflex:",noflatten"`
+	ConfigData fwtypes.ListNestedObjectValueOf[configDataModel]  `tfsdk:"config_data"`
+	ConfigID   types.String                                      `tfsdk:"config_id"`
+	ConfigType fwtypes.StringEnum[awstypes.ConfigCapabilityType] `tfsdk:"config_type"`
+	ID         types.String                                      `tfsdk:"id" autoflex:",noflatten"`
+	Name       types.String                                      `tfsdk:"name"`
+	Tags       tftags.Map                                        `tfsdk:"tags"`
+	TagsAll    tftags.Map                                        `tfsdk:"tags_all"`
+}
+
+const (
+	configResourceIDPartCount = 2
+)
+
+func (m *configResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), configResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ConfigID = types.StringValue(parts[0])
+	m.ConfigType = fwtypes.StringEnumValue(awstypes.ConfigCapabilityType(parts[1]))
+
+	return nil
+}
+
+func (m *configResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ConfigID.ValueString(),
+		m.ConfigType.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, configResourceIDPartCount, false)
+}
+
+type configDataModel struct {
+	AntennaDownlinkConfig            fwtypes.ListNestedObjectValueOf[antennaDownlinkConfigModel]            `tfsdk:"antenna_downlink_config"`
+	AntennaDownlinkDemodDecodeConfig fwtypes.ListNestedObjectValueOf[antennaDownlinkDemodDecodeConfigModel] `tfsdk:"antenna_downlink_demod_decode_config"`
+	AntennaUplinkConfig              fwtypes.ListNestedObjectValueOf[antennaUplinkConfigModel]              `tfsdk:"antenna_uplink_config"`
+	DataflowEndpointConfig           fwtypes.ListNestedObjectValueOf[dataflowEndpointConfigModel]           `tfsdk:"dataflow_endpoint_config"`
+	S3RecordingConfig                fwtypes.ListNestedObjectValueOf[s3RecordingConfigModel]                `tfsdk:"s3_recording_config"`
+	TrackingConfig                   fwtypes.ListNestedObjectValueOf[trackingConfigModel]                   `tfsdk:"tracking_config"`
+	UplinkEchoConfig                 fwtypes.ListNestedObjectValueOf[uplinkEchoConfigModel]                 `tfsdk:"uplink_echo_config"`
+}
+
+var (
+	_ fwflex.UnionModel = configDataModel{}
+)
+
+func (configDataModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.ConfigTypeDataMemberAntennaDownlinkConfig{},
+		&awstypes.ConfigTypeDataMemberAntennaDownlinkDemodDecodeConfig{},
+		&awstypes.ConfigTypeDataMemberAntennaUplinkConfig{},
+		&awstypes.ConfigTypeDataMemberDataflowEndpointConfig{},
+		&awstypes.ConfigTypeDataMemberS3RecordingConfig{},
+		&awstypes.ConfigTypeDataMemberTrackingConfig{},
+		&awstypes.ConfigTypeDataMemberUplinkEchoConfig{},
+	}
+}
+
+type antennaDownlinkConfigModel struct {
+	SpectrumConfig fwtypes.ListNestedObjectValueOf[spectrumConfigModel] `tfsdk:"spectrum_config"`
+}
+
+type antennaDownlinkDemodDecodeConfigModel struct {
+	DecodeConfig       fwtypes.ListNestedObjectValueOf[unvalidatedJSONModel] `tfsdk:"decode_config"`
+	DemodulationConfig fwtypes.ListNestedObjectValueOf[unvalidatedJSONModel] `tfsdk:"demodulation_config"`
+	SpectrumConfig     fwtypes.ListNestedObjectValueOf[spectrumConfigModel]  `tfsdk:"spectrum_config"`
+}
+
+type antennaUplinkConfigModel struct {
+	SpectrumConfig   fwtypes.ListNestedObjectValueOf[uplinkSpectrumConfigModel] `tfsdk:"spectrum_config"`
+	TargetEIRP       fwtypes.ListNestedObjectValueOf[eirpModel]                 `tfsdk:"target_eirp"`
+	TransmitDisabled types.Bool                                                 `tfsdk:"transmit_disabled"`
+}
+
+type dataflowEndpointConfigModel struct {
+	DataflowEndpointName   types.String `tfsdk:"dataflow_endpoint_name"`
+	DataflowEndpointRegion types.String `tfsdk:"dataflow_endpoint_region"`
+}
+
+type s3RecordingConfigModel struct {
+	BucketARN fwtypes.ARN  `tfsdk:"bucket_arn"`
+	Prefix    types.String `tfsdk:"prefix"`
+	RoleARN   fwtypes.ARN  `tfsdk:"role_arn"`
+}
+
+type trackingConfigModel struct {
+	Autotrack fwtypes.StringEnum[awstypes.Criticality] `tfsdk:"autotrack"`
+}
+
+type uplinkEchoConfigModel struct {
+	AntennaUplinkConfigARN fwtypes.ARN `tfsdk:"antenna_uplink_config_arn"`
+	Enabled                types.Bool  `tfsdk:"enabled"`
+}
+
+type spectrumConfigModel struct {
+	Bandwidth       fwtypes.ListNestedObjectValueOf[frequencyBandwidthModel] `tfsdk:"bandwidth"`
+	CenterFrequency fwtypes.ListNestedObjectValueOf[frequencyModel]          `tfsdk:"center_frequency"`
+	Polarization    fwtypes.StringEnum[awstypes.Polarization]                `tfsdk:"polarization"`
+}
+
+type uplinkSpectrumConfigModel struct {
+	CenterFrequency fwtypes.ListNestedObjectValueOf[frequencyModel] `tfsdk:"center_frequency"`
+	Polarization    fwtypes.StringEnum[awstypes.Polarization]       `tfsdk:"polarization"`
+}
+
+type frequencyModel struct {
+	Units fwtypes.StringEnum[awstypes.FrequencyUnits] `tfsdk:"units"`
+	Value types.Float64                               `tfsdk:"value"`
+}
+
+type frequencyBandwidthModel struct {
+	Units fwtypes.StringEnum[awstypes.BandwidthUnits] `tfsdk:"units"`
+	Value types.Float64                               `tfsdk:"value"`
+}
+
+type eirpModel struct {
+	Units fwtypes.StringEnum[awstypes.EirpUnits] `tfsdk:"units"`
+	Value types.Float64                          `tfsdk:"value"`
+}
+
+type unvalidatedJSONModel struct {
+	UnvalidatedJSON jsontypes.Normalized `tfsdk:"unvalidated_json"`
+}
@@ -0,0 +1,404 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, "PREFERRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNRegexp("groundstation", regexache.MustCompile(`config/tracking/.+`))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("config_data"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"tracking_config": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"autotrack": tfknownvalue.StringExact(awstypes.CriticalityPreferred),
+								}),
+							}),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("config_type"), tfknownvalue.StringExact(awstypes.ConfigCapabilityTypeTracking)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigConfig_tracking(rName, "REQUIRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("config_data").AtSliceIndex(0).AtMapKey("tracking_config").AtSliceIndex(0).AtMapKey("autotrack"), tfknownvalue.StringExact(awstypes.CriticalityRequired)),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, "PREFERRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfgroundstation.ResourceConfig, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_antennaDownlink(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_antennaDownlink(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("config_type"), tfknownvalue.StringExact(awstypes.ConfigCapabilityTypeAntennaDownlink)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("config_data").AtSliceIndex(0).AtMapKey("antenna_downlink_config").AtSliceIndex(0).AtMapKey("spectrum_config"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"bandwidth": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"units":         tfknownvalue.StringExact(awstypes.BandwidthUnitsMhz),
+									names.AttrValue: knownvalue.Float64Exact(30),
+								}),
+							}),
+							"center_frequency": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"units":         tfknownvalue.StringExact(awstypes.FrequencyUnitsMhz),
+									names.AttrValue: knownvalue.Float64Exact(7812),
+								}),
+							}),
+							"polarization": tfknownvalue.StringExact(awstypes.PolarizationRightHand),
+						}),
+					})),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigConfig_dataflowEndpoint(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("config_type"), tfknownvalue.StringExact(awstypes.ConfigCapabilityTypeDataflowEndpoint)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1Updated),
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+			},
+			{
+				Config: testAccConfigConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_groundstation_config" {
+				continue
+			}
+
+			_, err := tfgroundstation.FindConfigByTwoPartKey(ctx, conn, rs.Primary.Attributes["config_id"], awstypes.ConfigCapabilityType(rs.Primary.Attributes["config_type"]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Ground Station Config %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigExists(ctx context.Context, n string, v *groundstation.GetConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		output, err := tfgroundstation.FindConfigByTwoPartKey(ctx, conn, parts[0], awstypes.ConfigCapabilityType(parts[1]))
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+	input := groundstation.ListConfigsInput{}
+	_, err := conn.ListConfigs(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccConfigConfig_tracking(rName, autotrack string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = %[2]q
+    }
+  }
+}
+`, rName, autotrack)
+}
+
+func testAccConfigConfig_antennaDownlink(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    antenna_downlink_config {
+      spectrum_config {
+        polarization = "RIGHT_HAND"
+
+        bandwidth {
+          units = "MHz"
+          value = 30
+        }
+
+        center_frequency {
+          units = "MHz"
+          value = 7812
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfigConfig_dataflowEndpoint(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    dataflow_endpoint_config {
+      dataflow_endpoint_name = %[1]q
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfigConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccConfigConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,420 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_groundstation_mission_profile", name="Mission Profile")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/groundstation;groundstation.GetMissionProfileOutput")
+func newMissionProfileResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &missionProfileResource{}
+
+	return r, nil
+}
+
+type missionProfileResource struct {
+	framework.ResourceWithModel[missionProfileResourceModel]
+	framework.WithImportByID
+}
+
+func (r *missionProfileResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"contact_post_pass_duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 21600),
+				},
+			},
+			"contact_pre_pass_duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 21600),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"minimum_viable_contact_duration_seconds": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 21600),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"streams_kms_role": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tracking_config_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dataflow_edge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataflowEdgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDestination: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrSource: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"streams_kms_key": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[kmsKeyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.AlsoRequires(path.MatchRoot("streams_kms_role")),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_alias_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("kms_alias_arn"),
+									path.MatchRelative().AtParent().AtName("kms_alias_name"),
+									path.MatchRelative().AtParent().AtName("kms_key_arn"),
+								),
+							},
+						},
+						"kms_alias_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"kms_key_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *missionProfileResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input groundstation.CreateMissionProfileInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithIgnoredFieldNamesAppend("DataflowEdges"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	dataflowEdges, d := data.expandDataflowEdges(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.DataflowEdges = dataflowEdges
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateMissionProfile(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Ground Station Mission Profile (%s)", name), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.MissionProfileId)
+	missionProfile, err := findMissionProfileByID(ctx, conn, id)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, missionProfile)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *missionProfileResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findMissionProfileByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *missionProfileResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		id := fwflex.StringValueFromFramework(ctx, new.ID)
+		var input groundstation.UpdateMissionProfileInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, fwflex.WithIgnoredFieldNamesAppend("DataflowEdges"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		dataflowEdges, d := new.expandDataflowEdges(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.DataflowEdges = dataflowEdges
+		input.MissionProfileId = aws.String(id)
+
+		_, err := conn.UpdateMissionProfile(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Ground Station Mission Profile (%s)", id), err.Error())
+
+			return
+		}
+
+		output, err := findMissionProfileByID(ctx, conn, id)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", id), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, output)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *missionProfileResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	tflog.Debug(ctx, "deleting Ground Station Mission Profile", map[string]any{
+		names.AttrID: id,
+	})
+
+	input := groundstation.DeleteMissionProfileInput{
+		MissionProfileId: aws.String(id),
+	}
+	_, err := conn.DeleteMissionProfile(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Mission Profile (%s)", id), err.Error())
+
+		return
+	}
+}
+
+func findMissionProfileByID(ctx context.Context, conn *groundstation.Client, id string) (*groundstation.GetMissionProfileOutput, error) {
+	input := groundstation.GetMissionProfileInput{
+		MissionProfileId: aws.String(id),
+	}
+	output, err := conn.GetMissionProfile(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.MissionProfileId == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+type missionProfileResourceModel struct {
+	framework.WithRegionModel
+	ARN                                 types.String                                       `tfsdk:"arn"`
+	ContactPostPassDurationSeconds      types.Int64                                        `tfsdk:"contact_post_pass_duration_seconds"`
+	ContactPrePassDurationSeconds       types.Int64                                        `tfsdk:"contact_pre_pass_duration_seconds"`
+	DataflowEdges                       fwtypes.ListNestedObjectValueOf[dataflowEdgeModel] `tfsdk:"dataflow_edge"`
+	ID                                  types.String                                       `tfsdk:"id"`
+	MinimumViableContactDurationSeconds types.Int64                                        `tfsdk:"minimum_viable_contact_duration_seconds"`
+	Name                                types.String                                       `tfsdk:"name"`
+	StreamsKMSKey                       fwtypes.ListNestedObjectValueOf[kmsKeyModel]       `tfsdk:"streams_kms_key"`
+	StreamsKMSRole                      fwtypes.ARN                                        `tfsdk:"streams_kms_role"`
+	Tags                                tftags.Map                                         `tfsdk:"tags"`
+	TagsAll                             tftags.Map                                         `tfsdk:"tags_all"`
+	TrackingConfigARN                   fwtypes.ARN                                        `tfsdk:"tracking_config_arn"`
+}
+
+func (m *missionProfileResourceModel) flatten(ctx context.Context, apiObject *groundstation.GetMissionProfileOutput) diag.Diagnostics {
+	diags := fwflex.Flatten(ctx, apiObject, m, fwflex.WithIgnoredFieldNamesAppend("DataflowEdges"), fwflex.WithFieldNamePrefix("MissionProfile"))
+	if diags.HasError() {
+		return diags
+	}
+
+	// Edges are modelled as [source, destination] pairs.
+	edges := make([]*dataflowEdgeModel, 0, len(apiObject.DataflowEdges))
+	for _, v := range apiObject.DataflowEdges {
+		if len(v) != 2 {
+			continue
+		}
+		edges = append(edges, &dataflowEdgeModel{
+			Destination: fwtypes.ARNValue(v[1]),
+			Source:      fwtypes.ARNValue(v[0]),
+		})
+	}
+	m.DataflowEdges = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, edges)
+
+	return diags
+}
+
+func (m missionProfileResourceModel) expandDataflowEdges(ctx context.Context) ([][]string, diag.Diagnostics) {
+	edges, diags := m.DataflowEdges.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([][]string, 0, len(edges))
+	for _, v := range edges {
+		apiObjects = append(apiObjects, []string{v.Source.ValueString(), v.Destination.ValueString()})
+	}
+
+	return apiObjects, diags
+}
+
+type dataflowEdgeModel struct {
+	Destination fwtypes.ARN `tfsdk:"destination"`
+	Source      fwtypes.ARN `tfsdk:"source"`
+}
+
+type kmsKeyModel struct {
+	KMSAliasARN  fwtypes.ARN  `tfsdk:"kms_alias_arn"`
+	KMSAliasName types.String `tfsdk:"kms_alias_name"`
+	KMSKeyARN    fwtypes.ARN  `tfsdk:"kms_key_arn"`
+}
+
+var (
+	_ fwflex.UnionModel = kmsKeyModel{}
+)
+
+func (kmsKeyModel) UnionMemberTypes() []any {
+	return []any{
+		&awstypes.KmsKeyMemberKmsAliasArn{},
+		&awstypes.KmsKeyMemberKmsAliasName{},
+		&awstypes.KmsKeyMemberKmsKeyArn{},
+	}
+}
@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationMissionProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetMissionProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMissionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig_basic(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNRegexp("groundstation", regexache.MustCompile(`mission-profile/.+`))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("dataflow_edge"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("minimum_viable_contact_duration_seconds"), knownvalue.Int64Exact(60)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMissionProfileConfig_basic(rName, 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("minimum_viable_contact_duration_seconds"), knownvalue.Int64Exact(120)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccGroundStationMissionProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetMissionProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMissionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig_basic(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfgroundstation.ResourceMissionProfile, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationMissionProfile_streamsKMSKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetMissionProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+	keyResourceName := "aws_kms_key.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMissionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig_streamsKMSKey(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "streams_kms_key.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "streams_kms_key.0.kms_key_arn", keyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "streams_kms_role", roleResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationMissionProfile_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetMissionProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMissionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMissionProfileConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1Updated),
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+			},
+			{
+				Config: testAccMissionProfileConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckMissionProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_groundstation_mission_profile" {
+				continue
+			}
+
+			_, err := tfgroundstation.FindMissionProfileByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Ground Station Mission Profile %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMissionProfileExists(ctx context.Context, n string, v *groundstation.GetMissionProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		output, err := tfgroundstation.FindMissionProfileByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMissionProfileConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "tracking" {
+  name = "%[1]s-tracking"
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+}
+
+resource "aws_groundstation_config" "downlink" {
+  name = "%[1]s-downlink"
+
+  config_data {
+    antenna_downlink_config {
+      spectrum_config {
+        polarization = "RIGHT_HAND"
+
+        bandwidth {
+          units = "MHz"
+          value = 30
+        }
+
+        center_frequency {
+          units = "MHz"
+          value = 7812
+        }
+      }
+    }
+  }
+}
+
+resource "aws_groundstation_config" "endpoint" {
+  name = "%[1]s-endpoint"
+
+  config_data {
+    dataflow_endpoint_config {
+      dataflow_endpoint_name = %[1]q
+    }
+  }
+}
+`, rName)
+}
+
+func testAccMissionProfileConfig_basic(rName string, minimumViableContactDurationSeconds int) string {
+	return acctest.ConfigCompose(testAccMissionProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = %[2]d
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.downlink.arn
+    destination = aws_groundstation_config.endpoint.arn
+  }
+}
+`, rName, minimumViableContactDurationSeconds))
+}
+
+func testAccMissionProfileConfig_streamsKMSKey(rName string) string {
+	return acctest.ConfigCompose(testAccMissionProfileConfig_base(rName), fmt.Sprintf(`
+data "aws_service_principal" "groundstation" {
+  service_name = "groundstation"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = data.aws_service_principal.groundstation.name
+      }
+    }]
+  })
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = 60
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+  streams_kms_role                        = aws_iam_role.test.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.downlink.arn
+    destination = aws_groundstation_config.endpoint.arn
+  }
+
+  streams_kms_key {
+    kms_key_arn = aws_kms_key.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccMissionProfileConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccMissionProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = 60
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.downlink.arn
+    destination = aws_groundstation_config.endpoint.arn
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccMissionProfileConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccMissionProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = 60
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.downlink.arn
+    destination = aws_groundstation_config.endpoint.arn
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,469 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_healthlake_fhir_datastore", name="FHIR Datastore")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/healthlake/types;awstypes;awstypes.DatastoreProperties")
+func newFHIRDatastoreResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &fhirDatastoreResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type fhirDatastoreResource struct {
+	framework.ResourceWithModel[fhirDatastoreResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *fhirDatastoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"datastore_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DatastoreStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_type_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FHIRVersion](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"identity_provider_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[identityProviderConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"authorization_strategy": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AuthorizationStrategy](),
+							Required:   true,
+						},
+						"fine_grained_authorization_enabled": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"idp_lambda_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"metadata": schema.StringAttribute{
+							CustomType: jsontypes.NormalizedType{},
+							Optional:   true,
+						},
+					},
+				},
+			},
+			"preload_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[preloadDataConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"preload_data_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.PreloadDataType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"sse_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sseConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kms_encryption_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[kmsEncryptionConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"cmk_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.CmkType](),
+										Required:   true,
+									},
+									names.AttrKMSKeyID: schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 400),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *fhirDatastoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data fhirDatastoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	var input healthlake.CreateFHIRDatastoreInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFHIRDatastore(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating HealthLake FHIR Datastore", err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.DatastoreId)
+	datastore, err := waitFHIRDatastoreCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for HealthLake FHIR Datastore (%s) create", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, datastore)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *fhirDatastoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fhirDatastoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findFHIRDatastoreByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading HealthLake FHIR Datastore (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *fhirDatastoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data fhirDatastoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	tflog.Debug(ctx, "deleting HealthLake FHIR Datastore", map[string]any{
+		names.AttrID: id,
+	})
+
+	input := healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+	_, err := conn.DeleteFHIRDatastore(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting HealthLake FHIR Datastore (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitFHIRDatastoreDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for HealthLake FHIR Datastore (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *fhirDatastoreResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func findFHIRDatastoreByID(ctx context.Context, conn *healthlake.Client, id string) (*awstypes.DatastoreProperties, error) {
+	input := healthlake.DescribeFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+	output, err := conn.DescribeFHIRDatastore(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DatastoreProperties == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	if status := output.DatastoreProperties.DatastoreStatus; status == awstypes.DatastoreStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: &input,
+		}
+	}
+
+	return output.DatastoreProperties, nil
+}
+
+func statusFHIRDatastore(ctx context.Context, conn *healthlake.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findFHIRDatastoreByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DatastoreStatus), nil
+	}
+}
+
+func waitFHIRDatastoreCreated(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DatastoreStatusCreating),
+		Target:     enum.Slice(awstypes.DatastoreStatusActive),
+		Refresh:    statusFHIRDatastore(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		if v := output.ErrorCause; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFHIRDatastoreDeleted(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DatastoreStatusActive, awstypes.DatastoreStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusFHIRDatastore(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type fhirDatastoreResourceModel struct {
+	framework.WithRegionModel
+	ARN                           types.String                                                        `tfsdk:"arn"`
+	CreatedAt                     timetypes.RFC3339                                                   `tfsdk:"created_at"`
+	DatastoreEndpoint             types.String                                                        `tfsdk:"datastore_endpoint"`
+	DatastoreName                 types.String                                                        `tfsdk:"datastore_name"`
+	DatastoreStatus               fwtypes.StringEnum[awstypes.DatastoreStatus]                        `tfsdk:"datastore_status"`
+	DatastoreTypeVersion          fwtypes.StringEnum[awstypes.FHIRVersion]                            `tfsdk:"datastore_type_version"`
+	ID                            types.String                                                        `tfsdk:"id"`
+	IdentityProviderConfiguration fwtypes.ListNestedObjectValueOf[identityProviderConfigurationModel] `tfsdk:"identity_provider_configuration"`
+	PreloadDataConfig             fwtypes.ListNestedObjectValueOf[preloadDataConfigModel]             `tfsdk:"preload_data_config"`
+	SSEConfiguration              fwtypes.ListNestedObjectValueOf[sseConfigurationModel]              `tfsdk:"sse_configuration"`
+	Tags                          tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                                      `tfsdk:"timeouts"`
+}
+
+func (m *fhirDatastoreResourceModel) flatten(ctx context.Context, apiObject *awstypes.DatastoreProperties) diag.Diagnostics {
+	// Configuration blocks that were omitted are reported by the API with their default values.
+	// Keep them null so as not to show a perpetual diff.
+	identityProviderConfiguration, preloadDataConfig, sseConfiguration := m.IdentityProviderConfiguration, m.PreloadDataConfig, m.SSEConfiguration
+
+	diags := fwflex.Flatten(ctx, apiObject, m, fwflex.WithFieldNamePrefix("Datastore"))
+	if diags.HasError() {
+		return diags
+	}
+
+	if identityProviderConfiguration.IsNull() {
+		if v := apiObject.IdentityProviderConfiguration; v == nil || v.AuthorizationStrategy == awstypes.AuthorizationStrategyAwsAuth {
+			m.IdentityProviderConfiguration = identityProviderConfiguration
+		}
+	}
+	if preloadDataConfig.IsNull() {
+		m.PreloadDataConfig = preloadDataConfig
+	}
+	if sseConfiguration.IsNull() {
+		if v := apiObject.SseConfiguration; v == nil || v.KmsEncryptionConfig == nil || v.KmsEncryptionConfig.CmkType == awstypes.CmkTypeAoCmk {
+			m.SSEConfiguration = sseConfiguration
+		}
+	}
+
+	return diags
+}
+
+type identityProviderConfigurationModel struct {
+	AuthorizationStrategy           fwtypes.StringEnum[awstypes.AuthorizationStrategy] `tfsdk:"authorization_strategy"`
+	FineGrainedAuthorizationEnabled types.Bool                                         `tfsdk:"fine_grained_authorization_enabled"`
+	IdpLambdaARN                    fwtypes.ARN                                        `tfsdk:"idp_lambda_arn"`
+	Metadata                        jsontypes.Normalized                               `tfsdk:"metadata"`
+}
+
+type preloadDataConfigModel struct {
+	PreloadDataType fwtypes.StringEnum[awstypes.PreloadDataType] `tfsdk:"preload_data_type"`
+}
+
+type sseConfigurationModel struct {
+	KMSEncryptionConfig fwtypes.ListNestedObjectValueOf[kmsEncryptionConfigModel] `tfsdk:"kms_encryption_config"`
+}
+
+type kmsEncryptionConfigModel struct {
+	CmkType  fwtypes.StringEnum[awstypes.CmkType] `tfsdk:"cmk_type"`
+	KMSKeyID types.String                         `tfsdk:"kms_key_id"`
+}
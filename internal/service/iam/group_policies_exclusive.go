@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,26 +21,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_iam_group_policies_exclusive", name="Group Policies Exclusive")
 func newGroupPoliciesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &groupPoliciesExclusiveResource{}, nil
+	r := &groupPoliciesExclusiveResource{}
+	r.SetExclusiveCollection(r)
+
+	return r, nil
 }
 
 const (
 	ResNameGroupPoliciesExclusive = "Group Policies Exclusive"
 )
 
+// groupPoliciesExclusiveResource keeps the configured inline policy attachments
+// in sync with the remote resource.
+//
+// Inline policies defined on this resource but not attached to the group will
+// be added. Policies attached to the group but not configured on this resource
+// will be removed.
 type groupPoliciesExclusiveResource struct {
-	framework.ResourceWithModel[groupPoliciesExclusiveResourceModel]
-	framework.WithNoOpDelete
+	framework.ResourceWithExclusiveCollection[groupPoliciesExclusiveResourceModel, string]
 }
 
 func (r *groupPoliciesExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -63,104 +70,38 @@ func (r *groupPoliciesExclusiveResource) Schema(ctx context.Context, req resourc
 	}
 }
 
-func (r *groupPoliciesExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan groupPoliciesExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
+func (r *groupPoliciesExclusiveResource) ConfiguredMembers(ctx context.Context, data *groupPoliciesExclusiveResourceModel) ([]string, diag.Diagnostics) {
 	var policyNames []string
-	resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	diags := data.PolicyNames.ElementsAs(ctx, &policyNames, false)
 
-	err := r.syncAttachments(ctx, plan.GroupName.ValueString(), policyNames)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameGroupPoliciesExclusive, plan.GroupName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	return policyNames, diags
 }
 
-func (r *groupPoliciesExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	conn := r.Meta().IAMClient(ctx)
+func (r *groupPoliciesExclusiveResource) SetMembers(ctx context.Context, data *groupPoliciesExclusiveResourceModel, policyNames []string) diag.Diagnostics {
+	data.PolicyNames = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, policyNames)
 
-	var state groupPoliciesExclusiveResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	out, err := findGroupPoliciesByName(ctx, conn, state.GroupName.ValueString())
-	if tfresource.NotFound(err) {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameGroupPoliciesExclusive, state.GroupName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	state.PolicyNames = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, out)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return nil
 }
 
-func (r *groupPoliciesExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state groupPoliciesExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func (r *groupPoliciesExclusiveResource) ErrorSummary(data *groupPoliciesExclusiveResourceModel, action string, err error) string {
+	return create.ProblemStandardMessage(names.IAM, action, ResNameGroupPoliciesExclusive, data.GroupName.String(), err)
+}
 
-	if !plan.PolicyNames.Equal(state.PolicyNames) {
-		var policyNames []string
-		resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+// OmitNotFoundWarning removes the resource from state without a warning when the group no longer exists.
+func (r *groupPoliciesExclusiveResource) OmitNotFoundWarning() {}
 
-		err := r.syncAttachments(ctx, plan.GroupName.ValueString(), policyNames)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameGroupPoliciesExclusive, plan.GroupName.String(), err),
-				err.Error(),
-			)
-			return
-		}
-	}
+func (r *groupPoliciesExclusiveResource) ListMembers(ctx context.Context, data *groupPoliciesExclusiveResourceModel) ([]string, error) {
+	conn := r.Meta().IAMClient(ctx)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	return findGroupPoliciesByName(ctx, conn, data.GroupName.ValueString())
 }
 
-// syncAttachments handles keeping the configured inline policy attachments
-// in sync with the remote resource.
-//
-// Inline policies defined on this resource but not attached to the group will
-// be added. Policies attached to the group but not configured on this resource
-// will be removed.
-func (r *groupPoliciesExclusiveResource) syncAttachments(ctx context.Context, groupName string, want []string) error {
+func (r *groupPoliciesExclusiveResource) AddMembers(ctx context.Context, data *groupPoliciesExclusiveResourceModel, policyNames []string) error {
 	conn := r.Meta().IAMClient(ctx)
 
-	have, err := findGroupPoliciesByName(ctx, conn, groupName)
-	if err != nil {
-		return err
-	}
-
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, name := range create {
+	for _, name := range policyNames {
 		in := &iam.PutGroupPolicyInput{
-			GroupName:  aws.String(groupName),
+			GroupName:  data.GroupName.ValueStringPointer(),
 			PolicyName: aws.String(name),
 		}
 
@@ -170,9 +111,15 @@ func (r *groupPoliciesExclusiveResource) syncAttachments(ctx context.Context, gr
 		}
 	}
 
-	for _, name := range remove {
+	return nil
+}
+
+func (r *groupPoliciesExclusiveResource) RemoveMembers(ctx context.Context, data *groupPoliciesExclusiveResourceModel, policyNames []string) error {
+	conn := r.Meta().IAMClient(ctx)
+
+	for _, name := range policyNames {
 		in := &iam.DeleteGroupPolicyInput{
-			GroupName:  aws.String(groupName),
+			GroupName:  data.GroupName.ValueStringPointer(),
 			PolicyName: aws.String(name),
 		}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,26 +21,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_iam_group_policy_attachments_exclusive", name="Group Policy Attachments Exclusive")
 func newGroupPolicyAttachmentsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &groupPolicyAttachmentsExclusiveResource{}, nil
+	r := &groupPolicyAttachmentsExclusiveResource{}
+	r.SetExclusiveCollection(r)
+
+	return r, nil
 }
 
 const (
 	ResNameGroupPolicyAttachmentsExclusive = "Group Policy Attachments Exclusive"
 )
 
+// groupPolicyAttachmentsExclusiveResource keeps the configured managed IAM policy
+// attachments in sync with the remote resource.
+//
+// Managed IAM policies defined on this resource but not attached to
+// the group will be added. Policies attached to the group but not configured
+// on this resource will be removed.
 type groupPolicyAttachmentsExclusiveResource struct {
-	framework.ResourceWithModel[groupPolicyAttachmentsExclusiveResourceModel]
-	framework.WithNoOpDelete
+	framework.ResourceWithExclusiveCollection[groupPolicyAttachmentsExclusiveResourceModel, string]
 }
 
 func (r *groupPolicyAttachmentsExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -63,111 +70,49 @@ func (r *groupPolicyAttachmentsExclusiveResource) Schema(ctx context.Context, re
 	}
 }
 
-func (r *groupPolicyAttachmentsExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan groupPolicyAttachmentsExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
+func (r *groupPolicyAttachmentsExclusiveResource) ConfiguredMembers(ctx context.Context, data *groupPolicyAttachmentsExclusiveResourceModel) ([]string, diag.Diagnostics) {
 	var policyARNs []string
-	resp.Diagnostics.Append(plan.PolicyARNs.ElementsAs(ctx, &policyARNs, false)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	err := r.syncAttachments(ctx, plan.GroupName.ValueString(), policyARNs)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameGroupPolicyAttachmentsExclusive, plan.GroupName.String(), err),
-			err.Error(),
-		)
-		return
-	}
+	diags := data.PolicyARNs.ElementsAs(ctx, &policyARNs, false)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	return policyARNs, diags
 }
 
-func (r *groupPolicyAttachmentsExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	conn := r.Meta().IAMClient(ctx)
-
-	var state groupPolicyAttachmentsExclusiveResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	out, err := findGroupPolicyAttachmentsByName(ctx, conn, state.GroupName.ValueString())
-	if tfresource.NotFound(err) {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameGroupPolicyAttachmentsExclusive, state.GroupName.String(), err),
-			err.Error(),
-		)
-		return
-	}
+func (r *groupPolicyAttachmentsExclusiveResource) SetMembers(ctx context.Context, data *groupPolicyAttachmentsExclusiveResourceModel, policyARNs []string) diag.Diagnostics {
+	data.PolicyARNs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, policyARNs)
 
-	state.PolicyARNs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, out)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return nil
 }
 
-func (r *groupPolicyAttachmentsExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state groupPolicyAttachmentsExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func (r *groupPolicyAttachmentsExclusiveResource) ErrorSummary(data *groupPolicyAttachmentsExclusiveResourceModel, action string, err error) string {
+	return create.ProblemStandardMessage(names.IAM, action, ResNameGroupPolicyAttachmentsExclusive, data.GroupName.String(), err)
+}
 
-	if !plan.PolicyARNs.Equal(state.PolicyARNs) {
-		var policyARNs []string
-		resp.Diagnostics.Append(plan.PolicyARNs.ElementsAs(ctx, &policyARNs, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+// OmitNotFoundWarning removes the resource from state without a warning when the group no longer exists.
+func (r *groupPolicyAttachmentsExclusiveResource) OmitNotFoundWarning() {}
 
-		err := r.syncAttachments(ctx, plan.GroupName.ValueString(), policyARNs)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameGroupPolicyAttachmentsExclusive, plan.GroupName.String(), err),
-				err.Error(),
-			)
-			return
-		}
-	}
+func (r *groupPolicyAttachmentsExclusiveResource) ListMembers(ctx context.Context, data *groupPolicyAttachmentsExclusiveResourceModel) ([]string, error) {
+	conn := r.Meta().IAMClient(ctx)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	return findGroupPolicyAttachmentsByName(ctx, conn, data.GroupName.ValueString())
 }
 
-// syncAttachments handles keeping the configured managed IAM policy
-// attachments in sync with the remote resource.
-//
-// Managed IAM policies defined on this resource but not attached to
-// the group will be added. Policies attached to the group but not configured
-// on this resource will be removed.
-func (r *groupPolicyAttachmentsExclusiveResource) syncAttachments(ctx context.Context, groupName string, want []string) error {
+func (r *groupPolicyAttachmentsExclusiveResource) AddMembers(ctx context.Context, data *groupPolicyAttachmentsExclusiveResourceModel, policyARNs []string) error {
 	conn := r.Meta().IAMClient(ctx)
 
-	have, err := findGroupPolicyAttachmentsByName(ctx, conn, groupName)
-	if err != nil {
-		return err
-	}
-
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, arn := range create {
-		err := attachPolicyToGroup(ctx, conn, groupName, arn)
-		if err != nil {
+	for _, arn := range policyARNs {
+		if err := attachPolicyToGroup(ctx, conn, data.GroupName.ValueString(), arn); err != nil {
 			return err
 		}
 	}
 
-	for _, arn := range remove {
-		err := detachPolicyFromGroup(ctx, conn, groupName, arn)
-		if err != nil {
+	return nil
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) RemoveMembers(ctx context.Context, data *groupPolicyAttachmentsExclusiveResourceModel, policyARNs []string) error {
+	conn := r.Meta().IAMClient(ctx)
+
+	for _, arn := range policyARNs {
+		if err := detachPolicyFromGroup(ctx, conn, data.GroupName.ValueString(), arn); err != nil {
 			return err
 		}
 	}
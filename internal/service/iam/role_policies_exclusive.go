@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,26 +21,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_iam_role_policies_exclusive", name="Role Policies Exclusive")
 func newRolePoliciesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &rolePoliciesExclusiveResource{}, nil
+	r := &rolePoliciesExclusiveResource{}
+	r.SetExclusiveCollection(r)
+
+	return r, nil
 }
 
 const (
 	ResNameRolePoliciesExclusive = "Role Policies Exclusive"
 )
 
+// rolePoliciesExclusiveResource keeps the configured inline policy attachments
+// in sync with the remote resource.
+//
+// Inline policies defined on this resource but not attached to the role will
+// be added. Policies attached to the role but not configured on this resource
+// will be removed.
 type rolePoliciesExclusiveResource struct {
-	framework.ResourceWithModel[rolePoliciesExclusiveResourceModel]
-	framework.WithNoOpDelete
+	framework.ResourceWithExclusiveCollection[rolePoliciesExclusiveResourceModel, string]
 }
 
 func (r *rolePoliciesExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -63,104 +70,38 @@ func (r *rolePoliciesExclusiveResource) Schema(ctx context.Context, req resource
 	}
 }
 
-func (r *rolePoliciesExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan rolePoliciesExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
+func (r *rolePoliciesExclusiveResource) ConfiguredMembers(ctx context.Context, data *rolePoliciesExclusiveResourceModel) ([]string, diag.Diagnostics) {
 	var policyNames []string
-	resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	diags := data.PolicyNames.ElementsAs(ctx, &policyNames, false)
 
-	err := r.syncAttachments(ctx, plan.RoleName.ValueString(), policyNames)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameRolePoliciesExclusive, plan.RoleName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	return policyNames, diags
 }
 
-func (r *rolePoliciesExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	conn := r.Meta().IAMClient(ctx)
+func (r *rolePoliciesExclusiveResource) SetMembers(ctx context.Context, data *rolePoliciesExclusiveResourceModel, policyNames []string) diag.Diagnostics {
+	data.PolicyNames = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, policyNames)
 
-	var state rolePoliciesExclusiveResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	out, err := findRolePoliciesByName(ctx, conn, state.RoleName.ValueString())
-	if tfresource.NotFound(err) {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameRolePoliciesExclusive, state.RoleName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	state.PolicyNames = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, out)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return nil
 }
 
-func (r *rolePoliciesExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state rolePoliciesExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func (r *rolePoliciesExclusiveResource) ErrorSummary(data *rolePoliciesExclusiveResourceModel, action string, err error) string {
+	return create.ProblemStandardMessage(names.IAM, action, ResNameRolePoliciesExclusive, data.RoleName.String(), err)
+}
 
-	if !plan.PolicyNames.Equal(state.PolicyNames) {
-		var policyNames []string
-		resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+// OmitNotFoundWarning removes the resource from state without a warning when the role no longer exists.
+func (r *rolePoliciesExclusiveResource) OmitNotFoundWarning() {}
 
-		err := r.syncAttachments(ctx, plan.RoleName.ValueString(), policyNames)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameRolePoliciesExclusive, plan.RoleName.String(), err),
-				err.Error(),
-			)
-			return
-		}
-	}
+func (r *rolePoliciesExclusiveResource) ListMembers(ctx context.Context, data *rolePoliciesExclusiveResourceModel) ([]string, error) {
+	conn := r.Meta().IAMClient(ctx)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	return findRolePoliciesByName(ctx, conn, data.RoleName.ValueString())
 }
 
-// syncAttachments handles keeping the configured inline policy attachments
-// in sync with the remote resource.
-//
-// Inline policies defined on this resource but not attached to the role will
-// be added. Policies attached to the role but not configured on this resource
-// will be removed.
-func (r *rolePoliciesExclusiveResource) syncAttachments(ctx context.Context, roleName string, want []string) error {
+func (r *rolePoliciesExclusiveResource) AddMembers(ctx context.Context, data *rolePoliciesExclusiveResourceModel, policyNames []string) error {
 	conn := r.Meta().IAMClient(ctx)
 
-	have, err := findRolePoliciesByName(ctx, conn, roleName)
-	if err != nil {
-		return err
-	}
-
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, name := range create {
+	for _, name := range policyNames {
 		in := &iam.PutRolePolicyInput{
-			RoleName:   aws.String(roleName),
+			RoleName:   data.RoleName.ValueStringPointer(),
 			PolicyName: aws.String(name),
 		}
 
@@ -170,9 +111,15 @@ func (r *rolePoliciesExclusiveResource) syncAttachments(ctx context.Context, rol
 		}
 	}
 
-	for _, name := range remove {
+	return nil
+}
+
+func (r *rolePoliciesExclusiveResource) RemoveMembers(ctx context.Context, data *rolePoliciesExclusiveResourceModel, policyNames []string) error {
+	conn := r.Meta().IAMClient(ctx)
+
+	for _, name := range policyNames {
 		in := &iam.DeleteRolePolicyInput{
-			RoleName:   aws.String(roleName),
+			RoleName:   data.RoleName.ValueStringPointer(),
 			PolicyName: aws.String(name),
 		}
 
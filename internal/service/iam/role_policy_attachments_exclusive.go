@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,26 +21,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_iam_role_policy_attachments_exclusive", name="Role Policy Attachments Exclusive")
 func newRolePolicyAttachmentsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &rolePolicyAttachmentsExclusiveResource{}, nil
+	r := &rolePolicyAttachmentsExclusiveResource{}
+	r.SetExclusiveCollection(r)
+
+	return r, nil
 }
 
 const (
 	ResNameRolePolicyAttachmentsExclusive = "Role Policy Attachments Exclusive"
 )
 
+// rolePolicyAttachmentsExclusiveResource keeps the configured managed IAM policy
+// attachments in sync with the remote resource.
+//
+// Managed IAM policies defined on this resource but not attached to
+// the role will be added. Policies attached to the role but not configured
+// on this resource will be removed.
 type rolePolicyAttachmentsExclusiveResource struct {
-	framework.ResourceWithModel[rolePolicyAttachmentsExclusiveResourceModel]
-	framework.WithNoOpDelete
+	framework.ResourceWithExclusiveCollection[rolePolicyAttachmentsExclusiveResourceModel, string]
 }
 
 func (r *rolePolicyAttachmentsExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -63,111 +70,49 @@ func (r *rolePolicyAttachmentsExclusiveResource) Schema(ctx context.Context, req
 	}
 }
 
-func (r *rolePolicyAttachmentsExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan rolePolicyAttachmentsExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
+func (r *rolePolicyAttachmentsExclusiveResource) ConfiguredMembers(ctx context.Context, data *rolePolicyAttachmentsExclusiveResourceModel) ([]string, diag.Diagnostics) {
 	var policyARNs []string
-	resp.Diagnostics.Append(plan.PolicyARNs.ElementsAs(ctx, &policyARNs, false)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	err := r.syncAttachments(ctx, plan.RoleName.ValueString(), policyARNs)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameRolePolicyAttachmentsExclusive, plan.RoleName.String(), err),
-			err.Error(),
-		)
-		return
-	}
+	diags := data.PolicyARNs.ElementsAs(ctx, &policyARNs, false)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	return policyARNs, diags
 }
 
-func (r *rolePolicyAttachmentsExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	conn := r.Meta().IAMClient(ctx)
-
-	var state rolePolicyAttachmentsExclusiveResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	out, err := findRolePolicyAttachmentsByName(ctx, conn, state.RoleName.ValueString())
-	if tfresource.NotFound(err) {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameRolePolicyAttachmentsExclusive, state.RoleName.String(), err),
-			err.Error(),
-		)
-		return
-	}
+func (r *rolePolicyAttachmentsExclusiveResource) SetMembers(ctx context.Context, data *rolePolicyAttachmentsExclusiveResourceModel, policyARNs []string) diag.Diagnostics {
+	data.PolicyARNs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, policyARNs)
 
-	state.PolicyARNs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, out)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return nil
 }
 
-func (r *rolePolicyAttachmentsExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state rolePolicyAttachmentsExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func (r *rolePolicyAttachmentsExclusiveResource) ErrorSummary(data *rolePolicyAttachmentsExclusiveResourceModel, action string, err error) string {
+	return create.ProblemStandardMessage(names.IAM, action, ResNameRolePolicyAttachmentsExclusive, data.RoleName.String(), err)
+}
 
-	if !plan.PolicyARNs.Equal(state.PolicyARNs) {
-		var policyARNs []string
-		resp.Diagnostics.Append(plan.PolicyARNs.ElementsAs(ctx, &policyARNs, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+// OmitNotFoundWarning removes the resource from state without a warning when the role no longer exists.
+func (r *rolePolicyAttachmentsExclusiveResource) OmitNotFoundWarning() {}
 
-		err := r.syncAttachments(ctx, plan.RoleName.ValueString(), policyARNs)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameRolePolicyAttachmentsExclusive, plan.RoleName.String(), err),
-				err.Error(),
-			)
-			return
-		}
-	}
+func (r *rolePolicyAttachmentsExclusiveResource) ListMembers(ctx context.Context, data *rolePolicyAttachmentsExclusiveResourceModel) ([]string, error) {
+	conn := r.Meta().IAMClient(ctx)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	return findRolePolicyAttachmentsByName(ctx, conn, data.RoleName.ValueString())
 }
 
-// syncAttachments handles keeping the configured managed IAM policy
-// attachments in sync with the remote resource.
-//
-// Managed IAM policies defined on this resource but not attached to
-// the role will be added. Policies attached to the role but not configured
-// on this resource will be removed.
-func (r *rolePolicyAttachmentsExclusiveResource) syncAttachments(ctx context.Context, roleName string, want []string) error {
+func (r *rolePolicyAttachmentsExclusiveResource) AddMembers(ctx context.Context, data *rolePolicyAttachmentsExclusiveResourceModel, policyARNs []string) error {
 	conn := r.Meta().IAMClient(ctx)
 
-	have, err := findRolePolicyAttachmentsByName(ctx, conn, roleName)
-	if err != nil {
-		return err
-	}
-
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, arn := range create {
-		err := attachPolicyToRole(ctx, conn, roleName, arn)
-		if err != nil {
+	for _, arn := range policyARNs {
+		if err := attachPolicyToRole(ctx, conn, data.RoleName.ValueString(), arn); err != nil {
 			return err
 		}
 	}
 
-	for _, arn := range remove {
-		err := detachPolicyFromRole(ctx, conn, roleName, arn)
-		if err != nil {
+	return nil
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) RemoveMembers(ctx context.Context, data *rolePolicyAttachmentsExclusiveResourceModel, policyARNs []string) error {
+	conn := r.Meta().IAMClient(ctx)
+
+	for _, arn := range policyARNs {
+		if err := detachPolicyFromRole(ctx, conn, data.RoleName.ValueString(), arn); err != nil {
 			return err
 		}
 	}
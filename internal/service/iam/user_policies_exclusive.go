@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,26 +21,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_iam_user_policies_exclusive", name="User Policies Exclusive")
 func newUserPoliciesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &userPoliciesExclusiveResource{}, nil
+	r := &userPoliciesExclusiveResource{}
+	r.SetExclusiveCollection(r)
+
+	return r, nil
 }
 
 const (
 	ResNameUserPoliciesExclusive = "User Policies Exclusive"
 )
 
+// userPoliciesExclusiveResource keeps the configured inline policy attachments
+// in sync with the remote resource.
+//
+// Inline policies defined on this resource but not attached to the user will
+// be added. Policies attached to the user but not configured on this resource
+// will be removed.
 type userPoliciesExclusiveResource struct {
-	framework.ResourceWithModel[userPoliciesExclusiveResourceModel]
-	framework.WithNoOpDelete
+	framework.ResourceWithExclusiveCollection[userPoliciesExclusiveResourceModel, string]
 }
 
 func (r *userPoliciesExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -63,104 +70,38 @@ func (r *userPoliciesExclusiveResource) Schema(ctx context.Context, req resource
 	}
 }
 
-func (r *userPoliciesExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan userPoliciesExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
+func (r *userPoliciesExclusiveResource) ConfiguredMembers(ctx context.Context, data *userPoliciesExclusiveResourceModel) ([]string, diag.Diagnostics) {
 	var policyNames []string
-	resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	diags := data.PolicyNames.ElementsAs(ctx, &policyNames, false)
 
-	err := r.syncAttachments(ctx, plan.UserName.ValueString(), policyNames)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameUserPoliciesExclusive, plan.UserName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	return policyNames, diags
 }
 
-func (r *userPoliciesExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	conn := r.Meta().IAMClient(ctx)
+func (r *userPoliciesExclusiveResource) SetMembers(ctx context.Context, data *userPoliciesExclusiveResourceModel, policyNames []string) diag.Diagnostics {
+	data.PolicyNames = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, policyNames)
 
-	var state userPoliciesExclusiveResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	out, err := findUserPoliciesByName(ctx, conn, state.UserName.ValueString())
-	if tfresource.NotFound(err) {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameUserPoliciesExclusive, state.UserName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	state.PolicyNames = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, out)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return nil
 }
 
-func (r *userPoliciesExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state userPoliciesExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func (r *userPoliciesExclusiveResource) ErrorSummary(data *userPoliciesExclusiveResourceModel, action string, err error) string {
+	return create.ProblemStandardMessage(names.IAM, action, ResNameUserPoliciesExclusive, data.UserName.String(), err)
+}
 
-	if !plan.PolicyNames.Equal(state.PolicyNames) {
-		var policyNames []string
-		resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+// OmitNotFoundWarning removes the resource from state without a warning when the user no longer exists.
+func (r *userPoliciesExclusiveResource) OmitNotFoundWarning() {}
 
-		err := r.syncAttachments(ctx, plan.UserName.ValueString(), policyNames)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameUserPoliciesExclusive, plan.UserName.String(), err),
-				err.Error(),
-			)
-			return
-		}
-	}
+func (r *userPoliciesExclusiveResource) ListMembers(ctx context.Context, data *userPoliciesExclusiveResourceModel) ([]string, error) {
+	conn := r.Meta().IAMClient(ctx)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	return findUserPoliciesByName(ctx, conn, data.UserName.ValueString())
 }
 
-// syncAttachments handles keeping the configured inline policy attachments
-// in sync with the remote resource.
-//
-// Inline policies defined on this resource but not attached to the user will
-// be added. Policies attached to the user but not configured on this resource
-// will be removed.
-func (r *userPoliciesExclusiveResource) syncAttachments(ctx context.Context, userName string, want []string) error {
+func (r *userPoliciesExclusiveResource) AddMembers(ctx context.Context, data *userPoliciesExclusiveResourceModel, policyNames []string) error {
 	conn := r.Meta().IAMClient(ctx)
 
-	have, err := findUserPoliciesByName(ctx, conn, userName)
-	if err != nil {
-		return err
-	}
-
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, name := range create {
+	for _, name := range policyNames {
 		in := &iam.PutUserPolicyInput{
-			UserName:   aws.String(userName),
+			UserName:   data.UserName.ValueStringPointer(),
 			PolicyName: aws.String(name),
 		}
 
@@ -170,9 +111,15 @@ func (r *userPoliciesExclusiveResource) syncAttachments(ctx context.Context, use
 		}
 	}
 
-	for _, name := range remove {
+	return nil
+}
+
+func (r *userPoliciesExclusiveResource) RemoveMembers(ctx context.Context, data *userPoliciesExclusiveResourceModel, policyNames []string) error {
+	conn := r.Meta().IAMClient(ctx)
+
+	for _, name := range policyNames {
 		in := &iam.DeleteUserPolicyInput{
-			UserName:   aws.String(userName),
+			UserName:   data.UserName.ValueStringPointer(),
 			PolicyName: aws.String(name),
 		}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,26 +21,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_iam_user_policy_attachments_exclusive", name="User Policy Attachments Exclusive")
 func newUserPolicyAttachmentsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &userPolicyAttachmentsExclusiveResource{}, nil
+	r := &userPolicyAttachmentsExclusiveResource{}
+	r.SetExclusiveCollection(r)
+
+	return r, nil
 }
 
 const (
 	ResNameUserPolicyAttachmentsExclusive = "User Policy Attachments Exclusive"
 )
 
+// userPolicyAttachmentsExclusiveResource keeps the configured managed IAM policy
+// attachments in sync with the remote resource.
+//
+// Managed IAM policies defined on this resource but not attached to
+// the user will be added. Policies attached to the user but not configured
+// on this resource will be removed.
 type userPolicyAttachmentsExclusiveResource struct {
-	framework.ResourceWithModel[userPolicyAttachmentsExclusiveResourceModel]
-	framework.WithNoOpDelete
+	framework.ResourceWithExclusiveCollection[userPolicyAttachmentsExclusiveResourceModel, string]
 }
 
 func (r *userPolicyAttachmentsExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -63,111 +70,49 @@ func (r *userPolicyAttachmentsExclusiveResource) Schema(ctx context.Context, req
 	}
 }
 
-func (r *userPolicyAttachmentsExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan userPolicyAttachmentsExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
+func (r *userPolicyAttachmentsExclusiveResource) ConfiguredMembers(ctx context.Context, data *userPolicyAttachmentsExclusiveResourceModel) ([]string, diag.Diagnostics) {
 	var policyARNs []string
-	resp.Diagnostics.Append(plan.PolicyARNs.ElementsAs(ctx, &policyARNs, false)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	err := r.syncAttachments(ctx, plan.UserName.ValueString(), policyARNs)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameUserPolicyAttachmentsExclusive, plan.UserName.String(), err),
-			err.Error(),
-		)
-		return
-	}
+	diags := data.PolicyARNs.ElementsAs(ctx, &policyARNs, false)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	return policyARNs, diags
 }
 
-func (r *userPolicyAttachmentsExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	conn := r.Meta().IAMClient(ctx)
-
-	var state userPolicyAttachmentsExclusiveResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	out, err := findUserPolicyAttachmentsByName(ctx, conn, state.UserName.ValueString())
-	if tfresource.NotFound(err) {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameUserPolicyAttachmentsExclusive, state.UserName.String(), err),
-			err.Error(),
-		)
-		return
-	}
+func (r *userPolicyAttachmentsExclusiveResource) SetMembers(ctx context.Context, data *userPolicyAttachmentsExclusiveResourceModel, policyARNs []string) diag.Diagnostics {
+	data.PolicyARNs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, policyARNs)
 
-	state.PolicyARNs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, out)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return nil
 }
 
-func (r *userPolicyAttachmentsExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state userPolicyAttachmentsExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func (r *userPolicyAttachmentsExclusiveResource) ErrorSummary(data *userPolicyAttachmentsExclusiveResourceModel, action string, err error) string {
+	return create.ProblemStandardMessage(names.IAM, action, ResNameUserPolicyAttachmentsExclusive, data.UserName.String(), err)
+}
 
-	if !plan.PolicyARNs.Equal(state.PolicyARNs) {
-		var policyARNs []string
-		resp.Diagnostics.Append(plan.PolicyARNs.ElementsAs(ctx, &policyARNs, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+// OmitNotFoundWarning removes the resource from state without a warning when the user no longer exists.
+func (r *userPolicyAttachmentsExclusiveResource) OmitNotFoundWarning() {}
 
-		err := r.syncAttachments(ctx, plan.UserName.ValueString(), policyARNs)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameUserPolicyAttachmentsExclusive, plan.UserName.String(), err),
-				err.Error(),
-			)
-			return
-		}
-	}
+func (r *userPolicyAttachmentsExclusiveResource) ListMembers(ctx context.Context, data *userPolicyAttachmentsExclusiveResourceModel) ([]string, error) {
+	conn := r.Meta().IAMClient(ctx)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	return findUserPolicyAttachmentsByName(ctx, conn, data.UserName.ValueString())
 }
 
-// syncAttachments handles keeping the configured managed IAM policy
-// attachments in sync with the remote resource.
-//
-// Managed IAM policies defined on this resource but not attached to
-// the user will be added. Policies attached to the user but not configured
-// on this resource will be removed.
-func (r *userPolicyAttachmentsExclusiveResource) syncAttachments(ctx context.Context, userName string, want []string) error {
+func (r *userPolicyAttachmentsExclusiveResource) AddMembers(ctx context.Context, data *userPolicyAttachmentsExclusiveResourceModel, policyARNs []string) error {
 	conn := r.Meta().IAMClient(ctx)
 
-	have, err := findUserPolicyAttachmentsByName(ctx, conn, userName)
-	if err != nil {
-		return err
-	}
-
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, arn := range create {
-		err := attachPolicyToUser(ctx, conn, userName, arn)
-		if err != nil {
+	for _, arn := range policyARNs {
+		if err := attachPolicyToUser(ctx, conn, data.UserName.ValueString(), arn); err != nil {
 			return err
 		}
 	}
 
-	for _, arn := range remove {
-		err := detachPolicyFromUser(ctx, conn, userName, arn)
-		if err != nil {
+	return nil
+}
+
+func (r *userPolicyAttachmentsExclusiveResource) RemoveMembers(ctx context.Context, data *userPolicyAttachmentsExclusiveResourceModel, policyARNs []string) error {
+	conn := r.Meta().IAMClient(ctx)
+
+	for _, arn := range policyARNs {
+		if err := detachPolicyFromUser(ctx, conn, data.UserName.ValueString(), arn); err != nil {
 			return err
 		}
 	}
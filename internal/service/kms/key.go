@@ -34,6 +34,7 @@ import (
 // @SDKResource("aws_kms_key", name="Key")
 // @Tags(identifierAttribute="id")
 // @IdentityAttribute("id")
+// @ArnFormat("key/{id}", attribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kms/types;awstypes;awstypes.KeyMetadata")
 // @Testing(importIgnore="deletion_window_in_days;bypass_policy_lockout_safety_check")
 // @Testing(preIdentityVersion="v6.10.0")
@@ -46,6 +46,7 @@ const (
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetFunctionOutput")
 // @Testing(importIgnore="filename;last_modified;publish")
 // @IdentityAttribute("function_name")
+// @ArnFormat("function:{function_name}", attribute="arn")
 // @Testing(idAttrDuplicates="function_name")
 // @Testing(preIdentityVersion="v6.7.0")
 // @CustomImport
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_lambda_permissions_exclusive", name="Permissions Exclusive")
+func newPermissionsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &permissionsExclusiveResource{}
+	r.SetExclusiveCollection(r)
+
+	return r, nil
+}
+
+const (
+	ResNamePermissionsExclusive = "Permissions Exclusive"
+)
+
+// permissionsExclusiveResource keeps the statements in a Lambda function's
+// resource-based policy in sync with the configured statement IDs.
+//
+// Statements are created by other resources, such as aws_lambda_permission.
+// Statements in the policy but not configured on this resource are removed.
+type permissionsExclusiveResource struct {
+	framework.ResourceWithExclusiveCollection[permissionsExclusiveResourceModel, string]
+}
+
+func (r *permissionsExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"qualifier": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"statement_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *permissionsExclusiveResource) ConfiguredMembers(ctx context.Context, data *permissionsExclusiveResourceModel) ([]string, diag.Diagnostics) {
+	var statementIDs []string
+	diags := data.StatementIDs.ElementsAs(ctx, &statementIDs, false)
+
+	return statementIDs, diags
+}
+
+func (r *permissionsExclusiveResource) SetMembers(ctx context.Context, data *permissionsExclusiveResourceModel, statementIDs []string) diag.Diagnostics {
+	data.StatementIDs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, statementIDs)
+
+	return nil
+}
+
+func (r *permissionsExclusiveResource) ListMembers(ctx context.Context, data *permissionsExclusiveResourceModel) ([]string, error) {
+	conn := r.Meta().LambdaClient(ctx)
+
+	return findPolicyStatementIDsByTwoPartKey(ctx, conn, data.FunctionName.ValueString(), data.Qualifier.ValueString())
+}
+
+func (r *permissionsExclusiveResource) AddMembers(ctx context.Context, data *permissionsExclusiveResourceModel, statementIDs []string) error {
+	// Statements can't be created from their IDs alone.
+	return fmt.Errorf("Lambda Function (%s) permissions (%s) not found", data.FunctionName.ValueString(), strings.Join(statementIDs, ", "))
+}
+
+func (r *permissionsExclusiveResource) RemoveMembers(ctx context.Context, data *permissionsExclusiveResourceModel, statementIDs []string) error {
+	conn := r.Meta().LambdaClient(ctx)
+
+	functionName := data.FunctionName.ValueString()
+
+	// See resourcePermissionDelete.
+	conns.GlobalMutexKV.Lock(functionName)
+	defer conns.GlobalMutexKV.Unlock(functionName)
+
+	for _, statementID := range statementIDs {
+		input := lambda.RemovePermissionInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    data.Qualifier.ValueStringPointer(),
+			StatementId:  aws.String(statementID),
+		}
+
+		_, err := conn.RemovePermission(ctx, &input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("removing Lambda Permission (%s/%s): %w", functionName, statementID, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *permissionsExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is the function name, optionally followed by the qualifier.
+	parts := strings.Split(req.ID, intflex.ResourceIdSeparator)
+	if len(parts) > 2 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: FUNCTION_NAME or FUNCTION_NAME%[2]sQUALIFIER. Got: %[1]q", req.ID, intflex.ResourceIdSeparator),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("function_name"), parts[0])...)
+	if len(parts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("qualifier"), parts[1])...)
+	}
+}
+
+// findPolicyStatementIDsByTwoPartKey returns the IDs of the statements in a function's resource-based policy.
+// A function without a policy has no statements.
+func findPolicyStatementIDsByTwoPartKey(ctx context.Context, conn *lambda.Client, functionName, qualifier string) ([]string, error) {
+	if _, err := findFunctionConfigurationByTwoPartKey(ctx, conn, functionName, qualifier); err != nil {
+		return nil, err
+	}
+
+	input := lambda.GetPolicyInput{
+		FunctionName: aws.String(functionName),
+	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := findPolicy(ctx, conn, &input)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &policy{}
+	if err := json.Unmarshal([]byte(aws.ToString(output.Policy)), policy); err != nil {
+		return nil, err
+	}
+
+	var statementIDs []string
+	for _, v := range policy.Statement {
+		statementIDs = append(statementIDs, v.Sid)
+	}
+
+	return statementIDs, nil
+}
+
+type permissionsExclusiveResourceModel struct {
+	framework.WithRegionModel
+	FunctionName types.String        `tfsdk:"function_name"`
+	Qualifier    types.String        `tfsdk:"qualifier"`
+	StatementIDs fwtypes.SetOfString `tfsdk:"statement_ids"`
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaPermissionsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var statement tflambda.PolicyStatement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+	functionResourceName := "aws_lambda_function.test"
+	permissionResourceName := "aws_lambda_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionExists(ctx, permissionResourceName, &statement),
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "statement_ids.*", permissionResourceName, "statement_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "function_name"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "function_name",
+			},
+		},
+	})
+}
+
+func TestAccLambdaPermissionsExclusive_qualifier(t *testing.T) {
+	ctx := acctest.Context(t)
+	var statement tflambda.PolicyStatement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+	aliasResourceName := "aws_lambda_alias.test"
+	permissionResourceName := "aws_lambda_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_qualifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionExists(ctx, permissionResourceName, &statement),
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", aliasResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+
+					return rs.Primary.Attributes["function_name"] + "," + rs.Primary.Attributes["qualifier"], nil
+				},
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "function_name",
+			},
+		},
+	})
+}
+
+// A permission added out of band should be removed
+func TestAccLambdaPermissionsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					testAccCheckPermissionsExclusiveAddPermission(ctx, functionResourceName, rName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaPermissionsExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "0"),
+				),
+				// The empty `statement_ids` argument in the exclusive lock will remove the
+				// permission defined in this configuration, so a diff is expected
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		functionName := rs.Primary.Attributes["function_name"]
+		if functionName == "" {
+			return fmt.Errorf("No Lambda Function name is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		output, err := tflambda.FindPolicyStatementIDsByTwoPartKey(ctx, conn, functionName, rs.Primary.Attributes["qualifier"])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := rs.Primary.Attributes["statement_ids.#"], strconv.Itoa(len(output)); got != want {
+			return fmt.Errorf("Lambda Function (%s) statement_ids count = %s, want %s", functionName, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPermissionsExclusiveAddPermission(ctx context.Context, n, statementID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		input := lambda.AddPermissionInput{
+			Action:       aws.String("lambda:InvokeFunction"),
+			FunctionName: aws.String(rs.Primary.Attributes["function_name"]),
+			Principal:    aws.String("events.amazonaws.com"),
+			StatementId:  aws.String(statementID),
+		}
+
+		_, err := conn.AddPermission(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccPermissionsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_basic(rName), `
+resource "aws_lambda_permissions_exclusive" "test" {
+  function_name = aws_lambda_function.test.function_name
+  statement_ids = [aws_lambda_permission.test.statement_id]
+}
+`)
+}
+
+func testAccPermissionsExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_basic(rName), `
+resource "aws_lambda_permissions_exclusive" "test" {
+  function_name = aws_lambda_function.test.function_name
+  statement_ids = []
+
+  depends_on = [aws_lambda_permission.test]
+}
+`)
+}
+
+func testAccPermissionsExclusiveConfig_qualifier(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_qualifier(rName), `
+resource "aws_lambda_permissions_exclusive" "test" {
+  function_name = aws_lambda_function.test.function_name
+  qualifier     = aws_lambda_alias.test.name
+  statement_ids = [aws_lambda_permission.test.statement_id]
+}
+`)
+}
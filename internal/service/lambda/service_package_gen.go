@@ -40,6 +40,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Function Recursion Config",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newPermissionsExclusiveResource,
+			TypeName: "aws_lambda_permissions_exclusive",
+			Name:     "Permissions Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newRuntimeManagementConfigResource,
 			TypeName: "aws_lambda_runtime_management_config",
@@ -130,8 +136,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			}),
-			Region:   unique.Make(inttypes.ResourceRegionDefault()),
-			Identity: inttypes.RegionalSingleParameterIdentity("function_name"),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity("function_name",
+				inttypes.WithARNFormat("function:{function_name}"),
+			),
 			Import: inttypes.SDKv2Import{
 				CustomImport: true,
 			},
@@ -28,10 +28,12 @@ import (
 
 // @SDKResource("aws_cloudwatch_log_group", name="Log Group")
 // @Tags(identifierAttribute="arn")
+// @IAMActions(create="logs:CreateLogGroup;logs:PutRetentionPolicy;logs:TagResource;logs:DescribeLogGroups;logs:ListTagsForResource", update="logs:AssociateKmsKey;logs:DeleteRetentionPolicy;logs:DisassociateKmsKey;logs:PutRetentionPolicy;logs:TagResource;logs:UntagResource;logs:DescribeLogGroups;logs:ListTagsForResource", delete="logs:DeleteLogGroup")
 // @Testing(destroyTakesT=true)
 // @Testing(existsTakesT=true)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types;awstypes;awstypes.LogGroup")
 // @IdentityAttribute("name")
+// @ArnFormat("log-group:{name}", attribute="arn")
 // @Testing(idAttrDuplicates="name")
 // @Testing(preIdentityVersion="v6.7.0")
 func resourceGroup() *schema.Resource {
@@ -18,7 +18,14 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
-	return []*inttypes.ServicePackageFrameworkDataSource{}
+	return []*inttypes.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newTransformedEventsDataSource,
+			TypeName: "aws_cloudwatch_log_transformed_events",
+			Name:     "Transformed Events",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
@@ -71,6 +78,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Index Policy",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newTransformerResource,
+			TypeName: "aws_cloudwatch_log_transformer",
+			Name:     "Transformer",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -136,11 +149,18 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			}),
-			Region:   unique.Make(inttypes.ResourceRegionDefault()),
-			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrName),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrName,
+				inttypes.WithARNFormat("log-group:{name}"),
+			),
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
 			},
+			IAMActions: inttypes.ServicePackageResourceIAMActions{
+				Create: []string{"logs:CreateLogGroup", "logs:PutRetentionPolicy", "logs:TagResource", "logs:DescribeLogGroups", "logs:ListTagsForResource"},
+				Update: []string{"logs:AssociateKmsKey", "logs:DeleteRetentionPolicy", "logs:DisassociateKmsKey", "logs:PutRetentionPolicy", "logs:TagResource", "logs:UntagResource", "logs:DescribeLogGroups", "logs:ListTagsForResource"},
+				Delete: []string{"logs:DeleteLogGroup"},
+			},
 		},
 		{
 			Factory:  resourceMetricFilter,
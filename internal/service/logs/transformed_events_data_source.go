@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudwatch_log_transformed_events", name="Transformed Events")
+func newTransformedEventsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &transformedEventsDataSource{}, nil
+}
+
+type transformedEventsDataSource struct {
+	framework.DataSourceWithModel[transformedEventsDataSourceModel]
+}
+
+func (d *transformedEventsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	optionalStringAttribute := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+		}
+	}
+	overwriteIfExistsAttribute := func() schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional: true,
+		}
+	}
+	withKeysAttribute := func() schema.ListAttribute {
+		return schema.ListAttribute{
+			CustomType:  fwtypes.ListOfStringType,
+			ElementType: types.StringType,
+			Required:    true,
+			Validators: []validator.List{
+				listvalidator.SizeBetween(1, 10),
+			},
+		}
+	}
+	sourceOnlyProcessorBlock := func(processor string) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[transformerSourceProcessorModel](ctx),
+			Validators: transformerProcessorValidators(processor),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrSource: optionalStringAttribute(),
+				},
+			},
+		}
+	}
+	withKeysProcessorBlock := func(processor string) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[transformerWithKeysProcessorModel](ctx),
+			Validators: transformerProcessorValidators(processor),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"with_keys": withKeysAttribute(),
+				},
+			},
+		}
+	}
+	entryBlockValidators := []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeBetween(1, 10),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"log_event_messages": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+				},
+			},
+			"transformed_logs": framework.DataSourceComputedListOfObjectAttribute[transformedLogRecordModel](ctx),
+		},
+		Blocks: map[string]schema.Block{
+			"transformer_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[transformerProcessorModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"add_keys": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerAddKeysModel](ctx),
+							Validators: transformerProcessorValidators("add_keys"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerAddKeyEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+												"overwrite_if_exists": overwriteIfExistsAttribute(),
+												names.AttrValue: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"copy_value": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerCopyValueModel](ctx),
+							Validators: transformerProcessorValidators("copy_value"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerCopyValueEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"overwrite_if_exists": overwriteIfExistsAttribute(),
+												names.AttrSource: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrTarget: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"csv": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerCSVModel](ctx),
+							Validators: transformerProcessorValidators("csv"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"columns": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"delimiter":       optionalStringAttribute(),
+									"quote_character": optionalStringAttribute(),
+									names.AttrSource:  optionalStringAttribute(),
+								},
+							},
+						},
+						"date_time_converter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerDateTimeConverterModel](ctx),
+							Validators: transformerProcessorValidators("date_time_converter"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"locale": optionalStringAttribute(),
+									"match_patterns": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeBetween(1, 5),
+										},
+									},
+									names.AttrSource: schema.StringAttribute{
+										Required: true,
+									},
+									"source_timezone": optionalStringAttribute(),
+									names.AttrTarget: schema.StringAttribute{
+										Required: true,
+									},
+									"target_format":   optionalStringAttribute(),
+									"target_timezone": optionalStringAttribute(),
+								},
+							},
+						},
+						"delete_keys": withKeysProcessorBlock("delete_keys"),
+						"grok": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerGrokModel](ctx),
+							Validators: transformerProcessorValidators("grok"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"match": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrSource: optionalStringAttribute(),
+								},
+							},
+						},
+						"list_to_map": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerListToMapModel](ctx),
+							Validators: transformerProcessorValidators("list_to_map"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flatten": schema.BoolAttribute{
+										Optional: true,
+									},
+									"flattened_element": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlattenedElement](),
+										Optional:   true,
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrSource: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrTarget: schema.StringAttribute{
+										Optional: true,
+									},
+									"value_key": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"lower_case_string": withKeysProcessorBlock("lower_case_string"),
+						"move_keys": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerMoveKeysModel](ctx),
+							Validators: transformerProcessorValidators("move_keys"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerMoveKeyEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"overwrite_if_exists": overwriteIfExistsAttribute(),
+												names.AttrSource: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrTarget: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"parse_cloudfront": sourceOnlyProcessorBlock("parse_cloudfront"),
+						"parse_json": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerParseJSONModel](ctx),
+							Validators: transformerProcessorValidators("parse_json"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDestination: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrSource: optionalStringAttribute(),
+								},
+							},
+						},
+						"parse_key_value": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerParseKeyValueModel](ctx),
+							Validators: transformerProcessorValidators("parse_key_value"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDestination: schema.StringAttribute{
+										Optional: true,
+									},
+									"field_delimiter": optionalStringAttribute(),
+									"key_prefix": schema.StringAttribute{
+										Optional: true,
+									},
+									"key_value_delimiter": optionalStringAttribute(),
+									"non_match_value": schema.StringAttribute{
+										Optional: true,
+									},
+									"overwrite_if_exists": overwriteIfExistsAttribute(),
+									names.AttrSource:      optionalStringAttribute(),
+								},
+							},
+						},
+						"parse_postgres": sourceOnlyProcessorBlock("parse_postgres"),
+						"parse_route53":  sourceOnlyProcessorBlock("parse_route53"),
+						"parse_to_ocsf": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerParseToOCSFModel](ctx),
+							Validators: transformerProcessorValidators("parse_to_ocsf"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"event_source": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.EventSource](),
+										Required:   true,
+									},
+									"ocsf_version": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.OCSFVersion](),
+										Required:   true,
+									},
+									names.AttrSource: optionalStringAttribute(),
+								},
+							},
+						},
+						"parse_vpc": sourceOnlyProcessorBlock("parse_vpc"),
+						"parse_waf": sourceOnlyProcessorBlock("parse_waf"),
+						"rename_keys": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerRenameKeysModel](ctx),
+							Validators: transformerProcessorValidators("rename_keys"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerRenameKeyEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+												"overwrite_if_exists": overwriteIfExistsAttribute(),
+												"rename_to": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"split_string": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerSplitStringModel](ctx),
+							Validators: transformerProcessorValidators("split_string"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerSplitStringEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"delimiter": schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrSource: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"substitute_string": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerSubstituteStringModel](ctx),
+							Validators: transformerProcessorValidators("substitute_string"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerSubstituteStringEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"from": schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrSource: schema.StringAttribute{
+													Required: true,
+												},
+												"to": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"trim_string": withKeysProcessorBlock("trim_string"),
+						"type_converter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerTypeConverterModel](ctx),
+							Validators: transformerProcessorValidators("type_converter"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerTypeConverterEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Type](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"upper_case_string": withKeysProcessorBlock("upper_case_string"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *transformedEventsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data transformedEventsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LogsClient(ctx)
+
+	var input cloudwatchlogs.TestTransformerInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.TestTransformer(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("testing CloudWatch Logs Transformer", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.TransformedLogs, &data.TransformedLogs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type transformedEventsDataSourceModel struct {
+	framework.WithRegionModel
+	LogEventMessages  fwtypes.ListOfString                                       `tfsdk:"log_event_messages"`
+	TransformedLogs   fwtypes.ListNestedObjectValueOf[transformedLogRecordModel] `tfsdk:"transformed_logs"`
+	TransformerConfig fwtypes.ListNestedObjectValueOf[transformerProcessorModel] `tfsdk:"transformer_config"`
+}
+
+type transformedLogRecordModel struct {
+	EventMessage            types.String `tfsdk:"event_message"`
+	EventNumber             types.Int64  `tfsdk:"event_number"`
+	TransformedEventMessage types.String `tfsdk:"transformed_event_message"`
+}
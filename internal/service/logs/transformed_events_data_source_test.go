@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsTransformedEventsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_log_transformed_events.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformedEventsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "transformed_logs.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "transformed_logs.0.event_number", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "transformed_logs.0.event_message", `{"level":"ERROR","status":"500"}`),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "transformed_logs.0.transformed_event_message", `{"level":"error","status":500,"environment":"test"}`),
+					resource.TestCheckResourceAttr(dataSourceName, "transformed_logs.1.event_number", "2"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "transformed_logs.1.transformed_event_message", `{"level":"info","status":200,"environment":"test"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsTransformedEventsDataSource_multipleProcessors(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTransformedEventsDataSourceConfig_multipleProcessors,
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+const testAccTransformedEventsDataSourceConfig_basic = `
+data "aws_cloudwatch_log_transformed_events" "test" {
+  log_event_messages = [
+    jsonencode({ level = "ERROR", status = "500" }),
+    jsonencode({ level = "INFO", status = "200" }),
+  ]
+
+  transformer_config {
+    parse_json {}
+  }
+
+  transformer_config {
+    lower_case_string {
+      with_keys = ["level"]
+    }
+  }
+
+  transformer_config {
+    type_converter {
+      entry {
+        key  = "status"
+        type = "integer"
+      }
+    }
+  }
+
+  transformer_config {
+    add_keys {
+      entry {
+        key   = "environment"
+        value = "test"
+      }
+    }
+  }
+}
+`
+
+const testAccTransformedEventsDataSourceConfig_multipleProcessors = `
+data "aws_cloudwatch_log_transformed_events" "test" {
+  log_event_messages = ["{}"]
+
+  transformer_config {
+    parse_json {}
+
+    parse_vpc {}
+  }
+}
+`
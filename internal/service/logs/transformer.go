@@ -0,0 +1,792 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Transformer processor names, in the order in which they are declared in the API.
+var transformerProcessors = []string{
+	"add_keys",
+	"copy_value",
+	"csv",
+	"date_time_converter",
+	"delete_keys",
+	"grok",
+	"list_to_map",
+	"lower_case_string",
+	"move_keys",
+	"parse_cloudfront",
+	"parse_json",
+	"parse_key_value",
+	"parse_postgres",
+	"parse_route53",
+	"parse_to_ocsf",
+	"parse_vpc",
+	"parse_waf",
+	"rename_keys",
+	"split_string",
+	"substitute_string",
+	"trim_string",
+	"type_converter",
+	"upper_case_string",
+}
+
+// transformerProcessorValidators returns the validators for a processor block.
+// Each element of a transformer configuration contains exactly one processor.
+func transformerProcessorValidators(processor string) []validator.List {
+	var others []path.Expression
+	for _, v := range transformerProcessors {
+		if v != processor {
+			others = append(others, path.MatchRelative().AtParent().AtName(v))
+		}
+	}
+
+	return []validator.List{
+		listvalidator.SizeAtMost(1),
+		listvalidator.ExactlyOneOf(others...),
+	}
+}
+
+// @FrameworkResource("aws_cloudwatch_log_transformer", name="Transformer")
+func newTransformerResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &transformerResource{}
+
+	return r, nil
+}
+
+type transformerResource struct {
+	framework.ResourceWithModel[transformerResourceModel]
+}
+
+func (r *transformerResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	optionalComputedStringAttribute := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		}
+	}
+	overwriteIfExistsAttribute := func() schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		}
+	}
+	withKeysAttribute := func() schema.ListAttribute {
+		return schema.ListAttribute{
+			CustomType:  fwtypes.ListOfStringType,
+			ElementType: types.StringType,
+			Required:    true,
+			Validators: []validator.List{
+				listvalidator.SizeBetween(1, 10),
+			},
+		}
+	}
+	sourceOnlyProcessorBlock := func(processor string) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[transformerSourceProcessorModel](ctx),
+			Validators: transformerProcessorValidators(processor),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrSource: optionalComputedStringAttribute(),
+				},
+			},
+		}
+	}
+	withKeysProcessorBlock := func(processor string) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[transformerWithKeysProcessorModel](ctx),
+			Validators: transformerProcessorValidators(processor),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"with_keys": withKeysAttribute(),
+				},
+			},
+		}
+	}
+	entryBlockValidators := []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeBetween(1, 10),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"log_group_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"transformer_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[transformerProcessorModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"add_keys": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerAddKeysModel](ctx),
+							Validators: transformerProcessorValidators("add_keys"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerAddKeyEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+												"overwrite_if_exists": overwriteIfExistsAttribute(),
+												names.AttrValue: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"copy_value": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerCopyValueModel](ctx),
+							Validators: transformerProcessorValidators("copy_value"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerCopyValueEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"overwrite_if_exists": overwriteIfExistsAttribute(),
+												names.AttrSource: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrTarget: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"csv": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerCSVModel](ctx),
+							Validators: transformerProcessorValidators("csv"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"columns": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"delimiter":       optionalComputedStringAttribute(),
+									"quote_character": optionalComputedStringAttribute(),
+									names.AttrSource:  optionalComputedStringAttribute(),
+								},
+							},
+						},
+						"date_time_converter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerDateTimeConverterModel](ctx),
+							Validators: transformerProcessorValidators("date_time_converter"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"locale": optionalComputedStringAttribute(),
+									"match_patterns": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeBetween(1, 5),
+										},
+									},
+									names.AttrSource: schema.StringAttribute{
+										Required: true,
+									},
+									"source_timezone": optionalComputedStringAttribute(),
+									names.AttrTarget: schema.StringAttribute{
+										Required: true,
+									},
+									"target_format":   optionalComputedStringAttribute(),
+									"target_timezone": optionalComputedStringAttribute(),
+								},
+							},
+						},
+						"delete_keys": withKeysProcessorBlock("delete_keys"),
+						"grok": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerGrokModel](ctx),
+							Validators: transformerProcessorValidators("grok"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"match": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrSource: optionalComputedStringAttribute(),
+								},
+							},
+						},
+						"list_to_map": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerListToMapModel](ctx),
+							Validators: transformerProcessorValidators("list_to_map"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flatten": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										Default:  booldefault.StaticBool(false),
+									},
+									"flattened_element": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlattenedElement](),
+										Optional:   true,
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrSource: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrTarget: schema.StringAttribute{
+										Optional: true,
+									},
+									"value_key": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"lower_case_string": withKeysProcessorBlock("lower_case_string"),
+						"move_keys": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerMoveKeysModel](ctx),
+							Validators: transformerProcessorValidators("move_keys"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerMoveKeyEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"overwrite_if_exists": overwriteIfExistsAttribute(),
+												names.AttrSource: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrTarget: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"parse_cloudfront": sourceOnlyProcessorBlock("parse_cloudfront"),
+						"parse_json": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerParseJSONModel](ctx),
+							Validators: transformerProcessorValidators("parse_json"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDestination: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrSource: optionalComputedStringAttribute(),
+								},
+							},
+						},
+						"parse_key_value": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerParseKeyValueModel](ctx),
+							Validators: transformerProcessorValidators("parse_key_value"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDestination: schema.StringAttribute{
+										Optional: true,
+									},
+									"field_delimiter": optionalComputedStringAttribute(),
+									"key_prefix": schema.StringAttribute{
+										Optional: true,
+									},
+									"key_value_delimiter": optionalComputedStringAttribute(),
+									"non_match_value": schema.StringAttribute{
+										Optional: true,
+									},
+									"overwrite_if_exists": overwriteIfExistsAttribute(),
+									names.AttrSource:      optionalComputedStringAttribute(),
+								},
+							},
+						},
+						"parse_postgres": sourceOnlyProcessorBlock("parse_postgres"),
+						"parse_route53":  sourceOnlyProcessorBlock("parse_route53"),
+						"parse_to_ocsf": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerParseToOCSFModel](ctx),
+							Validators: transformerProcessorValidators("parse_to_ocsf"),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"event_source": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.EventSource](),
+										Required:   true,
+									},
+									"ocsf_version": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.OCSFVersion](),
+										Required:   true,
+									},
+									names.AttrSource: optionalComputedStringAttribute(),
+								},
+							},
+						},
+						"parse_vpc": sourceOnlyProcessorBlock("parse_vpc"),
+						"parse_waf": sourceOnlyProcessorBlock("parse_waf"),
+						"rename_keys": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerRenameKeysModel](ctx),
+							Validators: transformerProcessorValidators("rename_keys"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerRenameKeyEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+												"overwrite_if_exists": overwriteIfExistsAttribute(),
+												"rename_to": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"split_string": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerSplitStringModel](ctx),
+							Validators: transformerProcessorValidators("split_string"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerSplitStringEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"delimiter": schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrSource: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"substitute_string": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerSubstituteStringModel](ctx),
+							Validators: transformerProcessorValidators("substitute_string"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerSubstituteStringEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"from": schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrSource: schema.StringAttribute{
+													Required: true,
+												},
+												"to": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"trim_string": withKeysProcessorBlock("trim_string"),
+						"type_converter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transformerTypeConverterModel](ctx),
+							Validators: transformerProcessorValidators("type_converter"),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entry": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[transformerTypeConverterEntryModel](ctx),
+										Validators: entryBlockValidators,
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Type](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"upper_case_string": withKeysProcessorBlock("upper_case_string"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *transformerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	logGroupIdentifier := data.LogGroupIdentifier.ValueString()
+	var input cloudwatchlogs.PutTransformerInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutTransformer(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudWatch Logs Transformer (%s)", logGroupIdentifier), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	output, err := findTransformerByLogGroupIdentifier(ctx, conn, logGroupIdentifier)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Transformer (%s)", logGroupIdentifier), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.TransformerConfig, &data.TransformerConfig)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *transformerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	logGroupIdentifier := data.LogGroupIdentifier.ValueString()
+	output, err := findTransformerByLogGroupIdentifier(ctx, conn, logGroupIdentifier)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Transformer (%s)", logGroupIdentifier), err.Error())
+
+		return
+	}
+
+	// The log group identifier is not refreshed, as the API may return the log group's ARN instead of the configured name.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.TransformerConfig, &data.TransformerConfig)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *transformerResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new transformerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	logGroupIdentifier := new.LogGroupIdentifier.ValueString()
+	var input cloudwatchlogs.PutTransformerInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutTransformer(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Logs Transformer (%s)", logGroupIdentifier), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	output, err := findTransformerByLogGroupIdentifier(ctx, conn, logGroupIdentifier)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Transformer (%s)", logGroupIdentifier), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.TransformerConfig, &new.TransformerConfig)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *transformerResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	logGroupIdentifier := data.LogGroupIdentifier.ValueString()
+	input := cloudwatchlogs.DeleteTransformerInput{
+		LogGroupIdentifier: fwflex.StringFromFramework(ctx, data.LogGroupIdentifier),
+	}
+	_, err := conn.DeleteTransformer(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudWatch Logs Transformer (%s)", logGroupIdentifier), err.Error())
+
+		return
+	}
+}
+
+func (r *transformerResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("log_group_identifier"), request, response)
+}
+
+func findTransformerByLogGroupIdentifier(ctx context.Context, conn *cloudwatchlogs.Client, logGroupIdentifier string) (*cloudwatchlogs.GetTransformerOutput, error) {
+	input := cloudwatchlogs.GetTransformerInput{
+		LogGroupIdentifier: &logGroupIdentifier,
+	}
+	output, err := conn.GetTransformer(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransformerConfig) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type transformerResourceModel struct {
+	framework.WithRegionModel
+	LogGroupIdentifier types.String                                               `tfsdk:"log_group_identifier"`
+	TransformerConfig  fwtypes.ListNestedObjectValueOf[transformerProcessorModel] `tfsdk:"transformer_config"`
+}
+
+type transformerProcessorModel struct {
+	AddKeys           fwtypes.ListNestedObjectValueOf[transformerAddKeysModel]           `tfsdk:"add_keys"`
+	CopyValue         fwtypes.ListNestedObjectValueOf[transformerCopyValueModel]         `tfsdk:"copy_value"`
+	CSV               fwtypes.ListNestedObjectValueOf[transformerCSVModel]               `tfsdk:"csv"`
+	DateTimeConverter fwtypes.ListNestedObjectValueOf[transformerDateTimeConverterModel] `tfsdk:"date_time_converter"`
+	DeleteKeys        fwtypes.ListNestedObjectValueOf[transformerWithKeysProcessorModel] `tfsdk:"delete_keys"`
+	Grok              fwtypes.ListNestedObjectValueOf[transformerGrokModel]              `tfsdk:"grok"`
+	ListToMap         fwtypes.ListNestedObjectValueOf[transformerListToMapModel]         `tfsdk:"list_to_map"`
+	LowerCaseString   fwtypes.ListNestedObjectValueOf[transformerWithKeysProcessorModel] `tfsdk:"lower_case_string"`
+	MoveKeys          fwtypes.ListNestedObjectValueOf[transformerMoveKeysModel]          `tfsdk:"move_keys"`
+	ParseCloudfront   fwtypes.ListNestedObjectValueOf[transformerSourceProcessorModel]   `tfsdk:"parse_cloudfront"`
+	ParseJSON         fwtypes.ListNestedObjectValueOf[transformerParseJSONModel]         `tfsdk:"parse_json"`
+	ParseKeyValue     fwtypes.ListNestedObjectValueOf[transformerParseKeyValueModel]     `tfsdk:"parse_key_value"`
+	ParsePostgres     fwtypes.ListNestedObjectValueOf[transformerSourceProcessorModel]   `tfsdk:"parse_postgres"`
+	ParseRoute53      fwtypes.ListNestedObjectValueOf[transformerSourceProcessorModel]   `tfsdk:"parse_route53"`
+	ParseToOCSF       fwtypes.ListNestedObjectValueOf[transformerParseToOCSFModel]       `tfsdk:"parse_to_ocsf"`
+	ParseVPC          fwtypes.ListNestedObjectValueOf[transformerSourceProcessorModel]   `tfsdk:"parse_vpc"`
+	ParseWAF          fwtypes.ListNestedObjectValueOf[transformerSourceProcessorModel]   `tfsdk:"parse_waf"`
+	RenameKeys        fwtypes.ListNestedObjectValueOf[transformerRenameKeysModel]        `tfsdk:"rename_keys"`
+	SplitString       fwtypes.ListNestedObjectValueOf[transformerSplitStringModel]       `tfsdk:"split_string"`
+	SubstituteString  fwtypes.ListNestedObjectValueOf[transformerSubstituteStringModel]  `tfsdk:"substitute_string"`
+	TrimString        fwtypes.ListNestedObjectValueOf[transformerWithKeysProcessorModel] `tfsdk:"trim_string"`
+	TypeConverter     fwtypes.ListNestedObjectValueOf[transformerTypeConverterModel]     `tfsdk:"type_converter"`
+	UpperCaseString   fwtypes.ListNestedObjectValueOf[transformerWithKeysProcessorModel] `tfsdk:"upper_case_string"`
+}
+
+type transformerAddKeysModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[transformerAddKeyEntryModel] `tfsdk:"entry"`
+}
+
+type transformerAddKeyEntryModel struct {
+	Key               types.String `tfsdk:"key"`
+	OverwriteIfExists types.Bool   `tfsdk:"overwrite_if_exists"`
+	Value             types.String `tfsdk:"value"`
+}
+
+type transformerCopyValueModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[transformerCopyValueEntryModel] `tfsdk:"entry"`
+}
+
+type transformerCopyValueEntryModel struct {
+	OverwriteIfExists types.Bool   `tfsdk:"overwrite_if_exists"`
+	Source            types.String `tfsdk:"source"`
+	Target            types.String `tfsdk:"target"`
+}
+
+type transformerCSVModel struct {
+	Columns        fwtypes.ListOfString `tfsdk:"columns"`
+	Delimiter      types.String         `tfsdk:"delimiter"`
+	QuoteCharacter types.String         `tfsdk:"quote_character"`
+	Source         types.String         `tfsdk:"source"`
+}
+
+type transformerDateTimeConverterModel struct {
+	Locale         types.String         `tfsdk:"locale"`
+	MatchPatterns  fwtypes.ListOfString `tfsdk:"match_patterns"`
+	Source         types.String         `tfsdk:"source"`
+	SourceTimezone types.String         `tfsdk:"source_timezone"`
+	Target         types.String         `tfsdk:"target"`
+	TargetFormat   types.String         `tfsdk:"target_format"`
+	TargetTimezone types.String         `tfsdk:"target_timezone"`
+}
+
+type transformerGrokModel struct {
+	Match  types.String `tfsdk:"match"`
+	Source types.String `tfsdk:"source"`
+}
+
+type transformerListToMapModel struct {
+	Flatten          types.Bool                                    `tfsdk:"flatten"`
+	FlattenedElement fwtypes.StringEnum[awstypes.FlattenedElement] `tfsdk:"flattened_element"`
+	Key              types.String                                  `tfsdk:"key"`
+	Source           types.String                                  `tfsdk:"source"`
+	Target           types.String                                  `tfsdk:"target"`
+	ValueKey         types.String                                  `tfsdk:"value_key"`
+}
+
+type transformerMoveKeysModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[transformerMoveKeyEntryModel] `tfsdk:"entry"`
+}
+
+type transformerMoveKeyEntryModel struct {
+	OverwriteIfExists types.Bool   `tfsdk:"overwrite_if_exists"`
+	Source            types.String `tfsdk:"source"`
+	Target            types.String `tfsdk:"target"`
+}
+
+type transformerParseJSONModel struct {
+	Destination types.String `tfsdk:"destination"`
+	Source      types.String `tfsdk:"source"`
+}
+
+type transformerParseKeyValueModel struct {
+	Destination       types.String `tfsdk:"destination"`
+	FieldDelimiter    types.String `tfsdk:"field_delimiter"`
+	KeyPrefix         types.String `tfsdk:"key_prefix"`
+	KeyValueDelimiter types.String `tfsdk:"key_value_delimiter"`
+	NonMatchValue     types.String `tfsdk:"non_match_value"`
+	OverwriteIfExists types.Bool   `tfsdk:"overwrite_if_exists"`
+	Source            types.String `tfsdk:"source"`
+}
+
+type transformerParseToOCSFModel struct {
+	EventSource fwtypes.StringEnum[awstypes.EventSource] `tfsdk:"event_source"`
+	OCSFVersion fwtypes.StringEnum[awstypes.OCSFVersion] `tfsdk:"ocsf_version"`
+	Source      types.String                             `tfsdk:"source"`
+}
+
+type transformerRenameKeysModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[transformerRenameKeyEntryModel] `tfsdk:"entry"`
+}
+
+type transformerRenameKeyEntryModel struct {
+	Key               types.String `tfsdk:"key"`
+	OverwriteIfExists types.Bool   `tfsdk:"overwrite_if_exists"`
+	RenameTo          types.String `tfsdk:"rename_to"`
+}
+
+type transformerSourceProcessorModel struct {
+	Source types.String `tfsdk:"source"`
+}
+
+type transformerSplitStringModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[transformerSplitStringEntryModel] `tfsdk:"entry"`
+}
+
+type transformerSplitStringEntryModel struct {
+	Delimiter types.String `tfsdk:"delimiter"`
+	Source    types.String `tfsdk:"source"`
+}
+
+type transformerSubstituteStringModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[transformerSubstituteStringEntryModel] `tfsdk:"entry"`
+}
+
+type transformerSubstituteStringEntryModel struct {
+	From   types.String `tfsdk:"from"`
+	Source types.String `tfsdk:"source"`
+	To     types.String `tfsdk:"to"`
+}
+
+type transformerTypeConverterModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[transformerTypeConverterEntryModel] `tfsdk:"entry"`
+}
+
+type transformerTypeConverterEntryModel struct {
+	Key  types.String                      `tfsdk:"key"`
+	Type fwtypes.StringEnum[awstypes.Type] `tfsdk:"type"`
+}
+
+type transformerWithKeysProcessorModel struct {
+	WithKeys fwtypes.ListOfString `tfsdk:"with_keys"`
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsTransformer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_transformer.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudWatchEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransformerDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_identifier", "aws_cloudwatch_log_group.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.0.parse_json.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.0.parse_json.0.source", "@message"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.1.add_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.1.add_keys.0.entry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.1.add_keys.0.entry.0.key", "environment"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.1.add_keys.0.entry.0.overwrite_if_exists", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.1.add_keys.0.entry.0.value", "test"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "log_group_identifier"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "log_group_identifier",
+			},
+		},
+	})
+}
+
+func TestAccLogsTransformer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_transformer.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudWatchEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransformerDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(ctx, t, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflogs.ResourceTransformer, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsTransformer_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_transformer.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudWatchEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransformerDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.#", "2"),
+				),
+			},
+			{
+				Config: testAccTransformerConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.0.grok.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.0.grok.0.match", "%{IP:client_ip} %{WORD:method} %{URIPATHPARAM:request}"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.1.rename_keys.0.entry.0.rename_to", "clientIp"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.2.type_converter.0.entry.0.type", "integer"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.3.delete_keys.0.with_keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsTransformer_parseToOCSF(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_transformer.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudWatchEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransformerDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_parseToOCSF(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.0.parse_to_ocsf.0.event_source", "VPCFlow"),
+					resource.TestCheckResourceAttr(resourceName, "transformer_config.0.parse_to_ocsf.0.ocsf_version", "V1.1"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "log_group_identifier"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "log_group_identifier",
+			},
+		},
+	})
+}
+
+func testAccCheckTransformerDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_transformer" {
+				continue
+			}
+
+			_, err := tflogs.FindTransformerByLogGroupIdentifier(ctx, conn, rs.Primary.Attributes["log_group_identifier"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Transformer still exists: %s", rs.Primary.Attributes["log_group_identifier"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransformerExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).LogsClient(ctx)
+
+		_, err := tflogs.FindTransformerByLogGroupIdentifier(ctx, conn, rs.Primary.Attributes["log_group_identifier"])
+
+		return err
+	}
+}
+
+func testAccTransformerConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_transformer" "test" {
+  log_group_identifier = aws_cloudwatch_log_group.test.name
+
+  transformer_config {
+    parse_json {}
+  }
+
+  transformer_config {
+    add_keys {
+      entry {
+        key   = "environment"
+        value = "test"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccTransformerConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_transformer" "test" {
+  log_group_identifier = aws_cloudwatch_log_group.test.name
+
+  transformer_config {
+    grok {
+      match = "%%{IP:client_ip} %%{WORD:method} %%{URIPATHPARAM:request}"
+    }
+  }
+
+  transformer_config {
+    rename_keys {
+      entry {
+        key       = "client_ip"
+        rename_to = "clientIp"
+      }
+    }
+  }
+
+  transformer_config {
+    type_converter {
+      entry {
+        key  = "status"
+        type = "integer"
+      }
+    }
+  }
+
+  transformer_config {
+    delete_keys {
+      with_keys = ["request"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccTransformerConfig_parseToOCSF(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_transformer" "test" {
+  log_group_identifier = aws_cloudwatch_log_group.test.name
+
+  transformer_config {
+    parse_to_ocsf {
+      event_source = "VPCFlow"
+      ocsf_version = "V1.1"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,834 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_bridge", name="Bridge")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediaconnect/types;awstypes;awstypes.Bridge")
+func newBridgeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bridgeResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type bridgeResource struct {
+	framework.ResourceWithModel[bridgeResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *bridgeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	protocolAttribute := schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+		Required:   true,
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"bridge_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BridgeState](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"placement_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"egress_gateway_bridge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[egressGatewayBridgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ExactlyOneOf(
+						path.MatchRoot("egress_gateway_bridge"),
+						path.MatchRoot("ingress_gateway_bridge"),
+					),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_bitrate": schema.Int64Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"ingress_gateway_bridge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ingressGatewayBridgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_bitrate": schema.Int64Attribute{
+							Required: true,
+						},
+						"max_outputs": schema.Int64Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"network_output": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeNetworkOutputModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrIPAddress: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"network_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrPort: schema.Int64Attribute{
+										Required: true,
+									},
+									names.AttrProtocol: protocolAttribute,
+									"ttl": schema.Int64Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"flow_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeFlowSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("flow_source"),
+									path.MatchRelative().AtParent().AtName("network_source"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flow_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"output_arn": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"flow_vpc_interface_attachment": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceAttachmentModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"vpc_interface_name": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"network_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeNetworkSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"multicast_ip": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"network_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrPort: schema.Int64Attribute{
+										Required: true,
+									},
+									names.AttrProtocol: protocolAttribute,
+								},
+								Blocks: map[string]schema.Block{
+									"multicast_source_settings": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[multicastSourceSettingsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"multicast_source_ip": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"source_failover_config": failoverConfigBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *bridgeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bridgeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input mediaconnect.CreateBridgeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateBridge(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Bridge (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.Bridge.BridgeArn)
+	bridge, err := waitBridgeCreated(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), arn) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) create", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, bridge)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *bridgeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bridgeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findBridgeByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Bridge (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bridgeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old bridgeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, new.ID)
+	updateTimeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	if !new.EgressGatewayBridge.Equal(old.EgressGatewayBridge) ||
+		!new.IngressGatewayBridge.Equal(old.IngressGatewayBridge) ||
+		!new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+		input := mediaconnect.UpdateBridgeInput{
+			BridgeArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateBridge(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s)", arn), err.Error())
+
+			return
+		}
+
+		if _, err := waitBridgeUpdated(ctx, conn, arn, updateTimeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) update", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Sources.Equal(old.Sources) {
+		response.Diagnostics.Append(updateBridgeSources(ctx, conn, arn, old.Sources, new.Sources, updateTimeout)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !new.Outputs.Equal(old.Outputs) {
+		response.Diagnostics.Append(updateBridgeOutputs(ctx, conn, arn, old.Outputs, new.Outputs, updateTimeout)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	bridge, err := findBridgeByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Bridge (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.flatten(ctx, bridge)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *bridgeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bridgeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ID)
+	tflog.Debug(ctx, "deleting MediaConnect Bridge", map[string]any{
+		names.AttrARN: arn,
+	})
+
+	input := mediaconnect.DeleteBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+	_, err := conn.DeleteBridge(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Bridge (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitBridgeDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *bridgeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func updateBridgeSources(ctx context.Context, conn *mediaconnect.Client, arn string, o, n fwtypes.ListNestedObjectValueOf[bridgeSourceModel], timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldSources, d := o.ToSlice(ctx)
+	diags.Append(d...)
+	newSources, d := n.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	name := func(v *bridgeSourceModel) string {
+		return v.name(ctx)
+	}
+	add, update, remove := diffByName(ctx, oldSources, newSources, name)
+
+	// Add new sources before removing old ones so that the bridge always has a source.
+	if len(add) > 0 {
+		input := mediaconnect.AddBridgeSourcesInput{
+			BridgeArn: aws.String(arn),
+		}
+		diags.Append(fwflex.Expand(ctx, add, &input.Sources)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		_, err := conn.AddBridgeSources(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("adding MediaConnect Bridge (%s) sources", arn), err.Error())
+
+			return diags
+		}
+	}
+
+	for _, v := range update {
+		new := v[0]
+		input := mediaconnect.UpdateBridgeSourceInput{
+			BridgeArn:  aws.String(arn),
+			SourceName: aws.String(name(new)),
+		}
+		diags.Append(fwflex.Expand(ctx, new, &input)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		_, err := conn.UpdateBridgeSource(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s) source (%s)", arn, name(new)), err.Error())
+
+			return diags
+		}
+
+		if _, err := waitBridgeUpdated(ctx, conn, arn, timeout); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) update", arn), err.Error())
+
+			return diags
+		}
+	}
+
+	for _, v := range remove {
+		input := mediaconnect.RemoveBridgeSourceInput{
+			BridgeArn:  aws.String(arn),
+			SourceName: aws.String(name(v)),
+		}
+		_, err := conn.RemoveBridgeSource(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("removing MediaConnect Bridge (%s) source (%s)", arn, name(v)), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func updateBridgeOutputs(ctx context.Context, conn *mediaconnect.Client, arn string, o, n fwtypes.ListNestedObjectValueOf[bridgeOutputModel], timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldOutputs, d := o.ToSlice(ctx)
+	diags.Append(d...)
+	newOutputs, d := n.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	name := func(v *bridgeOutputModel) string {
+		return v.name(ctx)
+	}
+	add, update, remove := diffByName(ctx, oldOutputs, newOutputs, name)
+
+	for _, v := range remove {
+		input := mediaconnect.RemoveBridgeOutputInput{
+			BridgeArn:  aws.String(arn),
+			OutputName: aws.String(name(v)),
+		}
+		_, err := conn.RemoveBridgeOutput(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("removing MediaConnect Bridge (%s) output (%s)", arn, name(v)), err.Error())
+
+			return diags
+		}
+	}
+
+	for _, v := range update {
+		new := v[0]
+		input := mediaconnect.UpdateBridgeOutputInput{
+			BridgeArn:  aws.String(arn),
+			OutputName: aws.String(name(new)),
+		}
+		diags.Append(fwflex.Expand(ctx, new, &input)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		_, err := conn.UpdateBridgeOutput(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s) output (%s)", arn, name(new)), err.Error())
+
+			return diags
+		}
+
+		if _, err := waitBridgeUpdated(ctx, conn, arn, timeout); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) update", arn), err.Error())
+
+			return diags
+		}
+	}
+
+	if len(add) > 0 {
+		input := mediaconnect.AddBridgeOutputsInput{
+			BridgeArn: aws.String(arn),
+		}
+		diags.Append(fwflex.Expand(ctx, add, &input.Outputs)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		_, err := conn.AddBridgeOutputs(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("adding MediaConnect Bridge (%s) outputs", arn), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func findBridgeByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Bridge, error) {
+	input := mediaconnect.DescribeBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+	output, err := conn.DescribeBridge(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Bridge == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	if state := output.Bridge.BridgeState; state == awstypes.BridgeStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: &input,
+		}
+	}
+
+	return output.Bridge, nil
+}
+
+func statusBridge(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findBridgeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BridgeState), nil
+	}
+}
+
+func waitBridgeCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateCreating, awstypes.BridgeStateDeploying, awstypes.BridgeStateStarting),
+		Target:  enum.Slice(awstypes.BridgeStateActive, awstypes.BridgeStateStandby, awstypes.BridgeStateStartPending),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateUpdating, awstypes.BridgeStateDeploying, awstypes.BridgeStateStarting),
+		Target:  enum.Slice(awstypes.BridgeStateActive, awstypes.BridgeStateStandby, awstypes.BridgeStateStartPending),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateActive, awstypes.BridgeStateDeleting, awstypes.BridgeStateStandby, awstypes.BridgeStateStartPending, awstypes.BridgeStateStopping),
+		Target:  []string{},
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type bridgeResourceModel struct {
+	framework.WithRegionModel
+	ARN                  types.String                                               `tfsdk:"arn"`
+	BridgeState          fwtypes.StringEnum[awstypes.BridgeState]                   `tfsdk:"bridge_state"`
+	EgressGatewayBridge  fwtypes.ListNestedObjectValueOf[egressGatewayBridgeModel]  `tfsdk:"egress_gateway_bridge"`
+	ID                   types.String                                               `tfsdk:"id"`
+	IngressGatewayBridge fwtypes.ListNestedObjectValueOf[ingressGatewayBridgeModel] `tfsdk:"ingress_gateway_bridge"`
+	Name                 types.String                                               `tfsdk:"name"`
+	Outputs              fwtypes.ListNestedObjectValueOf[bridgeOutputModel]         `tfsdk:"output"`
+	PlacementARN         fwtypes.ARN                                                `tfsdk:"placement_arn"`
+	Sources              fwtypes.ListNestedObjectValueOf[bridgeSourceModel]         `tfsdk:"source"`
+	SourceFailoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel]       `tfsdk:"source_failover_config"`
+	Timeouts             timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+func (m *bridgeResourceModel) flatten(ctx context.Context, apiObject *awstypes.Bridge) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	outputNames, d := namesOf(ctx, m.Outputs, func(v *bridgeOutputModel) string { return v.name(ctx) })
+	diags.Append(d...)
+	sourceNames, d := namesOf(ctx, m.Sources, func(v *bridgeSourceModel) string { return v.name(ctx) })
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The source failover configuration is reported as disabled when it was omitted.
+	// Keep it null so as not to show a perpetual diff.
+	sourceFailoverConfig := m.SourceFailoverConfig
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, m,
+		fwflex.WithFieldNamePrefix("Bridge"),
+		fwflex.WithIgnoredFieldNamesAppend("Outputs"),
+		fwflex.WithIgnoredFieldNamesAppend("Sources"),
+	)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ID = m.ARN
+
+	if sourceFailoverConfig.IsNull() {
+		if v := apiObject.SourceFailoverConfig; v == nil || v.State != awstypes.StateEnabled {
+			m.SourceFailoverConfig = sourceFailoverConfig
+		}
+	}
+
+	// Outputs to flows are managed by the flows themselves.
+	var apiOutputs []awstypes.BridgeOutput
+	for _, v := range apiObject.Outputs {
+		if v.NetworkOutput != nil {
+			apiOutputs = append(apiOutputs, v)
+		}
+	}
+	diags.Append(fwflex.Flatten(ctx, orderByName(apiOutputs, outputNames, func(v awstypes.BridgeOutput) string {
+		return aws.ToString(v.NetworkOutput.Name)
+	}), &m.Outputs)...)
+	diags.Append(fwflex.Flatten(ctx, orderByName(apiObject.Sources, sourceNames, func(v awstypes.BridgeSource) string {
+		switch {
+		case v.FlowSource != nil:
+			return aws.ToString(v.FlowSource.Name)
+		case v.NetworkSource != nil:
+			return aws.ToString(v.NetworkSource.Name)
+		default:
+			return ""
+		}
+	}), &m.Sources)...)
+
+	return diags
+}
+
+type egressGatewayBridgeModel struct {
+	MaxBitrate types.Int64 `tfsdk:"max_bitrate"`
+}
+
+type ingressGatewayBridgeModel struct {
+	MaxBitrate types.Int64 `tfsdk:"max_bitrate"`
+	MaxOutputs types.Int64 `tfsdk:"max_outputs"`
+}
+
+type bridgeOutputModel struct {
+	NetworkOutput fwtypes.ListNestedObjectValueOf[bridgeNetworkOutputModel] `tfsdk:"network_output"`
+}
+
+func (m *bridgeOutputModel) name(ctx context.Context) string {
+	if v, _ := m.NetworkOutput.ToPtr(ctx); v != nil {
+		return v.Name.ValueString()
+	}
+
+	return ""
+}
+
+type bridgeNetworkOutputModel struct {
+	IPAddress   types.String                          `tfsdk:"ip_address"`
+	Name        types.String                          `tfsdk:"name"`
+	NetworkName types.String                          `tfsdk:"network_name"`
+	Port        types.Int64                           `tfsdk:"port"`
+	Protocol    fwtypes.StringEnum[awstypes.Protocol] `tfsdk:"protocol"`
+	TTL         types.Int64                           `tfsdk:"ttl"`
+}
+
+type bridgeSourceModel struct {
+	FlowSource    fwtypes.ListNestedObjectValueOf[bridgeFlowSourceModel]    `tfsdk:"flow_source"`
+	NetworkSource fwtypes.ListNestedObjectValueOf[bridgeNetworkSourceModel] `tfsdk:"network_source"`
+}
+
+func (m *bridgeSourceModel) name(ctx context.Context) string {
+	if v, _ := m.FlowSource.ToPtr(ctx); v != nil {
+		return v.Name.ValueString()
+	}
+	if v, _ := m.NetworkSource.ToPtr(ctx); v != nil {
+		return v.Name.ValueString()
+	}
+
+	return ""
+}
+
+type bridgeFlowSourceModel struct {
+	FlowARN                    fwtypes.ARN                                                  `tfsdk:"flow_arn"`
+	FlowVPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"flow_vpc_interface_attachment"`
+	Name                       types.String                                                 `tfsdk:"name"`
+	OutputARN                  types.String                                                 `tfsdk:"output_arn"`
+}
+
+type bridgeNetworkSourceModel struct {
+	MulticastIP             types.String                                                  `tfsdk:"multicast_ip"`
+	MulticastSourceSettings fwtypes.ListNestedObjectValueOf[multicastSourceSettingsModel] `tfsdk:"multicast_source_settings"`
+	Name                    types.String                                                  `tfsdk:"name"`
+	NetworkName             types.String                                                  `tfsdk:"network_name"`
+	Port                    types.Int64                                                   `tfsdk:"port"`
+	Protocol                fwtypes.StringEnum[awstypes.Protocol]                         `tfsdk:"protocol"`
+}
+
+type multicastSourceSettingsModel struct {
+	MulticastSourceIP types.String `tfsdk:"multicast_source_ip"`
+}
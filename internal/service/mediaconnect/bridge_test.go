@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConnectBridge_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Bridge
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_bridge.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBridgeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBridgeConfig_basic(rName, 10000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBridgeExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNRegexp("mediaconnect", regexache.MustCompile(`bridge:.+:`+rName+`$`))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("ingress_gateway_bridge"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"max_bitrate": knownvalue.Int64Exact(10000000),
+							"max_outputs": knownvalue.Int64Exact(2),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrSource), knownvalue.ListSizeExact(1)),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBridgeConfig_basic(rName, 20000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBridgeExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("ingress_gateway_bridge"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"max_bitrate": knownvalue.Int64Exact(20000000),
+							"max_outputs": knownvalue.Int64Exact(2),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectBridge_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Bridge
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_bridge.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBridgeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBridgeConfig_basic(rName, 10000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBridgeExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceBridge, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBridgeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_bridge" {
+				continue
+			}
+
+			_, err := tfmediaconnect.FindBridgeByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Bridge %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBridgeExists(ctx context.Context, n string, v *awstypes.Bridge) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindBridgeByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBridgeConfig_basic(rName string, maxBitrate int) string {
+	return acctest.ConfigCompose(testAccGatewayConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediaconnect_bridge" "test" {
+  name          = %[1]q
+  placement_arn = aws_mediaconnect_gateway.test.arn
+
+  ingress_gateway_bridge {
+    max_bitrate = %[2]d
+    max_outputs = 2
+  }
+
+  source {
+    network_source {
+      name         = "source1"
+      multicast_ip = "224.0.0.1"
+      network_name = "network1"
+      port         = 5000
+      protocol     = "rtp"
+    }
+  }
+}
+`, rName, maxBitrate))
+}
@@ -0,0 +1,1372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediaconnect/types;awstypes;awstypes.Flow")
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithModel[flowResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"egress_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Status](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.StatusStandby)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.StatusActive, awstypes.StatusStandby)...),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"entitlement": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[entitlementModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"data_transfer_subscriber_fee_percent": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"entitlement_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"entitlement_status": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EntitlementStatus](),
+							Optional:   true,
+							Computed:   true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"subscribers": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": encryptionBlock(ctx),
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr_allow_list": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrDestination: schema.StringAttribute{
+							Optional: true,
+						},
+						"max_latency": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"min_latency": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"output_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"output_status": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.OutputStatus](),
+							Optional:   true,
+							Computed:   true,
+						},
+						names.AttrPort: schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+							Required:   true,
+						},
+						"remote_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"sender_control_port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"smoothing_latency": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": encryptionBlock(ctx),
+						"vpc_interface_attachment": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceAttachmentModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"vpc_interface_name": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"entitlement_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"ingest_ip": schema.StringAttribute{
+							Computed: true,
+						},
+						"ingest_port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"max_bitrate": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"max_latency": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"max_sync_buffer": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"min_latency": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+							Optional:   true,
+						},
+						"sender_control_port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"sender_ip_address": schema.StringAttribute{
+							Optional: true,
+						},
+						"source_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"source_listener_address": schema.StringAttribute{
+							Optional: true,
+						},
+						"source_listener_port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"vpc_interface_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"whitelist_cidr": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"decryption": encryptionBlock(ctx),
+					},
+				},
+			},
+			"source_failover_config": failoverConfigBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"vpc_interface": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"network_interface_ids": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"network_interface_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.NetworkInterfaceType](),
+							Optional:   true,
+							Computed:   true,
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						names.AttrSubnetID: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func encryptionBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"algorithm": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.Algorithm](),
+					Optional:   true,
+				},
+				"constant_initialization_vector": schema.StringAttribute{
+					Optional: true,
+				},
+				"device_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"key_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeyType](),
+					Optional:   true,
+					Computed:   true,
+				},
+				names.AttrRegion: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrResourceID: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrRoleARN: schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+				"secret_arn": schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Optional:   true,
+				},
+				names.AttrURL: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func failoverConfigBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[failoverConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"failover_mode": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.FailoverMode](),
+					Optional:   true,
+					Computed:   true,
+				},
+				"recovery_window": schema.Int64Attribute{
+					Optional: true,
+					Computed: true,
+				},
+				names.AttrState: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.State](),
+					Optional:   true,
+					Computed:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"source_priority": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[sourcePriorityModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"primary_source": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input mediaconnect.CreateFlowInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateFlow(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.Flow.FlowArn)
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
+	flow, err := waitFlowCreated(ctx, conn, arn, createTimeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), arn) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) create", arn), err.Error())
+
+		return
+	}
+
+	if err := createTags(ctx, conn, arn, getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), arn) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("setting MediaConnect Flow (%s) tags", arn), err.Error())
+
+		return
+	}
+
+	if err := updateFlowState(ctx, conn, arn, flow.Status, data.State.ValueEnum(), createTimeout); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), arn) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) state", arn), err.Error())
+
+		return
+	}
+
+	flow, err = findFlowByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), arn) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, flow)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findFlowByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, new.ID)
+	updateTimeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	if !new.Sources.Equal(old.Sources) {
+		response.Diagnostics.Append(updateFlowSources(ctx, conn, arn, old.Sources, new.Sources, updateTimeout)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+		input := mediaconnect.UpdateFlowInput{
+			FlowArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.SourceFailoverConfig, &input.SourceFailoverConfig)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if input.SourceFailoverConfig == nil {
+			input.SourceFailoverConfig = &awstypes.UpdateFailoverConfig{
+				State: awstypes.StateDisabled,
+			}
+		}
+
+		_, err := conn.UpdateFlow(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) source failover configuration", arn), err.Error())
+
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, updateTimeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Outputs.Equal(old.Outputs) {
+		response.Diagnostics.Append(updateFlowOutputs(ctx, conn, arn, old.Outputs, new.Outputs, updateTimeout)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !new.Entitlements.Equal(old.Entitlements) {
+		response.Diagnostics.Append(updateFlowEntitlements(ctx, conn, arn, old.Entitlements, new.Entitlements)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Start or stop the flow last so that any component changes are picked up.
+	if !new.State.Equal(old.State) {
+		flow, err := waitFlowUpdated(ctx, conn, arn, updateTimeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", arn), err.Error())
+
+			return
+		}
+
+		if err := updateFlowState(ctx, conn, arn, flow.Status, new.State.ValueEnum(), updateTimeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) state", arn), err.Error())
+
+			return
+		}
+	}
+
+	flow, err := findFlowByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.flatten(ctx, flow)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ID)
+	deleteTimeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// A flow must be stopped before it can be deleted.
+	flow, err := findFlowByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	if err := updateFlowState(ctx, conn, arn, flow.Status, awstypes.StatusStandby, deleteTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("stopping MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	tflog.Debug(ctx, "deleting MediaConnect Flow", map[string]any{
+		names.AttrARN: arn,
+	})
+
+	input := mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	_, err = conn.DeleteFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, arn, deleteTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *flowResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func updateFlowState(ctx context.Context, conn *mediaconnect.Client, arn string, currentState, configuredState awstypes.Status, timeout time.Duration) error {
+	if currentState == configuredState {
+		return nil
+	}
+
+	switch configuredState {
+	case awstypes.StatusActive:
+		if err := startFlow(ctx, conn, arn, timeout); err != nil {
+			return err
+		}
+	case awstypes.StatusStandby:
+		if err := stopFlow(ctx, conn, arn, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func startFlow(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) error {
+	input := mediaconnect.StartFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	_, err := conn.StartFlow(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("starting MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := waitFlowStarted(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for MediaConnect Flow (%s) start: %w", arn, err)
+	}
+
+	return nil
+}
+
+func stopFlow(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) error {
+	input := mediaconnect.StopFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	_, err := conn.StopFlow(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("stopping MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := waitFlowStopped(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for MediaConnect Flow (%s) stop: %w", arn, err)
+	}
+
+	return nil
+}
+
+func updateFlowSources(ctx context.Context, conn *mediaconnect.Client, arn string, o, n fwtypes.ListNestedObjectValueOf[flowSourceModel], timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldSources, d := o.ToSlice(ctx)
+	diags.Append(d...)
+	newSources, d := n.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	add, update, remove := diffByName(ctx, oldSources, newSources, func(v *flowSourceModel) string {
+		return v.Name.ValueString()
+	})
+
+	// Add new sources before removing old ones so that the flow always has a source.
+	if len(add) > 0 {
+		input := mediaconnect.AddFlowSourcesInput{
+			FlowArn: aws.String(arn),
+		}
+		diags.Append(fwflex.Expand(ctx, add, &input.Sources)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		_, err := conn.AddFlowSources(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) sources", arn), err.Error())
+
+			return diags
+		}
+	}
+
+	for _, v := range update {
+		new, old := v[0], v[1]
+		input := mediaconnect.UpdateFlowSourceInput{
+			FlowArn:   aws.String(arn),
+			SourceArn: fwflex.StringFromFramework(ctx, old.SourceARN),
+		}
+		diags.Append(fwflex.Expand(ctx, new, &input, fwflex.WithIgnoredFieldNamesAppend("SourceARN"))...)
+		if diags.HasError() {
+			return diags
+		}
+
+		_, err := conn.UpdateFlowSource(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) source (%s)", arn, new.Name.ValueString()), err.Error())
+
+			return diags
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", arn), err.Error())
+
+			return diags
+		}
+	}
+
+	for _, v := range remove {
+		input := mediaconnect.RemoveFlowSourceInput{
+			FlowArn:   aws.String(arn),
+			SourceArn: fwflex.StringFromFramework(ctx, v.SourceARN),
+		}
+		_, err := conn.RemoveFlowSource(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) source (%s)", arn, v.Name.ValueString()), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func updateFlowOutputs(ctx context.Context, conn *mediaconnect.Client, arn string, o, n fwtypes.ListNestedObjectValueOf[flowOutputModel], timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldOutputs, d := o.ToSlice(ctx)
+	diags.Append(d...)
+	newOutputs, d := n.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	add, update, remove := diffByName(ctx, oldOutputs, newOutputs, func(v *flowOutputModel) string {
+		return v.Name.ValueString()
+	})
+
+	for _, v := range remove {
+		input := mediaconnect.RemoveFlowOutputInput{
+			FlowArn:   aws.String(arn),
+			OutputArn: fwflex.StringFromFramework(ctx, v.OutputARN),
+		}
+		_, err := conn.RemoveFlowOutput(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) output (%s)", arn, v.Name.ValueString()), err.Error())
+
+			return diags
+		}
+	}
+
+	for _, v := range update {
+		new, old := v[0], v[1]
+		input := mediaconnect.UpdateFlowOutputInput{
+			FlowArn:   aws.String(arn),
+			OutputArn: fwflex.StringFromFramework(ctx, old.OutputARN),
+		}
+		diags.Append(fwflex.Expand(ctx, new, &input, fwflex.WithIgnoredFieldNamesAppend("OutputARN"))...)
+		if diags.HasError() {
+			return diags
+		}
+
+		_, err := conn.UpdateFlowOutput(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) output (%s)", arn, new.Name.ValueString()), err.Error())
+
+			return diags
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", arn), err.Error())
+
+			return diags
+		}
+	}
+
+	if len(add) > 0 {
+		input := mediaconnect.AddFlowOutputsInput{
+			FlowArn: aws.String(arn),
+		}
+		diags.Append(fwflex.Expand(ctx, add, &input.Outputs)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		_, err := conn.AddFlowOutputs(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) outputs", arn), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func updateFlowEntitlements(ctx context.Context, conn *mediaconnect.Client, arn string, o, n fwtypes.ListNestedObjectValueOf[entitlementModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldEntitlements, d := o.ToSlice(ctx)
+	diags.Append(d...)
+	newEntitlements, d := n.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	add, update, remove := diffByName(ctx, oldEntitlements, newEntitlements, func(v *entitlementModel) string {
+		return v.Name.ValueString()
+	})
+
+	for _, v := range update {
+		new, old := v[0], v[1]
+
+		// The data transfer fee can only be set when the entitlement is granted.
+		if !new.DataTransferSubscriberFeePercent.IsUnknown() && !new.DataTransferSubscriberFeePercent.Equal(old.DataTransferSubscriberFeePercent) {
+			remove = append(remove, old)
+			add = append(add, new)
+
+			continue
+		}
+
+		input := mediaconnect.UpdateFlowEntitlementInput{
+			EntitlementArn: fwflex.StringFromFramework(ctx, old.EntitlementARN),
+			FlowArn:        aws.String(arn),
+		}
+		diags.Append(fwflex.Expand(ctx, new, &input, fwflex.WithIgnoredFieldNamesAppend("EntitlementARN"))...)
+		if diags.HasError() {
+			return diags
+		}
+
+		_, err := conn.UpdateFlowEntitlement(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) entitlement (%s)", arn, new.Name.ValueString()), err.Error())
+
+			return diags
+		}
+	}
+
+	for _, v := range remove {
+		input := mediaconnect.RevokeFlowEntitlementInput{
+			EntitlementArn: fwflex.StringFromFramework(ctx, v.EntitlementARN),
+			FlowArn:        aws.String(arn),
+		}
+		_, err := conn.RevokeFlowEntitlement(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("revoking MediaConnect Flow (%s) entitlement (%s)", arn, v.Name.ValueString()), err.Error())
+
+			return diags
+		}
+	}
+
+	if len(add) > 0 {
+		input := mediaconnect.GrantFlowEntitlementsInput{
+			FlowArn: aws.String(arn),
+		}
+		diags.Append(fwflex.Expand(ctx, add, &input.Entitlements)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		_, err := conn.GrantFlowEntitlements(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("granting MediaConnect Flow (%s) entitlements", arn), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// diffByName matches flow components by name.
+// It returns the components to add, the (new, old) pairs of components to update and the components to remove.
+// Components whose configurable values are unchanged are omitted.
+func diffByName[T any](ctx context.Context, o, n []*T, name func(*T) string) ([]*T, [][2]*T, []*T) {
+	var add, remove []*T
+	var update [][2]*T
+
+	old := make(map[string]*T, len(o))
+	for _, v := range o {
+		old[name(v)] = v
+	}
+
+	for _, v := range n {
+		k := name(v)
+		ov, ok := old[k]
+		if !ok {
+			add = append(add, v)
+			continue
+		}
+		delete(old, k)
+
+		if results, diags := fwflex.Diff(ctx, v, ov); !diags.HasError() && results.HasChanges() {
+			update = append(update, [2]*T{v, ov})
+		}
+	}
+
+	for _, v := range o {
+		if _, ok := old[name(v)]; ok {
+			remove = append(remove, v)
+		}
+	}
+
+	return add, update, remove
+}
+
+// orderByName orders apiObjects as their names appear in keys.
+// API objects whose names do not appear are appended in the order returned by the API.
+func orderByName[T any](apiObjects []T, keys []string, name func(T) string) []T {
+	position := make(map[string]int, len(keys))
+	for i, v := range keys {
+		position[v] = i
+	}
+
+	ordered := make([]T, len(keys))
+	found := make([]bool, len(keys))
+	var unknown []T
+
+	for _, v := range apiObjects {
+		if i, ok := position[name(v)]; ok && !found[i] {
+			ordered[i] = v
+			found[i] = true
+		} else {
+			unknown = append(unknown, v)
+		}
+	}
+
+	var apiObjectsOut []T
+	for i, v := range ordered {
+		if found[i] {
+			apiObjectsOut = append(apiObjectsOut, v)
+		}
+	}
+
+	return append(apiObjectsOut, unknown...)
+}
+
+func namesOf[T any](ctx context.Context, v fwtypes.ListNestedObjectValueOf[T], name func(*T) string) ([]string, diag.Diagnostics) {
+	ptrs, diags := v.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	keys := make([]string, 0, len(ptrs))
+	for _, v := range ptrs {
+		keys = append(keys, name(v))
+	}
+
+	return keys, diags
+}
+
+func findFlowByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Flow, error) {
+	input := mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	output, err := conn.DescribeFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output.Flow, nil
+}
+
+func statusFlow(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findFlowByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusActive, awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStarted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusStandby, awstypes.StatusStarting, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusActive),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStopped(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusStopping, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusDeleting, awstypes.StatusStandby),
+		Target:  []string{},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type flowResourceModel struct {
+	framework.WithRegionModel
+	ARN                  types.String                                         `tfsdk:"arn"`
+	AvailabilityZone     types.String                                         `tfsdk:"availability_zone"`
+	EgressIP             types.String                                         `tfsdk:"egress_ip"`
+	Entitlements         fwtypes.ListNestedObjectValueOf[entitlementModel]    `tfsdk:"entitlement"`
+	ID                   types.String                                         `tfsdk:"id"`
+	Name                 types.String                                         `tfsdk:"name"`
+	Outputs              fwtypes.ListNestedObjectValueOf[flowOutputModel]     `tfsdk:"output"`
+	Sources              fwtypes.ListNestedObjectValueOf[flowSourceModel]     `tfsdk:"source"`
+	SourceFailoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel] `tfsdk:"source_failover_config"`
+	State                fwtypes.StringEnum[awstypes.Status]                  `tfsdk:"state" autoflex:"-"`
+	Tags                 tftags.Map                                           `tfsdk:"tags"`
+	TagsAll              tftags.Map                                           `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                                       `tfsdk:"timeouts"`
+	VPCInterfaces        fwtypes.ListNestedObjectValueOf[vpcInterfaceModel]   `tfsdk:"vpc_interface"`
+}
+
+func (m *flowResourceModel) flatten(ctx context.Context, apiObject *awstypes.Flow) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	entitlementNames, d := namesOf(ctx, m.Entitlements, func(v *entitlementModel) string { return v.Name.ValueString() })
+	diags.Append(d...)
+	outputNames, d := namesOf(ctx, m.Outputs, func(v *flowOutputModel) string { return v.Name.ValueString() })
+	diags.Append(d...)
+	sourceNames, d := namesOf(ctx, m.Sources, func(v *flowSourceModel) string { return v.Name.ValueString() })
+	diags.Append(d...)
+	vpcInterfaceNames, d := namesOf(ctx, m.VPCInterfaces, func(v *vpcInterfaceModel) string { return v.Name.ValueString() })
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The source failover configuration is reported as disabled when it was omitted.
+	// Keep it null so as not to show a perpetual diff.
+	sourceFailoverConfig := m.SourceFailoverConfig
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, m,
+		fwflex.WithFieldNamePrefix("Flow"),
+		fwflex.WithIgnoredFieldNamesAppend("Entitlements"),
+		fwflex.WithIgnoredFieldNamesAppend("Outputs"),
+		fwflex.WithIgnoredFieldNamesAppend("Sources"),
+		fwflex.WithIgnoredFieldNamesAppend("VpcInterfaces"),
+	)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ID = m.ARN
+	m.State = fwtypes.StringEnumValue(apiObject.Status)
+
+	if sourceFailoverConfig.IsNull() {
+		if v := apiObject.SourceFailoverConfig; v == nil || v.State != awstypes.StateEnabled {
+			m.SourceFailoverConfig = sourceFailoverConfig
+		}
+	}
+
+	diags.Append(fwflex.Flatten(ctx, orderByName(apiObject.Entitlements, entitlementNames, func(v awstypes.Entitlement) string {
+		return aws.ToString(v.Name)
+	}), &m.Entitlements)...)
+	diags.Append(fwflex.Flatten(ctx, orderByName(apiObject.VpcInterfaces, vpcInterfaceNames, func(v awstypes.VpcInterface) string {
+		return aws.ToString(v.Name)
+	}), &m.VPCInterfaces)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Transport settings are reported in a nested structure.
+	var outputs []flowOutputModel
+	for _, v := range orderByName(apiObject.Outputs, outputNames, func(v awstypes.Output) string {
+		return aws.ToString(v.Name)
+	}) {
+		var output flowOutputModel
+		diags.Append(fwflex.Flatten(ctx, v, &output)...)
+		if v.Transport != nil {
+			diags.Append(fwflex.Flatten(ctx, v.Transport, &output)...)
+		}
+		if diags.HasError() {
+			return diags
+		}
+		outputs = append(outputs, output)
+	}
+	m.Outputs = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, outputs)
+
+	apiSources := apiObject.Sources
+	if len(apiSources) == 0 && apiObject.Source != nil {
+		apiSources = []awstypes.Source{*apiObject.Source}
+	}
+	var sources []flowSourceModel
+	for _, v := range orderByName(apiSources, sourceNames, func(v awstypes.Source) string {
+		return aws.ToString(v.Name)
+	}) {
+		var source flowSourceModel
+		diags.Append(fwflex.Flatten(ctx, v, &source)...)
+		if v.Transport != nil {
+			diags.Append(fwflex.Flatten(ctx, v.Transport, &source)...)
+		}
+		if diags.HasError() {
+			return diags
+		}
+		sources = append(sources, source)
+	}
+	m.Sources = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, sources)
+
+	return diags
+}
+
+type encryptionModel struct {
+	Algorithm                    fwtypes.StringEnum[awstypes.Algorithm] `tfsdk:"algorithm"`
+	ConstantInitializationVector types.String                           `tfsdk:"constant_initialization_vector"`
+	DeviceID                     types.String                           `tfsdk:"device_id"`
+	KeyType                      fwtypes.StringEnum[awstypes.KeyType]   `tfsdk:"key_type"`
+	Region                       types.String                           `tfsdk:"region"`
+	ResourceID                   types.String                           `tfsdk:"resource_id"`
+	RoleARN                      fwtypes.ARN                            `tfsdk:"role_arn"`
+	SecretARN                    fwtypes.ARN                            `tfsdk:"secret_arn"`
+	URL                          types.String                           `tfsdk:"url"`
+}
+
+type entitlementModel struct {
+	DataTransferSubscriberFeePercent types.Int64                                      `tfsdk:"data_transfer_subscriber_fee_percent"`
+	Description                      types.String                                     `tfsdk:"description"`
+	Encryption                       fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"encryption"`
+	EntitlementARN                   types.String                                     `tfsdk:"entitlement_arn"`
+	EntitlementStatus                fwtypes.StringEnum[awstypes.EntitlementStatus]   `tfsdk:"entitlement_status"`
+	Name                             types.String                                     `tfsdk:"name"`
+	Subscribers                      fwtypes.SetOfString                              `tfsdk:"subscribers"`
+}
+
+type failoverConfigModel struct {
+	FailoverMode   fwtypes.StringEnum[awstypes.FailoverMode]            `tfsdk:"failover_mode"`
+	RecoveryWindow types.Int64                                          `tfsdk:"recovery_window"`
+	SourcePriority fwtypes.ListNestedObjectValueOf[sourcePriorityModel] `tfsdk:"source_priority"`
+	State          fwtypes.StringEnum[awstypes.State]                   `tfsdk:"state"`
+}
+
+type flowOutputModel struct {
+	CIDRAllowList          fwtypes.ListOfString                                         `tfsdk:"cidr_allow_list"`
+	Description            types.String                                                 `tfsdk:"description"`
+	Destination            types.String                                                 `tfsdk:"destination"`
+	Encryption             fwtypes.ListNestedObjectValueOf[encryptionModel]             `tfsdk:"encryption"`
+	MaxLatency             types.Int64                                                  `tfsdk:"max_latency"`
+	MinLatency             types.Int64                                                  `tfsdk:"min_latency"`
+	Name                   types.String                                                 `tfsdk:"name"`
+	OutputARN              types.String                                                 `tfsdk:"output_arn"`
+	OutputStatus           fwtypes.StringEnum[awstypes.OutputStatus]                    `tfsdk:"output_status"`
+	Port                   types.Int64                                                  `tfsdk:"port"`
+	Protocol               fwtypes.StringEnum[awstypes.Protocol]                        `tfsdk:"protocol"`
+	RemoteID               types.String                                                 `tfsdk:"remote_id"`
+	SenderControlPort      types.Int64                                                  `tfsdk:"sender_control_port"`
+	SmoothingLatency       types.Int64                                                  `tfsdk:"smoothing_latency"`
+	StreamID               types.String                                                 `tfsdk:"stream_id"`
+	VPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"vpc_interface_attachment"`
+}
+
+type flowSourceModel struct {
+	Decryption            fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"decryption"`
+	Description           types.String                                     `tfsdk:"description"`
+	EntitlementARN        fwtypes.ARN                                      `tfsdk:"entitlement_arn"`
+	IngestIP              types.String                                     `tfsdk:"ingest_ip"`
+	IngestPort            types.Int64                                      `tfsdk:"ingest_port"`
+	MaxBitrate            types.Int64                                      `tfsdk:"max_bitrate"`
+	MaxLatency            types.Int64                                      `tfsdk:"max_latency"`
+	MaxSyncBuffer         types.Int64                                      `tfsdk:"max_sync_buffer"`
+	MinLatency            types.Int64                                      `tfsdk:"min_latency"`
+	Name                  types.String                                     `tfsdk:"name"`
+	Protocol              fwtypes.StringEnum[awstypes.Protocol]            `tfsdk:"protocol"`
+	SenderControlPort     types.Int64                                      `tfsdk:"sender_control_port"`
+	SenderIPAddress       types.String                                     `tfsdk:"sender_ip_address"`
+	SourceARN             types.String                                     `tfsdk:"source_arn"`
+	SourceListenerAddress types.String                                     `tfsdk:"source_listener_address"`
+	SourceListenerPort    types.Int64                                      `tfsdk:"source_listener_port"`
+	StreamID              types.String                                     `tfsdk:"stream_id"`
+	VPCInterfaceName      types.String                                     `tfsdk:"vpc_interface_name"`
+	WhitelistCIDR         types.String                                     `tfsdk:"whitelist_cidr"`
+}
+
+type sourcePriorityModel struct {
+	PrimarySource types.String `tfsdk:"primary_source"`
+}
+
+type vpcInterfaceAttachmentModel struct {
+	VPCInterfaceName types.String `tfsdk:"vpc_interface_name"`
+}
+
+type vpcInterfaceModel struct {
+	Name                 types.String                                      `tfsdk:"name"`
+	NetworkInterfaceIDs  fwtypes.ListOfString                              `tfsdk:"network_interface_ids"`
+	NetworkInterfaceType fwtypes.StringEnum[awstypes.NetworkInterfaceType] `tfsdk:"network_interface_type"`
+	RoleARN              fwtypes.ARN                                       `tfsdk:"role_arn"`
+	SecurityGroupIDs     fwtypes.SetOfString                               `tfsdk:"security_group_ids"`
+	SubnetID             types.String                                      `tfsdk:"subnet_id"`
+}
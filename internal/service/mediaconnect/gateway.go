@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_gateway", name="Gateway")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediaconnect/types;awstypes;awstypes.Gateway")
+func newGatewayResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &gatewayResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type gatewayResource struct {
+	framework.ResourceWithModel[gatewayResourceModel]
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *gatewayResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"egress_cidr_blocks": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"gateway_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GatewayState](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"network": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[gatewayNetworkModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrCIDRBlock: schema.StringAttribute{
+							CustomType: fwtypes.CIDRBlockType,
+							Required:   true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *gatewayResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data gatewayResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input mediaconnect.CreateGatewayInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateGateway(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Gateway (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.Gateway.GatewayArn)
+	gateway, err := waitGatewayCreated(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), arn) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Gateway (%s) create", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, gateway, &data, fwflex.WithFieldNamePrefix("Gateway"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ID = data.ARN
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *gatewayResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data gatewayResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findGatewayByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Gateway (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Gateway"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ID = data.ARN
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *gatewayResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data gatewayResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ID)
+	tflog.Debug(ctx, "deleting MediaConnect Gateway", map[string]any{
+		names.AttrARN: arn,
+	})
+
+	input := mediaconnect.DeleteGatewayInput{
+		GatewayArn: aws.String(arn),
+	}
+	_, err := conn.DeleteGateway(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Gateway (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitGatewayDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Gateway (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *gatewayResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func findGatewayByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Gateway, error) {
+	input := mediaconnect.DescribeGatewayInput{
+		GatewayArn: aws.String(arn),
+	}
+	output, err := conn.DescribeGateway(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Gateway == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	if state := output.Gateway.GatewayState; state == awstypes.GatewayStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: &input,
+		}
+	}
+
+	return output.Gateway, nil
+}
+
+func statusGateway(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findGatewayByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.GatewayState), nil
+	}
+}
+
+func waitGatewayCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Gateway, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GatewayStateCreating),
+		Target:  enum.Slice(awstypes.GatewayStateActive),
+		Refresh: statusGateway(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Gateway); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGatewayDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Gateway, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GatewayStateActive, awstypes.GatewayStateDeleting, awstypes.GatewayStateError),
+		Target:  []string{},
+		Refresh: statusGateway(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Gateway); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type gatewayResourceModel struct {
+	framework.WithRegionModel
+	ARN              types.String                                         `tfsdk:"arn"`
+	EgressCIDRBlocks fwtypes.ListOfString                                 `tfsdk:"egress_cidr_blocks"`
+	GatewayState     fwtypes.StringEnum[awstypes.GatewayState]            `tfsdk:"gateway_state"`
+	ID               types.String                                         `tfsdk:"id"`
+	Name             types.String                                         `tfsdk:"name"`
+	Networks         fwtypes.ListNestedObjectValueOf[gatewayNetworkModel] `tfsdk:"network"`
+	Timeouts         timeouts.Value                                       `tfsdk:"timeouts"`
+}
+
+type gatewayNetworkModel struct {
+	CIDRBlock fwtypes.CIDRBlock `tfsdk:"cidr_block"`
+	Name      types.String      `tfsdk:"name"`
+}
@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_application", name="Application")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("application_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.Application")
+func newApplicationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &applicationResource{}
+
+	return r, nil
+}
+
+type applicationResource struct {
+	framework.ResourceWithModel[applicationResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *applicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(600),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"wave_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *applicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	name := data.Name.ValueString()
+	var input mgn.CreateApplicationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateApplication(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MGN Application (%s)", name), err.Error())
+
+		return
+	}
+
+	applicationID := aws.ToString(output.ApplicationID)
+
+	if waveID := data.WaveID.ValueString(); waveID != "" {
+		if err := associateApplication(ctx, conn, applicationID, waveID); err != nil {
+			response.State.SetAttribute(ctx, path.Root("application_id"), applicationID) // Set 'application_id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("associating MGN Application (%s) with Wave (%s)", applicationID, waveID), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.ApplicationID = fwflex.StringValueToFramework(ctx, applicationID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *applicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	applicationID := data.ApplicationID.ValueString()
+	output, err := findApplicationByID(ctx, conn, applicationID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MGN Application (%s)", applicationID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("WaveID"))
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	applicationID := new.ApplicationID.ValueString()
+
+	if diff.HasChanges() {
+		var input mgn.UpdateApplicationInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateApplication(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MGN Application (%s)", applicationID), err.Error())
+
+			return
+		}
+	}
+
+	if !new.WaveID.Equal(old.WaveID) {
+		if waveID := old.WaveID.ValueString(); waveID != "" {
+			if err := disassociateApplication(ctx, conn, applicationID, waveID); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating MGN Application (%s) from Wave (%s)", applicationID, waveID), err.Error())
+
+				return
+			}
+		}
+
+		if waveID := new.WaveID.ValueString(); waveID != "" {
+			if err := associateApplication(ctx, conn, applicationID, waveID); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating MGN Application (%s) with Wave (%s)", applicationID, waveID), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *applicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	applicationID := data.ApplicationID.ValueString()
+
+	if waveID := data.WaveID.ValueString(); waveID != "" {
+		err := disassociateApplication(ctx, conn, applicationID, waveID)
+
+		if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			response.Diagnostics.AddError(fmt.Sprintf("disassociating MGN Application (%s) from Wave (%s)", applicationID, waveID), err.Error())
+
+			return
+		}
+	}
+
+	// An application must be archived before it can be deleted.
+	archiveInput := mgn.ArchiveApplicationInput{
+		ApplicationID: aws.String(applicationID),
+	}
+	_, err := conn.ArchiveApplication(ctx, &archiveInput)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("archiving MGN Application (%s)", applicationID), err.Error())
+
+		return
+	}
+
+	input := mgn.DeleteApplicationInput{
+		ApplicationID: aws.String(applicationID),
+	}
+	_, err = conn.DeleteApplication(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MGN Application (%s)", applicationID), err.Error())
+
+		return
+	}
+}
+
+func associateApplication(ctx context.Context, conn *mgn.Client, applicationID, waveID string) error {
+	input := mgn.AssociateApplicationsInput{
+		ApplicationIDs: []string{applicationID},
+		WaveID:         aws.String(waveID),
+	}
+	_, err := conn.AssociateApplications(ctx, &input)
+
+	return err
+}
+
+func disassociateApplication(ctx context.Context, conn *mgn.Client, applicationID, waveID string) error {
+	input := mgn.DisassociateApplicationsInput{
+		ApplicationIDs: []string{applicationID},
+		WaveID:         aws.String(waveID),
+	}
+	_, err := conn.DisassociateApplications(ctx, &input)
+
+	return err
+}
+
+func findApplicationByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.Application, error) {
+	input := mgn.ListApplicationsInput{
+		Filters: &awstypes.ListApplicationsRequestFilters{
+			ApplicationIDs: []string{id},
+		},
+	}
+
+	return findApplication(ctx, conn, &input)
+}
+
+func findApplication(ctx context.Context, conn *mgn.Client, input *mgn.ListApplicationsInput) (*awstypes.Application, error) {
+	output, err := findApplications(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findApplications(ctx context.Context, conn *mgn.Client, input *mgn.ListApplicationsInput) ([]awstypes.Application, error) {
+	var output []awstypes.Application
+
+	pages := mgn.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type applicationResourceModel struct {
+	framework.WithRegionModel
+	ApplicationID types.String `tfsdk:"application_id"`
+	ARN           types.String `tfsdk:"arn"`
+	Description   types.String `tfsdk:"description"`
+	Name          types.String `tfsdk:"name"`
+	Tags          tftags.Map   `tfsdk:"tags"`
+	TagsAll       tftags.Map   `tfsdk:"tags_all"`
+	WaveID        types.String `tfsdk:"wave_id"`
+}
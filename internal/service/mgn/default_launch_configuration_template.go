@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_mgn_default_launch_configuration_template", name="Default Launch Configuration Template")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("launch_configuration_template_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.LaunchConfigurationTemplate")
+// @Testing(serialize=true)
+func newDefaultLaunchConfigurationTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &defaultLaunchConfigurationTemplateResource{}
+
+	return r, nil
+}
+
+// defaultLaunchConfigurationTemplateResource adopts the launch configuration template
+// that is created when Application Migration Service is initialized in an account and Region.
+// Its schema is identical to aws_mgn_launch_configuration_template's, except that launch_configuration_template_id can be specified.
+type defaultLaunchConfigurationTemplateResource struct {
+	launchConfigurationTemplateResource
+}
+
+func (r *defaultLaunchConfigurationTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	r.launchConfigurationTemplateResource.Schema(ctx, request, response)
+
+	// MGN does not mark the template created on service initialization,
+	// so its ID must be specified if the account and Region hold other templates.
+	response.Schema.Attributes["launch_configuration_template_id"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *defaultLaunchConfigurationTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var template *awstypes.LaunchConfigurationTemplate
+	var err error
+	if id := fwflex.StringValueFromFramework(ctx, data.LaunchConfigurationTemplateID); id != "" {
+		template, err = findLaunchConfigurationTemplateByID(ctx, conn, id)
+	} else {
+		template, err = findDefaultLaunchConfigurationTemplate(ctx, conn)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading MGN default Launch Configuration Template", err.Error())
+
+		return
+	}
+
+	templateID, arn := aws.ToString(template.LaunchConfigurationTemplateID), aws.ToString(template.Arn)
+	data.ARN = fwflex.StringValueToFramework(ctx, arn)
+	data.LaunchConfigurationTemplateID = fwflex.StringValueToFramework(ctx, templateID)
+
+	output, err := updateLaunchConfigurationTemplate(ctx, conn, data)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("launch_configuration_template_id"), templateID) // Set 'launch_configuration_template_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("updating MGN default Launch Configuration Template (%s)", templateID), err.Error())
+
+		return
+	}
+
+	if err := updateTags(ctx, conn, arn, template.Tags, getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root("launch_configuration_template_id"), templateID) // Set 'launch_configuration_template_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("updating MGN default Launch Configuration Template (%s) tags", templateID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, data.flattenOptions()...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *defaultLaunchConfigurationTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// The default template is retained and only removed from state.
+}
+
+// findDefaultLaunchConfigurationTemplate returns the account and Region's only launch configuration template.
+func findDefaultLaunchConfigurationTemplate(ctx context.Context, conn *mgn.Client) (*awstypes.LaunchConfigurationTemplate, error) {
+	var input mgn.DescribeLaunchConfigurationTemplatesInput
+	output, err := findLaunchConfigurationTemplates(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch n := len(output); n {
+	case 0:
+		return nil, tfresource.NewEmptyResultError(&input)
+	case 1:
+		return &output[0], nil
+	default:
+		return nil, fmt.Errorf("%d MGN Launch Configuration Templates found, specify launch_configuration_template_id to identify the default template", n)
+	}
+}
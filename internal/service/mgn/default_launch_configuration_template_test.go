@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDefaultLaunchConfigurationTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LaunchConfigurationTemplate
+	resourceName := "aws_mgn_default_launch_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckDefaultLaunchConfigurationTemplate(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "mgn", "launch-configuration-template/{launch_configuration_template_id}"),
+					resource.TestCheckResourceAttr(resourceName, "copy_private_ip", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "ec2_launch_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "target_instance_type_right_sizing_method", "NONE"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "launch_configuration_template_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "launch_configuration_template_id",
+			},
+		},
+	})
+}
+
+func testAccDefaultLaunchConfigurationTemplate_otherTemplate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LaunchConfigurationTemplate
+	var templateID string
+	resourceName := "aws_mgn_default_launch_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			templateID = testAccPreCheckDefaultLaunchConfigurationTemplate(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDefaultLaunchConfigurationTemplateConfig_otherTemplate(),
+				ExpectError: regexache.MustCompile(`2 MGN Launch Configuration Templates found, specify launch_configuration_template_id`),
+			},
+			{
+				Config: testAccDefaultLaunchConfigurationTemplateConfig_otherTemplateID(templateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "launch_configuration_template_id", templateID),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STOPPED"),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckDefaultLaunchConfigurationTemplate skips the test unless the account and Region
+// hold exactly one launch configuration template, which the default resource adopts, and returns its ID.
+func testAccPreCheckDefaultLaunchConfigurationTemplate(ctx context.Context, t *testing.T) string {
+	conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+	var input mgn.DescribeLaunchConfigurationTemplatesInput
+	output, err := tfmgn.FindLaunchConfigurationTemplates(ctx, conn, &input)
+
+	if err != nil {
+		t.Fatalf("listing MGN Launch Configuration Templates: %s", err)
+	}
+
+	if n := len(output); n != 1 {
+		t.Skipf("skipping acceptance testing: %d MGN Launch Configuration Templates found, expected 1", n)
+	}
+
+	return aws.ToString(output[0].LaunchConfigurationTemplateID)
+}
+
+func testAccDefaultLaunchConfigurationTemplateConfig_basic() string {
+	return `
+resource "aws_mgn_default_launch_configuration_template" "test" {
+  copy_private_ip                          = false
+  copy_tags                                = true
+  launch_disposition                       = "STOPPED"
+  target_instance_type_right_sizing_method = "NONE"
+}
+`
+}
+
+func testAccDefaultLaunchConfigurationTemplateConfig_otherTemplate() string {
+	return acctest.ConfigCompose(testAccLaunchConfigurationTemplateConfig_basic(), `
+resource "aws_mgn_default_launch_configuration_template" "test" {
+  copy_private_ip                          = false
+  copy_tags                                = true
+  launch_disposition                       = "STOPPED"
+  target_instance_type_right_sizing_method = "NONE"
+
+  depends_on = [aws_mgn_launch_configuration_template.test]
+}
+`)
+}
+
+func testAccDefaultLaunchConfigurationTemplateConfig_otherTemplateID(templateID string) string {
+	return acctest.ConfigCompose(testAccLaunchConfigurationTemplateConfig_basic(), fmt.Sprintf(`
+resource "aws_mgn_default_launch_configuration_template" "test" {
+  copy_private_ip                          = false
+  copy_tags                                = true
+  launch_configuration_template_id         = %[1]q
+  launch_disposition                       = "STOPPED"
+  target_instance_type_right_sizing_method = "NONE"
+
+  depends_on = [aws_mgn_launch_configuration_template.test]
+}
+`, templateID))
+}
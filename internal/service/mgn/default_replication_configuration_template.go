@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_mgn_default_replication_configuration_template", name="Default Replication Configuration Template")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("replication_configuration_template_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.ReplicationConfigurationTemplate")
+// @Testing(serialize=true)
+func newDefaultReplicationConfigurationTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &defaultReplicationConfigurationTemplateResource{}
+
+	return r, nil
+}
+
+// defaultReplicationConfigurationTemplateResource adopts the replication configuration template
+// that is created when Application Migration Service is initialized in an account and Region.
+// Its schema is identical to aws_mgn_replication_configuration_template's, except that replication_configuration_template_id can be specified.
+type defaultReplicationConfigurationTemplateResource struct {
+	replicationConfigurationTemplateResource
+}
+
+func (r *defaultReplicationConfigurationTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	r.replicationConfigurationTemplateResource.Schema(ctx, request, response)
+
+	// MGN does not mark the template created on service initialization,
+	// so its ID must be specified if the account and Region hold other templates.
+	response.Schema.Attributes["replication_configuration_template_id"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *defaultReplicationConfigurationTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data replicationConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var template *awstypes.ReplicationConfigurationTemplate
+	var err error
+	if id := fwflex.StringValueFromFramework(ctx, data.ReplicationConfigurationTemplateID); id != "" {
+		template, err = findReplicationConfigurationTemplateByID(ctx, conn, id)
+	} else {
+		template, err = findDefaultReplicationConfigurationTemplate(ctx, conn)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading MGN default Replication Configuration Template", err.Error())
+
+		return
+	}
+
+	templateID, arn := aws.ToString(template.ReplicationConfigurationTemplateID), aws.ToString(template.Arn)
+	data.ARN = fwflex.StringValueToFramework(ctx, arn)
+	data.ReplicationConfigurationTemplateID = fwflex.StringValueToFramework(ctx, templateID)
+
+	output, err := updateReplicationConfigurationTemplate(ctx, conn, data)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("replication_configuration_template_id"), templateID) // Set 'replication_configuration_template_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("updating MGN default Replication Configuration Template (%s)", templateID), err.Error())
+
+		return
+	}
+
+	if err := updateTags(ctx, conn, arn, template.Tags, getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root("replication_configuration_template_id"), templateID) // Set 'replication_configuration_template_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("updating MGN default Replication Configuration Template (%s) tags", templateID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.UseFIPSEndpoint = fwflex.BoolToFramework(ctx, output.UseFipsEndpoint)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *defaultReplicationConfigurationTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// The default template is retained and only removed from state.
+}
+
+// findDefaultReplicationConfigurationTemplate returns the account and Region's only replication configuration template.
+func findDefaultReplicationConfigurationTemplate(ctx context.Context, conn *mgn.Client) (*awstypes.ReplicationConfigurationTemplate, error) {
+	var input mgn.DescribeReplicationConfigurationTemplatesInput
+	output, err := findReplicationConfigurationTemplates(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch n := len(output); n {
+	case 0:
+		return nil, tfresource.NewEmptyResultError(&input)
+	case 1:
+		return &output[0], nil
+	default:
+		return nil, fmt.Errorf("%d MGN Replication Configuration Templates found, specify replication_configuration_template_id to identify the default template", n)
+	}
+}
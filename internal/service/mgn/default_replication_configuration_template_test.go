@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDefaultReplicationConfigurationTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReplicationConfigurationTemplate
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mgn_default_replication_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckDefaultReplicationConfigurationTemplate(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultReplicationConfigurationTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, t, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "mgn", "replication-configuration-template/{replication_configuration_template_id}"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "0"),
+					resource.TestCheckResourceAttr(resourceName, "replication_server_instance_type", "t3.small"),
+					resource.TestCheckResourceAttrPair(resourceName, "staging_area_subnet_id", "aws_subnet.test.0", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "staging_area_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "staging_area_tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "replication_configuration_template_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "replication_configuration_template_id",
+			},
+		},
+	})
+}
+
+func testAccDefaultReplicationConfigurationTemplate_otherTemplate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReplicationConfigurationTemplate
+	var templateID string
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mgn_default_replication_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			templateID = testAccPreCheckDefaultReplicationConfigurationTemplate(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDefaultReplicationConfigurationTemplateConfig_otherTemplate(rName),
+				ExpectError: regexache.MustCompile(`2 MGN Replication Configuration Templates found, specify replication_configuration_template_id`),
+			},
+			{
+				Config: testAccDefaultReplicationConfigurationTemplateConfig_otherTemplateID(rName, templateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration_template_id", templateID),
+					resource.TestCheckResourceAttr(resourceName, "replication_server_instance_type", "t3.small"),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckDefaultReplicationConfigurationTemplate skips the test unless the account and Region
+// hold exactly one replication configuration template, which the default resource adopts, and returns its ID.
+func testAccPreCheckDefaultReplicationConfigurationTemplate(ctx context.Context, t *testing.T) string {
+	conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+	var input mgn.DescribeReplicationConfigurationTemplatesInput
+	output, err := tfmgn.FindReplicationConfigurationTemplates(ctx, conn, &input)
+
+	if err != nil {
+		t.Fatalf("listing MGN Replication Configuration Templates: %s", err)
+	}
+
+	if n := len(output); n != 1 {
+		t.Skipf("skipping acceptance testing: %d MGN Replication Configuration Templates found, expected 1", n)
+	}
+
+	return aws.ToString(output[0].ReplicationConfigurationTemplateID)
+}
+
+func testAccDefaultReplicationConfigurationTemplateConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_mgn_default_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = 0
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = "GP3"
+  ebs_encryption                          = "DEFAULT"
+  replication_server_instance_type        = "t3.small"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test[0].id
+  use_dedicated_replication_server        = false
+
+  staging_area_tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccDefaultReplicationConfigurationTemplateConfig_otherTemplate(rName string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationTemplateConfig_basic(rName), `
+resource "aws_mgn_default_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = 0
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = "GP3"
+  ebs_encryption                          = "DEFAULT"
+  replication_server_instance_type        = "t3.small"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test[0].id
+  use_dedicated_replication_server        = false
+
+  depends_on = [aws_mgn_replication_configuration_template.test]
+}
+`)
+}
+
+func testAccDefaultReplicationConfigurationTemplateConfig_otherTemplateID(rName, templateID string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationTemplateConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mgn_default_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = 0
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = "GP3"
+  ebs_encryption                          = "DEFAULT"
+  replication_configuration_template_id   = %[1]q
+  replication_server_instance_type        = "t3.small"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test[0].id
+  use_dedicated_replication_server        = false
+
+  depends_on = [aws_mgn_replication_configuration_template.test]
+}
+`, templateID))
+}
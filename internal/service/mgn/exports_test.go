@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+// Exports for use in tests only.
+var (
+	ResourceApplication                             = newApplicationResource
+	ResourceDefaultLaunchConfigurationTemplate      = newDefaultLaunchConfigurationTemplateResource
+	ResourceDefaultReplicationConfigurationTemplate = newDefaultReplicationConfigurationTemplateResource
+	ResourceLaunchConfigurationTemplate             = newLaunchConfigurationTemplateResource
+	ResourceReplicationConfigurationTemplate        = newReplicationConfigurationTemplateResource
+	ResourceSourceServerLaunchConfiguration         = newSourceServerLaunchConfigurationResource
+	ResourceWave                                    = newWaveResource
+
+	FindApplicationByID                      = findApplicationByID
+	FindLaunchConfigurationBySourceServerID  = findLaunchConfigurationBySourceServerID
+	FindLaunchConfigurationTemplateByID      = findLaunchConfigurationTemplateByID
+	FindLaunchConfigurationTemplates         = findLaunchConfigurationTemplates
+	FindReplicationConfigurationTemplateByID = findReplicationConfigurationTemplateByID
+	FindReplicationConfigurationTemplates    = findReplicationConfigurationTemplates
+	FindWaveByID                             = findWaveByID
+)
@@ -0,0 +1,496 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_launch_configuration_template", name="Launch Configuration Template")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("launch_configuration_template_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.LaunchConfigurationTemplate")
+// @Testing(serialize=true)
+func newLaunchConfigurationTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &launchConfigurationTemplateResource{}
+
+	return r, nil
+}
+
+type launchConfigurationTemplateResource struct {
+	framework.ResourceWithModel[launchConfigurationTemplateResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *launchConfigurationTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:                 framework.ARNAttributeComputedOnly(),
+			"associate_public_ip_address": optionalComputedBoolAttribute(),
+			"boot_mode":                   bootModeAttribute(),
+			"copy_private_ip":             optionalComputedBoolAttribute(),
+			"copy_tags":                   optionalComputedBoolAttribute(),
+			"ec2_launch_template_id":      ec2LaunchTemplateIDAttribute(),
+			"enable_map_auto_tagging":     optionalComputedBoolAttribute(),
+			"launch_configuration_template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"launch_disposition":      launchDispositionAttribute(),
+			"map_auto_tagging_mpe_id": mapAutoTaggingMPEIDAttribute(),
+			"small_volume_max_size": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_instance_type_right_sizing_method": targetInstanceTypeRightSizingMethodAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"large_volume_conf":   launchTemplateDiskConfBlock(ctx),
+			"licensing":           licensingBlock(ctx),
+			"post_launch_actions": postLaunchActionsBlock(ctx),
+			"small_volume_conf":   launchTemplateDiskConfBlock(ctx),
+		},
+	}
+}
+
+func (r *launchConfigurationTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var input mgn.CreateLaunchConfigurationTemplateInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateLaunchConfigurationTemplate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating MGN Launch Configuration Template", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, data.flattenOptions()...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *launchConfigurationTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	templateID := data.LaunchConfigurationTemplateID.ValueString()
+	output, err := findLaunchConfigurationTemplateByID(ctx, conn, templateID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MGN Launch Configuration Template (%s)", templateID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, data.flattenOptions()...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *launchConfigurationTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		output, err := updateLaunchConfigurationTemplate(ctx, conn, new)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MGN Launch Configuration Template (%s)", new.LaunchConfigurationTemplateID.ValueString()), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new, new.flattenOptions()...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *launchConfigurationTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	templateID := data.LaunchConfigurationTemplateID.ValueString()
+	input := mgn.DeleteLaunchConfigurationTemplateInput{
+		LaunchConfigurationTemplateID: aws.String(templateID),
+	}
+	_, err := conn.DeleteLaunchConfigurationTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MGN Launch Configuration Template (%s)", templateID), err.Error())
+
+		return
+	}
+}
+
+func updateLaunchConfigurationTemplate(ctx context.Context, conn *mgn.Client, data launchConfigurationTemplateResourceModel) (*mgn.UpdateLaunchConfigurationTemplateOutput, error) {
+	var input mgn.UpdateLaunchConfigurationTemplateInput
+	if diags := fwflex.Expand(ctx, data, &input); diags.HasError() {
+		return nil, fwdiag.DiagnosticsError(diags)
+	}
+
+	return conn.UpdateLaunchConfigurationTemplate(ctx, &input)
+}
+
+func findLaunchConfigurationTemplateByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.LaunchConfigurationTemplate, error) {
+	input := mgn.DescribeLaunchConfigurationTemplatesInput{
+		LaunchConfigurationTemplateIDs: []string{id},
+	}
+
+	return findLaunchConfigurationTemplate(ctx, conn, &input)
+}
+
+func findLaunchConfigurationTemplate(ctx context.Context, conn *mgn.Client, input *mgn.DescribeLaunchConfigurationTemplatesInput) (*awstypes.LaunchConfigurationTemplate, error) {
+	output, err := findLaunchConfigurationTemplates(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findLaunchConfigurationTemplates(ctx context.Context, conn *mgn.Client, input *mgn.DescribeLaunchConfigurationTemplatesInput) ([]awstypes.LaunchConfigurationTemplate, error) {
+	var output []awstypes.LaunchConfigurationTemplate
+
+	pages := mgn.NewDescribeLaunchConfigurationTemplatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+func bootModeAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.BootMode](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func ec2LaunchTemplateIDAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func launchDispositionAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.LaunchDisposition](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func mapAutoTaggingMPEIDAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func optionalComputedBoolAttribute() schema.Attribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func targetInstanceTypeRightSizingMethodAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.TargetInstanceTypeRightSizingMethod](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func launchTemplateDiskConfBlock(ctx context.Context) schema.Block {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[launchTemplateDiskConfModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrIOPS: schema.Int64Attribute{
+					Optional: true,
+					Computed: true,
+				},
+				names.AttrThroughput: schema.Int64Attribute{
+					Optional: true,
+					Computed: true,
+				},
+				names.AttrVolumeType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.VolumeType](),
+					Optional:   true,
+					Computed:   true,
+				},
+			},
+		},
+	}
+}
+
+func licensingBlock(ctx context.Context) schema.Block {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[licensingModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"os_byol": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func postLaunchActionsBlock(ctx context.Context) schema.Block {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[postLaunchActionsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"cloud_watch_log_group_name": schema.StringAttribute{
+					Optional: true,
+				},
+				"deployment": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.PostLaunchActionsDeploymentType](),
+					Optional:   true,
+					Computed:   true,
+				},
+				"s3_log_bucket": schema.StringAttribute{
+					Optional: true,
+				},
+				"s3_output_key_prefix": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"ssm_document": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[ssmDocumentModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"action_name": schema.StringAttribute{
+								Required: true,
+							},
+							"must_succeed_for_cutover": schema.BoolAttribute{
+								Optional: true,
+								Computed: true,
+							},
+							"ssm_document_name": schema.StringAttribute{
+								Required: true,
+							},
+							"timeout_seconds": schema.Int32Attribute{
+								Optional: true,
+								Computed: true,
+								Validators: []validator.Int32{
+									int32validator.AtLeast(1),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type launchConfigurationTemplateResourceModel struct {
+	framework.WithRegionModel
+	ARN                                 types.String                                                     `tfsdk:"arn"`
+	AssociatePublicIPAddress            types.Bool                                                       `tfsdk:"associate_public_ip_address"`
+	BootMode                            fwtypes.StringEnum[awstypes.BootMode]                            `tfsdk:"boot_mode"`
+	CopyPrivateIP                       types.Bool                                                       `tfsdk:"copy_private_ip"`
+	CopyTags                            types.Bool                                                       `tfsdk:"copy_tags"`
+	EC2LaunchTemplateID                 types.String                                                     `tfsdk:"ec2_launch_template_id"`
+	EnableMapAutoTagging                types.Bool                                                       `tfsdk:"enable_map_auto_tagging"`
+	LargeVolumeConf                     fwtypes.ListNestedObjectValueOf[launchTemplateDiskConfModel]     `tfsdk:"large_volume_conf"`
+	LaunchConfigurationTemplateID       types.String                                                     `tfsdk:"launch_configuration_template_id"`
+	LaunchDisposition                   fwtypes.StringEnum[awstypes.LaunchDisposition]                   `tfsdk:"launch_disposition"`
+	Licensing                           fwtypes.ListNestedObjectValueOf[licensingModel]                  `tfsdk:"licensing"`
+	MapAutoTaggingMPEID                 types.String                                                     `tfsdk:"map_auto_tagging_mpe_id"`
+	PostLaunchActions                   fwtypes.ListNestedObjectValueOf[postLaunchActionsModel]          `tfsdk:"post_launch_actions"`
+	SmallVolumeConf                     fwtypes.ListNestedObjectValueOf[launchTemplateDiskConfModel]     `tfsdk:"small_volume_conf"`
+	SmallVolumeMaxSize                  types.Int64                                                      `tfsdk:"small_volume_max_size"`
+	Tags                                tftags.Map                                                       `tfsdk:"tags"`
+	TagsAll                             tftags.Map                                                       `tfsdk:"tags_all"`
+	TargetInstanceTypeRightSizingMethod fwtypes.StringEnum[awstypes.TargetInstanceTypeRightSizingMethod] `tfsdk:"target_instance_type_right_sizing_method"`
+}
+
+// flattenOptions returns AutoFlex options that leave unconfigured blocks untouched.
+// The API always returns these blocks, so only refresh the ones that have been configured.
+func (m launchConfigurationTemplateResourceModel) flattenOptions() []fwflex.AutoFlexOptionsFunc {
+	var opts []fwflex.AutoFlexOptionsFunc
+
+	if m.LargeVolumeConf.IsNull() {
+		opts = append(opts, fwflex.WithIgnoredFieldNamesAppend("LargeVolumeConf"))
+	}
+	if m.Licensing.IsNull() {
+		opts = append(opts, fwflex.WithIgnoredFieldNamesAppend("Licensing"))
+	}
+	if m.PostLaunchActions.IsNull() {
+		opts = append(opts, fwflex.WithIgnoredFieldNamesAppend("PostLaunchActions"))
+	}
+	if m.SmallVolumeConf.IsNull() {
+		opts = append(opts, fwflex.WithIgnoredFieldNamesAppend("SmallVolumeConf"))
+	}
+
+	return opts
+}
+
+type launchTemplateDiskConfModel struct {
+	IOPS       types.Int64                             `tfsdk:"iops"`
+	Throughput types.Int64                             `tfsdk:"throughput"`
+	VolumeType fwtypes.StringEnum[awstypes.VolumeType] `tfsdk:"volume_type"`
+}
+
+type licensingModel struct {
+	OSBYOL types.Bool `tfsdk:"os_byol"`
+}
+
+type postLaunchActionsModel struct {
+	CloudWatchLogGroupName types.String                                                 `tfsdk:"cloud_watch_log_group_name"`
+	Deployment             fwtypes.StringEnum[awstypes.PostLaunchActionsDeploymentType] `tfsdk:"deployment"`
+	S3LogBucket            types.String                                                 `tfsdk:"s3_log_bucket"`
+	S3OutputKeyPrefix      types.String                                                 `tfsdk:"s3_output_key_prefix"`
+	SSMDocuments           fwtypes.ListNestedObjectValueOf[ssmDocumentModel]            `tfsdk:"ssm_document"`
+}
+
+type ssmDocumentModel struct {
+	ActionName            types.String `tfsdk:"action_name"`
+	MustSucceedForCutover types.Bool   `tfsdk:"must_succeed_for_cutover"`
+	SSMDocumentName       types.String `tfsdk:"ssm_document_name"`
+	TimeoutSeconds        types.Int32  `tfsdk:"timeout_seconds"`
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccLaunchConfigurationTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LaunchConfigurationTemplate
+	resourceName := "aws_mgn_launch_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "mgn", "launch-configuration-template/{launch_configuration_template_id}"),
+					resource.TestCheckResourceAttr(resourceName, "boot_mode", "USE_SOURCE"),
+					resource.TestCheckResourceAttr(resourceName, "copy_private_ip", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "ec2_launch_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "large_volume_conf.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "launch_configuration_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.0.os_byol", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "small_volume_conf.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_instance_type_right_sizing_method", "BASIC"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "launch_configuration_template_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "launch_configuration_template_id",
+				ImportStateVerifyIgnore:              []string{"licensing"},
+			},
+		},
+	})
+}
+
+func testAccLaunchConfigurationTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LaunchConfigurationTemplate
+	resourceName := "aws_mgn_launch_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmgn.ResourceLaunchConfigurationTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccLaunchConfigurationTemplate_postLaunchActions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LaunchConfigurationTemplate
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mgn_launch_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_postLaunchActions(rName, "TEST_AND_CUTOVER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.cloud_watch_log_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.deployment", "TEST_AND_CUTOVER"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.ssm_document.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.ssm_document.0.action_name", "restart"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.ssm_document.0.must_succeed_for_cutover", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.ssm_document.0.ssm_document_name", "AWS-RestartEC2Instance"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.ssm_document.0.timeout_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "small_volume_conf.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "small_volume_conf.0.volume_type", "gp3"),
+					resource.TestCheckResourceAttr(resourceName, "small_volume_max_size", "100"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "launch_configuration_template_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "launch_configuration_template_id",
+				ImportStateVerifyIgnore:              []string{"post_launch_actions", "small_volume_conf"},
+			},
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_postLaunchActions(rName, "CUTOVER_ONLY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.deployment", "CUTOVER_ONLY"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLaunchConfigurationTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LaunchConfigurationTemplate
+	resourceName := "aws_mgn_launch_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_tags1(acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_tags2(acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_tags1(acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchConfigurationTemplateDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mgn_launch_configuration_template" {
+				continue
+			}
+
+			_, err := tfmgn.FindLaunchConfigurationTemplateByID(ctx, conn, rs.Primary.Attributes["launch_configuration_template_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MGN Launch Configuration Template %s still exists", rs.Primary.Attributes["launch_configuration_template_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchConfigurationTemplateExists(ctx context.Context, t *testing.T, n string, v *awstypes.LaunchConfigurationTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+		output, err := tfmgn.FindLaunchConfigurationTemplateByID(ctx, conn, rs.Primary.Attributes["launch_configuration_template_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLaunchConfigurationTemplateConfig_basic() string {
+	return `
+resource "aws_mgn_launch_configuration_template" "test" {
+  boot_mode                                = "USE_SOURCE"
+  copy_private_ip                          = false
+  copy_tags                                = true
+  launch_disposition                       = "STOPPED"
+  target_instance_type_right_sizing_method = "BASIC"
+
+  licensing {
+    os_byol = true
+  }
+}
+`
+}
+
+func testAccLaunchConfigurationTemplateConfig_postLaunchActions(rName, deployment string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_mgn_launch_configuration_template" "test" {
+  small_volume_max_size = 100
+
+  small_volume_conf {
+    volume_type = "gp3"
+  }
+
+  post_launch_actions {
+    cloud_watch_log_group_name = aws_cloudwatch_log_group.test.name
+    deployment                 = %[2]q
+
+    ssm_document {
+      action_name              = "restart"
+      must_succeed_for_cutover = true
+      ssm_document_name        = "AWS-RestartEC2Instance"
+      timeout_seconds          = 600
+    }
+  }
+}
+`, rName, deployment)
+}
+
+func testAccLaunchConfigurationTemplateConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_launch_configuration_template" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccLaunchConfigurationTemplateConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_launch_configuration_template" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
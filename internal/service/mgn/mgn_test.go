@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+)
+
+const serializeDelay = 5 * time.Second
+
+// Serialize the template tests as the default templates are account-wide singletons
+// and the account/Region must hold at most one template of each kind for them to be adopted.
+func TestAccMgn_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"DefaultLaunchConfigurationTemplate": {
+			acctest.CtBasic: testAccDefaultLaunchConfigurationTemplate_basic,
+			"otherTemplate": testAccDefaultLaunchConfigurationTemplate_otherTemplate,
+		},
+		"DefaultReplicationConfigurationTemplate": {
+			acctest.CtBasic: testAccDefaultReplicationConfigurationTemplate_basic,
+			"otherTemplate": testAccDefaultReplicationConfigurationTemplate_otherTemplate,
+		},
+		"LaunchConfigurationTemplate": {
+			acctest.CtBasic:      testAccLaunchConfigurationTemplate_basic,
+			acctest.CtDisappears: testAccLaunchConfigurationTemplate_disappears,
+			"postLaunchActions":  testAccLaunchConfigurationTemplate_postLaunchActions,
+			"tags":               testAccLaunchConfigurationTemplate_tags,
+		},
+		"ReplicationConfigurationTemplate": {
+			acctest.CtBasic:      testAccReplicationConfigurationTemplate_basic,
+			acctest.CtDisappears: testAccReplicationConfigurationTemplate_disappears,
+			"tags":               testAccReplicationConfigurationTemplate_tags,
+			"update":             testAccReplicationConfigurationTemplate_update,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, serializeDelay)
+}
+
+// testAccPreCheck skips the test if Application Migration Service has not been initialized
+// in the account and Region.
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+	var input mgn.DescribeReplicationConfigurationTemplatesInput
+	_, err := tfmgn.FindReplicationConfigurationTemplates(ctx, conn, &input)
+
+	if acctest.PreCheckSkipError(err) || errs.IsA[*awstypes.UninitializedAccountException](err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
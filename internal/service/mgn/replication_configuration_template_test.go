@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccReplicationConfigurationTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReplicationConfigurationTemplate
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mgn_replication_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, t, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "mgn", "replication-configuration-template/{replication_configuration_template_id}"),
+					resource.TestCheckResourceAttr(resourceName, "associate_default_security_group", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "0"),
+					resource.TestCheckResourceAttr(resourceName, "create_public_ip", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "data_plane_routing", "PRIVATE_IP"),
+					resource.TestCheckResourceAttr(resourceName, "default_large_staging_disk_type", "GP3"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_encryption", "DEFAULT"),
+					resource.TestCheckNoResourceAttr(resourceName, "ebs_encryption_key_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "replication_configuration_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "replication_server_instance_type", "t3.small"),
+					resource.TestCheckResourceAttr(resourceName, "replication_servers_security_groups_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "staging_area_subnet_id", "aws_subnet.test.0", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "staging_area_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "staging_area_tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "use_dedicated_replication_server", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, "use_fips_endpoint"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "replication_configuration_template_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "replication_configuration_template_id",
+			},
+		},
+	})
+}
+
+func testAccReplicationConfigurationTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReplicationConfigurationTemplate
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mgn_replication_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmgn.ResourceReplicationConfigurationTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccReplicationConfigurationTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReplicationConfigurationTemplate
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mgn_replication_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_large_staging_disk_type", "GP3"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_encryption", "DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "replication_server_instance_type", "t3.small"),
+				),
+			},
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "50"),
+					resource.TestCheckResourceAttr(resourceName, "default_large_staging_disk_type", "ST1"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_encryption", "CUSTOM"),
+					resource.TestCheckResourceAttrPair(resourceName, "ebs_encryption_key_arn", "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "replication_server_instance_type", "t3.medium"),
+					resource.TestCheckResourceAttr(resourceName, "staging_area_tags.%", "2"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "replication_configuration_template_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "replication_configuration_template_id",
+			},
+		},
+	})
+}
+
+func testAccReplicationConfigurationTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReplicationConfigurationTemplate
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mgn_replication_configuration_template.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "replication_configuration_template_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "replication_configuration_template_id",
+			},
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReplicationConfigurationTemplateDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mgn_replication_configuration_template" {
+				continue
+			}
+
+			_, err := tfmgn.FindReplicationConfigurationTemplateByID(ctx, conn, rs.Primary.Attributes["replication_configuration_template_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MGN Replication Configuration Template %s still exists", rs.Primary.Attributes["replication_configuration_template_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckReplicationConfigurationTemplateExists(ctx context.Context, t *testing.T, n string, v *awstypes.ReplicationConfigurationTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+		output, err := tfmgn.FindReplicationConfigurationTemplateByID(ctx, conn, rs.Primary.Attributes["replication_configuration_template_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReplicationConfigurationTemplateConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccReplicationConfigurationTemplateConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_mgn_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = 0
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = "GP3"
+  ebs_encryption                          = "DEFAULT"
+  replication_server_instance_type        = "t3.small"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test[0].id
+  use_dedicated_replication_server        = false
+
+  staging_area_tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccReplicationConfigurationTemplateConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_mgn_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = 50
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = "ST1"
+  ebs_encryption                          = "CUSTOM"
+  ebs_encryption_key_arn                  = aws_kms_key.test.arn
+  replication_server_instance_type        = "t3.medium"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test[0].id
+  use_dedicated_replication_server        = false
+
+  staging_area_tags = {
+    Name        = %[1]q
+    Environment = "test"
+  }
+}
+`, rName))
+}
+
+func testAccReplicationConfigurationTemplateConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_mgn_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = 0
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = "GP3"
+  ebs_encryption                          = "DEFAULT"
+  replication_server_instance_type        = "t3.small"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test[0].id
+  use_dedicated_replication_server        = false
+
+  staging_area_tags = {
+    Name = %[1]q
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccReplicationConfigurationTemplateConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_mgn_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = 0
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = "GP3"
+  ebs_encryption                          = "DEFAULT"
+  replication_server_instance_type        = "t3.small"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test[0].id
+  use_dedicated_replication_server        = false
+
+  staging_area_tags = {
+    Name = %[1]q
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_source_server_launch_configuration", name="Source Server Launch Configuration")
+// @IdentityAttribute("source_server_id")
+func newSourceServerLaunchConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &sourceServerLaunchConfigurationResource{}
+
+	return r, nil
+}
+
+type sourceServerLaunchConfigurationResource struct {
+	framework.ResourceWithModel[sourceServerLaunchConfigurationResourceModel]
+	framework.WithImportByIdentity
+	framework.WithNoOpDelete
+}
+
+func (r *sourceServerLaunchConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"boot_mode":               bootModeAttribute(),
+			"copy_private_ip":         optionalComputedBoolAttribute(),
+			"copy_tags":               optionalComputedBoolAttribute(),
+			"ec2_launch_template_id":  ec2LaunchTemplateIDAttribute(),
+			"enable_map_auto_tagging": optionalComputedBoolAttribute(),
+			"launch_disposition":      launchDispositionAttribute(),
+			"map_auto_tagging_mpe_id": mapAutoTaggingMPEIDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_instance_type_right_sizing_method": targetInstanceTypeRightSizingMethodAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"licensing":           licensingBlock(ctx),
+			"post_launch_actions": postLaunchActionsBlock(ctx),
+		},
+	}
+}
+
+func (r *sourceServerLaunchConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data sourceServerLaunchConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	sourceServerID := data.SourceServerID.ValueString()
+	output, err := updateSourceServerLaunchConfiguration(ctx, conn, data)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("source_server_id"), sourceServerID) // Set 'source_server_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("creating MGN Source Server Launch Configuration (%s)", sourceServerID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, data.flattenOptions()...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *sourceServerLaunchConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data sourceServerLaunchConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	sourceServerID := data.SourceServerID.ValueString()
+	output, err := findLaunchConfigurationBySourceServerID(ctx, conn, sourceServerID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MGN Source Server Launch Configuration (%s)", sourceServerID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, data.flattenOptions()...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sourceServerLaunchConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old sourceServerLaunchConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		output, err := updateSourceServerLaunchConfiguration(ctx, conn, new)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MGN Source Server Launch Configuration (%s)", new.SourceServerID.ValueString()), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new, new.flattenOptions()...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func updateSourceServerLaunchConfiguration(ctx context.Context, conn *mgn.Client, data sourceServerLaunchConfigurationResourceModel) (*mgn.UpdateLaunchConfigurationOutput, error) {
+	var input mgn.UpdateLaunchConfigurationInput
+	if diags := fwflex.Expand(ctx, data, &input); diags.HasError() {
+		return nil, fwdiag.DiagnosticsError(diags)
+	}
+
+	return conn.UpdateLaunchConfiguration(ctx, &input)
+}
+
+func findLaunchConfigurationBySourceServerID(ctx context.Context, conn *mgn.Client, id string) (*mgn.GetLaunchConfigurationOutput, error) {
+	input := mgn.GetLaunchConfigurationInput{
+		SourceServerID: aws.String(id),
+	}
+	output, err := conn.GetLaunchConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+type sourceServerLaunchConfigurationResourceModel struct {
+	framework.WithRegionModel
+	BootMode                            fwtypes.StringEnum[awstypes.BootMode]                            `tfsdk:"boot_mode"`
+	CopyPrivateIP                       types.Bool                                                       `tfsdk:"copy_private_ip"`
+	CopyTags                            types.Bool                                                       `tfsdk:"copy_tags"`
+	EC2LaunchTemplateID                 types.String                                                     `tfsdk:"ec2_launch_template_id"`
+	EnableMapAutoTagging                types.Bool                                                       `tfsdk:"enable_map_auto_tagging"`
+	LaunchDisposition                   fwtypes.StringEnum[awstypes.LaunchDisposition]                   `tfsdk:"launch_disposition"`
+	Licensing                           fwtypes.ListNestedObjectValueOf[licensingModel]                  `tfsdk:"licensing"`
+	MapAutoTaggingMPEID                 types.String                                                     `tfsdk:"map_auto_tagging_mpe_id"`
+	Name                                types.String                                                     `tfsdk:"name"`
+	PostLaunchActions                   fwtypes.ListNestedObjectValueOf[postLaunchActionsModel]          `tfsdk:"post_launch_actions"`
+	SourceServerID                      types.String                                                     `tfsdk:"source_server_id"`
+	TargetInstanceTypeRightSizingMethod fwtypes.StringEnum[awstypes.TargetInstanceTypeRightSizingMethod] `tfsdk:"target_instance_type_right_sizing_method"`
+}
+
+// flattenOptions returns AutoFlex options that leave unconfigured blocks untouched.
+func (m sourceServerLaunchConfigurationResourceModel) flattenOptions() []fwflex.AutoFlexOptionsFunc {
+	var opts []fwflex.AutoFlexOptionsFunc
+
+	if m.Licensing.IsNull() {
+		opts = append(opts, fwflex.WithIgnoredFieldNamesAppend("Licensing"))
+	}
+	if m.PostLaunchActions.IsNull() {
+		opts = append(opts, fwflex.WithIgnoredFieldNamesAppend("PostLaunchActions"))
+	}
+
+	return opts
+}
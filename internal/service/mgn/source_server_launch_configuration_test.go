@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Source servers are registered by installing the replication agent on a machine to be migrated,
+// so the tests run against an existing source server.
+const envVarSourceServerID = "MGN_SOURCE_SERVER_ID"
+
+func TestAccMgnSourceServerLaunchConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceServerID := acctest.SkipIfEnvVarNotSet(t, envVarSourceServerID)
+	var v mgn.GetLaunchConfigurationOutput
+	resourceName := "aws_mgn_source_server_launch_configuration.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, "STOPPED", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceServerLaunchConfigurationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_private_ip", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "ec2_launch_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.0.os_byol", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "source_server_id", sourceServerID),
+					resource.TestCheckResourceAttr(resourceName, "target_instance_type_right_sizing_method", "BASIC"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "source_server_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "source_server_id",
+				ImportStateVerifyIgnore:              []string{"licensing"},
+			},
+			{
+				Config: testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, "STARTED", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceServerLaunchConfigurationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STARTED"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.0.os_byol", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSourceServerLaunchConfigurationExists(ctx context.Context, t *testing.T, n string, v *mgn.GetLaunchConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+		output, err := tfmgn.FindLaunchConfigurationBySourceServerID(ctx, conn, rs.Primary.Attributes["source_server_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, launchDisposition string, osBYOL bool) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_source_server_launch_configuration" "test" {
+  source_server_id = %[1]q
+
+  copy_private_ip                          = false
+  copy_tags                                = true
+  launch_disposition                       = %[2]q
+  target_instance_type_right_sizing_method = "BASIC"
+
+  licensing {
+    os_byol = %[3]t
+  }
+}
+`, sourceServerID, launchDisposition, osBYOL)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/semver"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -38,6 +39,7 @@ import (
 )
 
 // @SDKResource("aws_opensearch_domain", name="Domain")
+// @CreationPending
 // @Tags(identifierAttribute="id")
 func resourceDomain() *schema.Resource {
 	return &schema.Resource{
@@ -872,6 +874,14 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
 
 	name := d.Get(names.AttrDomainName).(string)
+
+	// Resume waiting for a create that was interrupted or timed out.
+	if tfretry.CreationPending(ctx) {
+		if err := waitForDomainCreationResumed(ctx, conn, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Domain (%s) create: %s", d.Id(), err)
+		}
+	}
+
 	ds, err := findDomainByName(ctx, conn, name)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -172,6 +172,55 @@ func TestAccOpenSearchDomain_basic(t *testing.T) {
 	})
 }
 
+// TestAccOpenSearchDomain_creationPending verifies that a domain whose create times out is saved to state untainted
+// and that the next refresh resumes waiting for creation to complete.
+func TestAccOpenSearchDomain_creationPending(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var domain1, domain2 awstypes.DomainStatus
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIAMServiceLinkedRole(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_createTimeout(rName, "1m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDomainName, rName),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchClient(ctx)
+
+					if err := tfopensearch.WaitForDomainCreation(ctx, conn, rName, 60*time.Minute); err != nil {
+						t.Fatal(err)
+					}
+				},
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain2),
+					testAccCheckDomainNotRecreated(&domain1, &domain2),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEndpoint),
+					resource.TestMatchResourceAttr(resourceName, "dashboard_endpoint", regexache.MustCompile(`.*(opensearch|es)\..*/_dashboards`)),
+				),
+			},
+			{
+				Config:   testAccDomainConfig_createTimeout(rName, "1m"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccOpenSearchDomain_requireHTTPS(t *testing.T) {
 	ctx := acctest.Context(t)
 	var domain awstypes.DomainStatus
@@ -2571,6 +2620,23 @@ resource "aws_opensearch_domain" "test" {
 `, rName)
 }
 
+func testAccDomainConfig_createTimeout(rName, timeout string) string {
+	return fmt.Sprintf(`
+resource "aws_opensearch_domain" "test" {
+  domain_name = %[1]q
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  timeouts {
+    create = %[2]q
+  }
+}
+`, rName, timeout)
+}
+
 func testAccDomainConfig_ipAddressType(rName, ipAddressType string) string {
 	return fmt.Sprintf(`
 resource "aws_opensearch_domain" "test" {
@@ -52,7 +52,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			}),
-			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Region:          unique.Make(inttypes.ResourceRegionDefault()),
+			CreationPending: true,
 		},
 		{
 			Factory:  resourceDomainPolicy,
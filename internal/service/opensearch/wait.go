@@ -41,24 +41,24 @@ func waitUpgradeSucceeded(ctx context.Context, conn *opensearch.Client, name str
 }
 
 func waitForDomainCreation(ctx context.Context, conn *opensearch.Client, domainName string, timeout time.Duration) error {
-	var out *awstypes.DomainStatus
-	err := tfresource.Retry(ctx, timeout, func(ctx context.Context) *tfresource.RetryError {
-		var err error
-		out, err = findDomainByName(ctx, conn, domainName)
-		if tfresource.NotFound(err) {
-			return tfresource.RetryableError(err)
-		}
-		if err != nil {
-			return tfresource.NonRetryableError(err)
-		}
+	err := tfresource.WaitUntil(ctx, timeout, domainCreated(conn, domainName), tfresource.WaitOpts{
+		Delay:        10 * time.Minute,
+		PollInterval: 10 * time.Second,
+	})
 
-		if !aws.ToBool(out.Processing) && (out.Endpoint != nil || out.Endpoints != nil) {
-			return nil
-		}
+	if err != nil {
+		return fmt.Errorf("waiting for OpenSearch Domain to be created: %w", err)
+	}
 
-		return tfresource.RetryableError(
-			fmt.Errorf("%q: Timeout while waiting for OpenSearch Domain to be created", domainName))
-	}, tfresource.WithDelay(10*time.Minute), tfresource.WithPollInterval(10*time.Second))
+	return nil
+}
+
+// waitForDomainCreationResumed waits for a domain whose create was interrupted to be created.
+// Unlike waitForDomainCreation, there is no initial delay.
+func waitForDomainCreationResumed(ctx context.Context, conn *opensearch.Client, domainName string, timeout time.Duration) error {
+	err := tfresource.WaitUntil(ctx, timeout, domainCreated(conn, domainName), tfresource.WaitOpts{
+		PollInterval: 10 * time.Second,
+	})
 
 	if err != nil {
 		return fmt.Errorf("waiting for OpenSearch Domain to be created: %w", err)
@@ -67,6 +67,22 @@ func waitForDomainCreation(ctx context.Context, conn *opensearch.Client, domainN
 	return nil
 }
 
+func domainCreated(conn *opensearch.Client, domainName string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		out, err := findDomainByName(ctx, conn, domainName)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return !aws.ToBool(out.Processing) && (out.Endpoint != nil || out.Endpoints != nil), nil
+	}
+}
+
 func waitForDomainUpdate(ctx context.Context, conn *opensearch.Client, domainName string, timeout time.Duration) error {
 	var out *awstypes.DomainStatus
 	err := tfresource.Retry(ctx, timeout, func(ctx context.Context) *tfresource.RetryError {
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/authtoken"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_rds_auth_token", name="Auth Token")
+func newAuthTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := net.JoinHostPort(data.Hostname.ValueString(), strconv.FormatInt(data.Port.ValueInt64(), 10))
+	token, err := authtoken.Presign(ctx, e.Meta().CredentialsProvider(ctx), authtoken.Request{
+		Scheme:    "https",
+		Host:      endpoint,
+		Query:     url.Values{"Action": {"connect"}, "DBUser": {data.Username.ValueString()}},
+		Service:   "rds-db",
+		Region:    e.Meta().Region(ctx),
+		ExpiresIn: 15 * time.Minute,
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("generating RDS auth token (%s)", endpoint), err.Error())
+
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(token.Expiration)
+	data.Token = types.StringValue(token.Value)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Hostname   types.String      `tfsdk:"hostname"`
+	Port       types.Int64       `tfsdk:"port"`
+	Token      types.String      `tfsdk:"token"`
+	Username   types.String      `tfsdk:"username"`
+}
@@ -166,3 +166,155 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	return nil
 }
+
+type clusterHandler struct {
+	conn *rds.Client
+}
+
+func newClusterHandler(conn *rds.Client) *clusterHandler {
+	return &clusterHandler{
+		conn: conn,
+	}
+}
+
+func (h *clusterHandler) precondition(ctx context.Context, d *schema.ResourceData) error {
+	needsPreConditions := false
+	input := &rds.ModifyDBClusterInput{
+		ApplyImmediately:    aws.Bool(true),
+		DBClusterIdentifier: aws.String(d.Id()),
+	}
+
+	// Backups must be enabled for Blue/Green Deployments.
+	// Apply any change to the backup retention period first, so that the Green environment is created with it,
+	// as the retention period isn't modified after switchover.
+	if d.HasChange("backup_retention_period") {
+		needsPreConditions = true
+		input.BackupRetentionPeriod = aws.Int32(int32(d.Get("backup_retention_period").(int)))
+	}
+
+	if d.HasChange(names.AttrDeletionProtection) {
+		needsPreConditions = true
+		input.DeletionProtection = aws.Bool(d.Get(names.AttrDeletionProtection).(bool))
+	}
+
+	if needsPreConditions {
+		err := dbClusterModify(ctx, h.conn, d.Id(), input, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("setting pre-conditions: %w", err)
+		}
+	}
+	return nil
+}
+
+// createBlueGreenInput returns the input for a Blue/Green Deployment which applies the
+// engine version and parameter group changes to the Green environment's DB cluster and its DB instances.
+func (h *clusterHandler) createBlueGreenInput(d *schema.ResourceData) *rds.CreateBlueGreenDeploymentInput {
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get(names.AttrARN).(string)),
+	}
+
+	if d.HasChange(names.AttrEngineVersion) {
+		input.TargetEngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+	if d.HasChange("db_instance_parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(d.Get("db_instance_parameter_group_name").(string))
+	}
+
+	return input
+}
+
+// waitForTarget waits for the Green environment's DB cluster and all of its DB instances to become available.
+func (h *clusterHandler) waitForTarget(ctx context.Context, identifier string, timeout time.Duration, operation string) error {
+	log.Printf("[DEBUG] %s: Waiting for Green environment", operation)
+
+	cluster, err := waitDBClusterAvailable(ctx, h.conn, identifier, false, timeout)
+	if err != nil {
+		return fmt.Errorf("waiting for Green environment: %w", err)
+	}
+
+	for _, v := range cluster.DBClusterMembers {
+		instanceID := aws.ToString(v.DBInstanceIdentifier)
+		if _, err := waitDBInstanceAvailable(ctx, h.conn, instanceID, timeout); err != nil {
+			return fmt.Errorf("waiting for Green environment DB Instance (%s): %w", instanceID, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteSource deletes the Blue environment's DB cluster, and all of its DB instances, after switchover.
+func (h *clusterHandler) deleteSource(ctx context.Context, identifier string, timeout time.Duration) error {
+	cluster, err := findDBClusterByID(ctx, h.conn, identifier)
+	if err != nil {
+		return err
+	}
+
+	if aws.ToBool(cluster.DeletionProtection) {
+		input := &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(identifier),
+			DeletionProtection:  aws.Bool(false),
+		}
+
+		if err := dbClusterModify(ctx, h.conn, identifier, input, timeout); err != nil {
+			return fmt.Errorf("disabling deletion protection: %w", err)
+		}
+	}
+
+	// A DB cluster cannot be deleted while it has DB instances.
+	for _, v := range cluster.DBClusterMembers {
+		instanceID := aws.ToString(v.DBInstanceIdentifier)
+		input := &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceID),
+			SkipFinalSnapshot:    aws.Bool(true),
+		}
+
+		_, err := h.conn.DeleteDBInstance(ctx, input)
+
+		if errs.IsA[*types.DBInstanceNotFoundFault](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting DB Instance (%s): %w", instanceID, err)
+		}
+	}
+
+	for _, v := range cluster.DBClusterMembers {
+		instanceID := aws.ToString(v.DBInstanceIdentifier)
+		if _, err := waitDBInstanceDeleted(ctx, h.conn, instanceID, timeout); err != nil {
+			return fmt.Errorf("deleting DB Instance (%s): waiting for completion: %w", instanceID, err)
+		}
+	}
+
+	input := &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(identifier),
+		SkipFinalSnapshot:   aws.Bool(true),
+	}
+
+	const (
+		deleteTimeout = 5 * time.Minute
+	)
+	_, err = tfresource.RetryWhen(ctx, deleteTimeout,
+		func(ctx context.Context) (any, error) {
+			return h.conn.DeleteDBCluster(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.IsA[*types.InvalidDBClusterStateFault](err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if errs.IsA[*types.DBClusterNotFoundFault](err) {
+		return nil
+	}
+
+	return err
+}
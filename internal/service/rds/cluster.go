@@ -5,8 +5,10 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,7 +21,6 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -28,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -42,6 +44,7 @@ const (
 )
 
 // @SDKResource("aws_rds_cluster", name="Cluster")
+// @CreationPending
 // @Tags(identifierAttribute="arn")
 // @Testing(tagsTest=false)
 func resourceCluster() *schema.Resource {
@@ -109,6 +112,19 @@ func resourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"ca_certificate_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -690,6 +706,30 @@ func resourceCluster() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				engine := d.Get(names.AttrEngine).(string)
+				if !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+				return nil
+			},
+			func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				if source := d.Get("replication_source_identifier").(string); source != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "replication_source_identifier" is set.`)
+				}
+				if globalCluster := d.Get("global_cluster_identifier").(string); globalCluster != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+				return nil
+			},
 		),
 	}
 }
@@ -1405,6 +1445,13 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta any
 func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
+	// Resume waiting for a create that was interrupted or timed out.
+	if retry.CreationPending(ctx) {
+		if _, err := waitDBClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) create: %s", d.Id(), err)
+		}
+	}
+
 	dbc, err := findDBClusterByID(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -1573,15 +1620,128 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		}
 	}
 
-	if d.HasChangesExcept(
+	blueGreenUpdated := false
+	if d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(names.AttrEngineVersion, "db_cluster_parameter_group_name", "db_instance_parameter_group_name") {
+		deadline := itypes.NewDeadline(d.Timeout(schema.TimeoutUpdate))
+
+		orchestrator := newBlueGreenOrchestrator(conn)
+		defer orchestrator.CleanUp(ctx)
+
+		handler := newClusterHandler(conn)
+
+		err := handler.precondition(ctx, d)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		createIn := handler.createBlueGreenInput(d)
+
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Creating Blue/Green Deployment", d.Id())
+
+		dep, err := orchestrator.CreateDeployment(ctx, createIn)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		deploymentIdentifier := dep.BlueGreenDeploymentIdentifier
+		defer func() {
+			log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
+
+			if dep == nil {
+				log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment: deployment disappeared", d.Id())
+				return
+			}
+
+			// Ensure that the Blue/Green Deployment is always cleaned up
+			input := &rds.DeleteBlueGreenDeploymentInput{
+				BlueGreenDeploymentIdentifier: deploymentIdentifier,
+			}
+			if aws.ToString(dep.Status) != "SWITCHOVER_COMPLETED" {
+				input.DeleteTarget = aws.Bool(true)
+			}
+
+			_, err = conn.DeleteBlueGreenDeployment(ctx, input)
+
+			if err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: %s", d.Id(), err)
+				return
+			}
+
+			orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+				if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, aws.ToString(deploymentIdentifier), deadline.Remaining(), optFns...); err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: waiting for completion: %s", d.Id(), err)
+				}
+			})
+		}()
+
+		dep, err = orchestrator.waitForDeploymentAvailable(ctx, aws.ToString(dep.BlueGreenDeploymentIdentifier), deadline.Remaining())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		targetARN, err := parseDBClusterARN(aws.ToString(dep.Target))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
+		}
+
+		if err := handler.waitForTarget(ctx, targetARN.Identifier, deadline.Remaining(), fmt.Sprintf("Updating RDS Cluster (%s)", d.Id())); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Switching over Blue/Green Deployment", d.Id())
+
+		dep, err = orchestrator.Switchover(ctx, aws.ToString(dep.BlueGreenDeploymentIdentifier), deadline.Remaining())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment source", d.Id())
+
+		sourceARN, err := parseDBClusterARN(aws.ToString(dep.Source))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+		}
+
+		if err := handler.deleteSource(ctx, sourceARN.Identifier, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+		}
+
+		orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+			if _, err := waitDBClusterDeleted(ctx, conn, sourceARN.Identifier, deadline.Remaining()); err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: waiting for completion: %s", d.Id(), err)
+			}
+		})
+
+		if diags.HasError() {
+			return diags
+		}
+
+		blueGreenUpdated = true
+	}
+
+	except := []string{
 		names.AttrAllowMajorVersionUpgrade,
+		"blue_green_update",
 		"delete_automated_backups",
 		names.AttrFinalSnapshotIdentifier,
 		"global_cluster_identifier",
 		"iam_roles",
 		"replication_source_identifier",
 		"skip_final_snapshot",
-		names.AttrTags, names.AttrTagsAll) {
+		names.AttrTags, names.AttrTagsAll,
+	}
+	if blueGreenUpdated {
+		// These changes have been applied by the Blue/Green Deployment.
+		except = append(except,
+			"backup_retention_period",
+			"db_cluster_parameter_group_name",
+			"db_instance_parameter_group_name",
+			names.AttrDeletionProtection,
+			names.AttrEngineVersion,
+		)
+	}
+
+	if d.HasChangesExcept(except...) {
 		applyImmediately := d.Get(names.AttrApplyImmediately).(bool)
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(applyImmediately),
@@ -1600,7 +1760,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			input.BacktrackWindow = aws.Int64(int64(d.Get("backtrack_window").(int)))
 		}
 
-		if d.HasChange("backup_retention_period") {
+		if d.HasChange("backup_retention_period") && !blueGreenUpdated {
 			input.BackupRetentionPeriod = aws.Int32(int32(d.Get("backup_retention_period").(int)))
 		}
 
@@ -1625,7 +1785,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
 		}
 
-		if d.HasChange("db_cluster_parameter_group_name") {
+		if d.HasChange("db_cluster_parameter_group_name") && !blueGreenUpdated {
 			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 		}
 
@@ -1634,11 +1794,11 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		// set, the configured attribute should always be sent on modify.
 		// Except, this causes an error on a minor version upgrade, so it is
 		// removed during update retry, if necessary.
-		if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
+		if v, ok := d.GetOk("db_instance_parameter_group_name"); (ok || d.HasChange("db_instance_parameter_group_name")) && !blueGreenUpdated {
 			input.DBInstanceParameterGroupName = aws.String(v.(string))
 		}
 
-		if d.HasChange(names.AttrDeletionProtection) {
+		if d.HasChange(names.AttrDeletionProtection) && !blueGreenUpdated {
 			input.DeletionProtection = aws.Bool(d.Get(names.AttrDeletionProtection).(bool))
 		}
 
@@ -1670,14 +1830,14 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			}
 		}
 
-		if d.HasChange(names.AttrEngineVersion) {
+		if d.HasChange(names.AttrEngineVersion) && !blueGreenUpdated {
 			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
 		}
 
 		// This can happen when updates are deferred (apply_immediately = false), and
 		// multiple applies occur before the maintenance window. In this case,
 		// continue sending the desired engine_version as part of the modify request.
-		if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) {
+		if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) && !blueGreenUpdated {
 			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
 		}
 
@@ -2050,6 +2210,68 @@ func clusterSetResourceDataEngineVersionFromCluster(d *schema.ResourceData, c *t
 	compareActualEngineVersion(d, oldVersion, newVersion, pendingVersion)
 }
 
+func dbClusterModify(ctx context.Context, conn *rds.Client, id string, input *rds.ModifyDBClusterInput, timeout time.Duration) error {
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func(ctx context.Context) (any, error) {
+			return conn.ModifyDBCluster(ctx, input)
+		},
+		func(err error) (bool, error) {
+			// Retry for IAM eventual consistency.
+			if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions") {
+				return true, err
+			}
+
+			if errs.IsA[*types.InvalidDBClusterStateFault](err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitDBClusterUpdated(ctx, conn, id, true, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+type dbClusterARN struct {
+	arn.ARN
+	Identifier string
+}
+
+func parseDBClusterARN(s string) (dbClusterARN, error) {
+	arn, err := arn.Parse(s)
+	if err != nil {
+		return dbClusterARN{}, err
+	}
+
+	result := dbClusterARN{
+		ARN: arn,
+	}
+
+	re := regexache.MustCompile(`^cluster:([0-9a-z-]+)$`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if matches == nil || len(matches) != 2 {
+		return dbClusterARN{}, errors.New("DB Cluster ARN: invalid resource section")
+	}
+	result.Identifier = matches[1]
+
+	return result, nil
+}
+
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
+
 func findDBClusterByID(ctx context.Context, conn *rds.Client, id string, optFns ...func(*rds.Options)) (*types.DBCluster, error) {
 	input := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(id),
@@ -2063,14 +2285,10 @@ func findDBClusterByID(ctx context.Context, conn *rds.Client, id string, optFns
 	// Eventual consistency check.
 	if arn.IsARN(id) {
 		if aws.ToString(output.DBClusterArn) != id {
-			return nil, &retry.NotFoundError{
-				LastRequest: input,
-			}
+			return nil, &retry.NotFoundError{}
 		}
 	} else if aws.ToString(output.DBClusterIdentifier) != id {
-		return nil, &retry.NotFoundError{
-			LastRequest: input,
-		}
+		return nil, &retry.NotFoundError{}
 	}
 
 	return output, nil
@@ -2095,8 +2313,7 @@ func findDBClusters(ctx context.Context, conn *rds.Client, input *rds.DescribeDB
 
 		if errs.IsA[*types.DBClusterNotFoundFault](err) {
 			return nil, &retry.NotFoundError{
-				LastError:   err,
-				LastRequest: input,
+				LastError: err,
 			}
 		}
 
@@ -2114,8 +2331,8 @@ func findDBClusters(ctx context.Context, conn *rds.Client, input *rds.DescribeDB
 	return output, nil
 }
 
-func statusDBCluster(ctx context.Context, conn *rds.Client, id string, waitNoPendingModifiedValues bool, optFns ...func(*rds.Options)) retry.StateRefreshFunc {
-	return func() (any, string, error) {
+func statusDBCluster(conn *rds.Client, id string, waitNoPendingModifiedValues bool, optFns ...func(*rds.Options)) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
 		output, err := findDBClusterByID(ctx, conn, id, optFns...)
 
 		if tfresource.NotFound(err) {
@@ -2156,7 +2373,7 @@ func waitDBClusterAvailable(ctx context.Context, conn *rds.Client, id string, wa
 	stateConf := &retry.StateChangeConf{
 		Pending:    pendingStatuses,
 		Target:     []string{clusterStatusAvailable},
-		Refresh:    statusDBCluster(ctx, conn, id, waitNoPendingModifiedValues),
+		Refresh:    statusDBCluster(conn, id, waitNoPendingModifiedValues),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
@@ -2183,7 +2400,7 @@ func waitDBClusterCreated(ctx context.Context, conn *rds.Client, id string, time
 			clusterStatusResettingMasterCredentials,
 		},
 		Target:     []string{clusterStatusAvailable},
-		Refresh:    statusDBCluster(ctx, conn, id, false),
+		Refresh:    statusDBCluster(conn, id, false),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
@@ -2217,7 +2434,7 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.Client, id string, wait
 	stateConf := &retry.StateChangeConf{
 		Pending:    pendingStatuses,
 		Target:     []string{clusterStatusAvailable},
-		Refresh:    statusDBCluster(ctx, conn, id, waitNoPendingModifiedValues),
+		Refresh:    statusDBCluster(conn, id, waitNoPendingModifiedValues),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
@@ -2243,7 +2460,7 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.Client, id string, time
 			clusterStatusScalingCompute,
 		},
 		Target:     []string{},
-		Refresh:    statusDBCluster(ctx, conn, id, false),
+		Refresh:    statusDBCluster(conn, id, false),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
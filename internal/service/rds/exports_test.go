@@ -68,6 +68,7 @@ var (
 	ProxyTargetParseResourceID                 = proxyTargetParseResourceID
 	WaitBlueGreenDeploymentDeleted             = waitBlueGreenDeploymentDeleted
 	WaitBlueGreenDeploymentAvailable           = waitBlueGreenDeploymentAvailable
+	WaitDBClusterCreated                       = waitDBClusterCreated
 	WaitDBInstanceAvailable                    = waitDBInstanceAvailable
 	WaitDBInstanceDeleted                      = waitDBInstanceDeleted
 
@@ -5,7 +5,8 @@ package route53
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -24,7 +25,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -37,6 +37,7 @@ import (
 // @FrameworkResource("aws_route53_records_exclusive", name="Records Exclusive")
 func newRecordsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &recordsExclusiveResource{}
+	r.SetExclusiveCollection(r)
 
 	r.SetDefaultCreateTimeout(45 * time.Minute)
 	r.SetDefaultUpdateTimeout(45 * time.Minute)
@@ -49,8 +50,7 @@ const (
 )
 
 type recordsExclusiveResource struct {
-	framework.ResourceWithModel[recordsExclusiveResourceModel]
-	framework.WithNoOpDelete
+	framework.ResourceWithExclusiveCollection[recordsExclusiveResourceModel, *awstypes.ResourceRecordSet]
 	framework.WithTimeouts
 }
 
@@ -266,150 +266,115 @@ func (r *recordsExclusiveResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
-func (r *recordsExclusiveResource) syncRecordSets(ctx context.Context, plan recordsExclusiveResourceModel, timeout time.Duration) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (r *recordsExclusiveResource) ConfiguredMembers(ctx context.Context, data *recordsExclusiveResourceModel) ([]*awstypes.ResourceRecordSet, diag.Diagnostics) {
+	var recordSets []awstypes.ResourceRecordSet
+	diags := flex.Expand(ctx, data.ResourceRecordSet, &recordSets)
+
+	return tfslices.ApplyToAll(recordSets, func(v awstypes.ResourceRecordSet) *awstypes.ResourceRecordSet { return &v }), diags
+}
+
+func (r *recordsExclusiveResource) SetMembers(ctx context.Context, data *recordsExclusiveResourceModel, recordSets []*awstypes.ResourceRecordSet) diag.Diagnostics {
+	return flex.Flatten(
+		ctx,
+		struct {
+			ResourceRecordSets []awstypes.ResourceRecordSet
+		}{
+			ResourceRecordSets: tfslices.Values(recordSets),
+		},
+		data)
+}
+
+func (r *recordsExclusiveResource) ErrorSummary(data *recordsExclusiveResourceModel, action string, err error) string {
+	return create.ProblemStandardMessage(names.Route53, action, ResNameRecordsExclusive, data.ZoneID.String(), err)
+}
+
+func (r *recordsExclusiveResource) Timeouts(data *recordsExclusiveResourceModel) timeouts.Value {
+	return data.Timeouts
+}
+
+func (r *recordsExclusiveResource) ListMembers(ctx context.Context, data *recordsExclusiveResourceModel) ([]*awstypes.ResourceRecordSet, error) {
 	conn := r.Meta().Route53Client(ctx)
 
-	have, err := findResourceRecordSetsForHostedZone(ctx, conn, plan.ZoneID.ValueString())
+	recordSets, err := findResourceRecordSetsForHostedZone(ctx, conn, data.ZoneID.ValueString())
 	if err != nil {
-		diags.AddError(
-			create.ProblemStandardMessage(names.Route53, "Syncronizing", ResNameRecordsExclusive, plan.ZoneID.String(), err),
-			err.Error(),
-		)
-		return diags
+		return nil, err
 	}
 
-	var want []awstypes.ResourceRecordSet
-	diags.Append(flex.Expand(ctx, plan.ResourceRecordSet, &want)...)
-	if diags.HasError() {
-		return diags
-	}
+	return tfslices.ApplyToAll(recordSets, func(v awstypes.ResourceRecordSet) *awstypes.ResourceRecordSet { return &v }), nil
+}
+
+func (r *recordsExclusiveResource) DiffMembers(have, want []*awstypes.ResourceRecordSet) ([]*awstypes.ResourceRecordSet, []*awstypes.ResourceRecordSet) {
+	add, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 *awstypes.ResourceRecordSet) bool { return resourceRecordSetEqual(*s1, *s2) })
+
+	return add, remove
+}
+
+func (r *recordsExclusiveResource) AddMembers(ctx context.Context, data *recordsExclusiveResourceModel, recordSets []*awstypes.ResourceRecordSet) error {
+	return r.ChangeMembers(ctx, data, recordSets, nil)
+}
+
+func (r *recordsExclusiveResource) RemoveMembers(ctx context.Context, data *recordsExclusiveResourceModel, recordSets []*awstypes.ResourceRecordSet) error {
+	return r.ChangeMembers(ctx, data, nil, recordSets)
+}
+
+func (r *recordsExclusiveResource) ChangeMembers(ctx context.Context, data *recordsExclusiveResourceModel, add, remove []*awstypes.ResourceRecordSet) error {
+	conn := r.Meta().Route53Client(ctx)
 
 	// Amazon Route 53 can update an existing resource record set only when all
 	// of the following values match: Name, Type and SetIdentifier.
 	// Ref: http://docs.aws.amazon.com/Route53/latest/APIReference/API_ChangeResourceRecordSets.html.
-	add, remove, modify, _ := intflex.DiffSlicesWithModify(have, want, resourceRecordSetEqual, resourceRecordSetIdentifiersEqual)
+	identifiersEqual := func(s1 *awstypes.ResourceRecordSet) func(*awstypes.ResourceRecordSet) bool {
+		return func(s2 *awstypes.ResourceRecordSet) bool { return resourceRecordSetIdentifiersEqual(*s1, *s2) }
+	}
 
 	var changes []awstypes.Change
-	for _, r := range remove {
+	for _, v := range remove {
+		if slices.ContainsFunc(add, identifiersEqual(v)) {
+			continue
+		}
+
 		changes = append(changes, awstypes.Change{
 			Action:            awstypes.ChangeActionDelete,
-			ResourceRecordSet: &r,
+			ResourceRecordSet: v,
 		})
 	}
-	for _, r := range add {
-		changes = append(changes, awstypes.Change{
-			Action:            awstypes.ChangeActionCreate,
-			ResourceRecordSet: &r,
-		})
-	}
-	for _, r := range modify {
-		changes = append(changes, awstypes.Change{
-			Action:            awstypes.ChangeActionUpsert,
-			ResourceRecordSet: &r,
-		})
-	}
-
-	if len(changes) > 0 {
-		input := route53.ChangeResourceRecordSetsInput{
-			HostedZoneId: plan.ZoneID.ValueStringPointer(),
-			ChangeBatch: &awstypes.ChangeBatch{
-				Changes: changes,
-			},
-		}
-		out, err := conn.ChangeResourceRecordSets(ctx, &input)
-		if err != nil {
-			diags.AddError(
-				create.ProblemStandardMessage(names.Route53, create.ErrActionCreating, ResNameRecordsExclusive, plan.ZoneID.String(), err),
-				err.Error(),
-			)
-			return diags
-		}
-		if out == nil || out.ChangeInfo == nil || out.ChangeInfo.Id == nil {
-			diags.AddError(
-				create.ProblemStandardMessage(names.Route53, create.ErrActionCreating, ResNameRecordsExclusive, plan.ZoneID.String(), nil),
-				errors.New("empty output").Error(),
-			)
-			return diags
+	for _, v := range add {
+		action := awstypes.ChangeActionCreate
+		if slices.ContainsFunc(remove, identifiersEqual(v)) {
+			action = awstypes.ChangeActionUpsert
 		}
 
-		if _, err := waitChangeInsync(ctx, conn, aws.ToString(out.ChangeInfo.Id), timeout); err != nil {
-			diags.AddError(
-				create.ProblemStandardMessage(names.Route53, create.ErrActionWaitingForCreation, ResNameRecordsExclusive, plan.ZoneID.String(), nil),
-				err.Error(),
-			)
-			return diags
-		}
-	}
-
-	return diags
-}
-
-func (r *recordsExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan recordsExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(r.syncRecordSets(ctx, plan, r.CreateTimeout(ctx, plan.Timeouts))...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
-}
-
-func (r *recordsExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state recordsExclusiveResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
+		changes = append(changes, awstypes.Change{
+			Action:            action,
+			ResourceRecordSet: v,
+		})
 	}
 
-	conn := r.Meta().Route53Client(ctx)
-	output, err := findResourceRecordSetsForHostedZone(ctx, conn, state.ZoneID.ValueString())
-	if tfresource.NotFound(err) {
-		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
-		resp.State.RemoveResource(ctx)
-		return
+	input := route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: data.ZoneID.ValueStringPointer(),
+		ChangeBatch: &awstypes.ChangeBatch{
+			Changes: changes,
+		},
 	}
+	output, err := conn.ChangeResourceRecordSets(ctx, &input)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.Route53, create.ErrActionReading, ResNameRecordsExclusive, state.ZoneID.String(), err),
-			err.Error(),
-		)
-		return
+		return err
 	}
 
-	resp.Diagnostics.Append(flex.Flatten(
-		ctx,
-		struct {
-			ResourceRecordSets []awstypes.ResourceRecordSet
-		}{
-			ResourceRecordSets: output,
-		},
-		&state)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if output == nil || output.ChangeInfo == nil || output.ChangeInfo.Id == nil {
+		return tfresource.NewEmptyResultError(input)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
-
-func (r *recordsExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan recordsExclusiveResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
+	timeout := r.UpdateTimeout(ctx, data.Timeouts)
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
 	}
 
-	resp.Diagnostics.Append(r.syncRecordSets(ctx, plan, r.UpdateTimeout(ctx, plan.Timeouts))...)
-	if resp.Diagnostics.HasError() {
-		return
+	if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id), timeout); err != nil {
+		return fmt.Errorf("waiting for Route 53 Hosted Zone (%s) records sync: %w", data.ZoneID.ValueString(), err)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	return nil
 }
 
 func (r *recordsExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -569,6 +569,11 @@ func stateMachineDefinitionValidate(ctx context.Context, d *schema.ResourceDiff,
 			return nil
 		}
 
+		// Catch structural problems locally before calling the API.
+		if err := validateStateMachineDefinition(definition); err != nil {
+			return fmt.Errorf("invalid Step Functions State Machine definition: %w", err)
+		}
+
 		input := &sfn.ValidateStateMachineDefinitionInput{
 			Definition: aws.String(definition),
 			Type:       awstypes.StateMachineType(d.Get(names.AttrType).(string)),
@@ -0,0 +1,649 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Local validation of Amazon States Language (ASL) state machine definitions.
+// See https://states-language.net/spec.html and https://docs.aws.amazon.com/step-functions/latest/dg/concepts-amazon-states-language.html.
+
+const (
+	queryLanguageJSONata  = "JSONata"
+	queryLanguageJSONPath = "JSONPath"
+)
+
+func queryLanguage_Values() []string {
+	return []string{
+		queryLanguageJSONata,
+		queryLanguageJSONPath,
+	}
+}
+
+const (
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+)
+
+func stateType_Values() []string {
+	return []string{
+		stateTypeChoice,
+		stateTypeFail,
+		stateTypeMap,
+		stateTypeParallel,
+		stateTypePass,
+		stateTypeSucceed,
+		stateTypeTask,
+		stateTypeWait,
+	}
+}
+
+const (
+	stateNameMaxLength = 80
+)
+
+var (
+	// Fields that are only valid when the state's query language is JSONPath.
+	jsonPathOnlyFields = []string{
+		"InputPath",
+		"ItemsPath",
+		"OutputPath",
+		"Parameters",
+		"ResultPath",
+		"ResultSelector",
+		"SecondsPath",
+		"TimestampPath",
+	}
+	// Fields that are only valid when the state's query language is JSONata.
+	jsonataOnlyFields = []string{
+		"Arguments",
+		"Output",
+	}
+	// State fields whose values can contain JSONata expressions.
+	jsonataFields = []string{
+		"Arguments",
+		"Assign",
+		"Cause",
+		"Error",
+		"HeartbeatSeconds",
+		"ItemBatcher",
+		"ItemReader",
+		"Items",
+		"MaxConcurrency",
+		"Output",
+		"ResultWriter",
+		"Seconds",
+		"TimeoutSeconds",
+		"Timestamp",
+		"ToleratedFailureCount",
+		"ToleratedFailurePercentage",
+	}
+	// Choice rule and catcher fields whose values can contain JSONata expressions.
+	jsonataRuleFields = []string{
+		"Assign",
+		"Condition",
+		"Output",
+	}
+)
+
+// validateStateMachineDefinition validates an ASL state machine definition without calling the AWS API.
+// All problems found are returned.
+func validateStateMachineDefinition(definition string) error {
+	var v any
+	if err := json.Unmarshal([]byte(definition), &v); err != nil {
+		return fmt.Errorf("parsing JSON: %w", err)
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return errors.New("definition must be a JSON object")
+	}
+
+	var validator aslValidator
+	validator.stateMachine("", m, queryLanguageJSONPath)
+
+	return errors.Join(validator.errs...)
+}
+
+type aslValidator struct {
+	errs []error
+}
+
+func (v *aslValidator) errorf(path, format string, a ...any) {
+	if path == "" {
+		path = "/"
+	}
+	v.errs = append(v.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, a...)))
+}
+
+// stateMachine validates a top-level state machine, a Parallel state branch or a Map state item processor.
+func (v *aslValidator) stateMachine(path string, m map[string]any, queryLanguage string) {
+	queryLanguage = v.queryLanguage(path, m, queryLanguage)
+
+	startAt, ok := m["StartAt"].(string)
+	if !ok {
+		v.errorf(path, `missing required string field "StartAt"`)
+	}
+
+	states, ok := m["States"].(map[string]any)
+	if !ok || len(states) == 0 {
+		v.errorf(path, `missing required non-empty object field "States"`)
+		return
+	}
+
+	if startAt != "" {
+		if _, ok := states[startAt]; !ok {
+			v.errorf(path+"/StartAt", "state %q does not exist", startAt)
+		}
+	}
+
+	names := slices.Sorted(maps.Keys(states))
+
+	hasTerminalState := false
+	transitions := make(map[string][]string, len(states))
+	for _, name := range names {
+		statePath := path + "/States/" + name
+
+		state, ok := states[name].(map[string]any)
+		if !ok {
+			v.errorf(statePath, "state must be a JSON object")
+			continue
+		}
+
+		terminal, next := v.state(statePath, name, state, states, queryLanguage)
+		if terminal {
+			hasTerminalState = true
+		}
+		transitions[name] = next
+	}
+
+	if !hasTerminalState {
+		v.errorf(path+"/States", `no terminal state; at least one state must be of type "Succeed" or "Fail", or have "End" set to true`)
+	}
+
+	if _, ok := states[startAt]; ok {
+		reachable := map[string]bool{startAt: true}
+		queue := []string{startAt}
+		for len(queue) > 0 {
+			name := queue[0]
+			queue = queue[1:]
+			for _, next := range transitions[name] {
+				if _, ok := states[next]; ok && !reachable[next] {
+					reachable[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+
+		for _, name := range names {
+			if !reachable[name] {
+				v.errorf(path+"/States/"+name, "state is not reachable from %q", startAt)
+			}
+		}
+	}
+}
+
+// state validates a single state, returning whether the state is terminal and the names of the states it may transition to.
+func (v *aslValidator) state(path, name string, m map[string]any, states map[string]any, queryLanguage string) (bool, []string) {
+	var next []string
+
+	if len(name) == 0 || len(name) > stateNameMaxLength {
+		v.errorf(path, "state name must be between 1 and %d characters", stateNameMaxLength)
+	}
+
+	typ, ok := m["Type"].(string)
+	if !ok {
+		v.errorf(path, `missing required string field "Type"`)
+		return false, nil
+	}
+	if !slices.Contains(stateType_Values(), typ) {
+		v.errorf(path+"/Type", "invalid state type %q, expected one of %s", typ, strings.Join(stateType_Values(), ", "))
+		return false, nil
+	}
+
+	queryLanguage = v.queryLanguage(path, m, queryLanguage)
+
+	switch queryLanguage {
+	case queryLanguageJSONata:
+		for _, field := range jsonPathOnlyFields {
+			if _, ok := m[field]; ok {
+				v.errorf(path+"/"+field, "field is not supported when the query language is %s", queryLanguageJSONata)
+			}
+		}
+	case queryLanguageJSONPath:
+		for _, field := range jsonataOnlyFields {
+			if _, ok := m[field]; ok {
+				v.errorf(path+"/"+field, "field is not supported when the query language is %s", queryLanguageJSONPath)
+			}
+		}
+	}
+
+	// Transitions.
+	terminal := false
+	nextState, hasNext := m["Next"]
+	end, hasEnd := m["End"]
+	switch typ {
+	case stateTypeChoice, stateTypeFail, stateTypeSucceed:
+		if hasNext {
+			v.errorf(path+"/Next", "field is not supported for %s states", typ)
+		}
+		if hasEnd {
+			v.errorf(path+"/End", "field is not supported for %s states", typ)
+		}
+		terminal = typ != stateTypeChoice
+	default:
+		if hasEnd {
+			if b, ok := end.(bool); !ok {
+				v.errorf(path+"/End", "field must be a boolean")
+			} else {
+				terminal = b
+			}
+		}
+
+		switch {
+		case hasNext && terminal:
+			v.errorf(path, `exactly one of "Next" or "End" must be set`)
+		case !hasNext && !terminal:
+			v.errorf(path, `one of "Next" or "End" must be set`)
+		case hasNext:
+			if s, ok := v.transition(path+"/Next", nextState, states); ok {
+				next = append(next, s)
+			}
+		}
+	}
+
+	// Type-specific fields.
+	switch typ {
+	case stateTypeChoice:
+		choices, ok := m["Choices"].([]any)
+		if !ok || len(choices) == 0 {
+			v.errorf(path, `missing required non-empty array field "Choices"`)
+		}
+		for i, choice := range choices {
+			choicePath := fmt.Sprintf("%s/Choices/%d", path, i)
+
+			rule, ok := choice.(map[string]any)
+			if !ok {
+				v.errorf(choicePath, "choice rule must be a JSON object")
+				continue
+			}
+
+			if s, ok := v.transition(choicePath+"/Next", rule["Next"], states); ok {
+				next = append(next, s)
+			}
+
+			switch queryLanguage {
+			case queryLanguageJSONata:
+				if _, ok := rule["Condition"]; !ok {
+					v.errorf(choicePath, `missing required field "Condition"`)
+				}
+			case queryLanguageJSONPath:
+				if _, ok := rule["Condition"]; ok {
+					v.errorf(choicePath+"/Condition", "field is not supported when the query language is %s", queryLanguageJSONPath)
+				}
+				v.choiceRulePaths(choicePath, rule)
+			}
+		}
+
+		if defaultState, ok := m["Default"]; ok {
+			if s, ok := v.transition(path+"/Default", defaultState, states); ok {
+				next = append(next, s)
+			}
+		}
+	case stateTypeMap:
+		processor, ok := m["ItemProcessor"].(map[string]any)
+		field := "ItemProcessor"
+		if !ok {
+			processor, ok = m["Iterator"].(map[string]any)
+			field = "Iterator"
+		}
+		if !ok {
+			v.errorf(path, `missing required object field "ItemProcessor"`)
+		} else {
+			v.stateMachine(path+"/"+field, processor, queryLanguage)
+		}
+	case stateTypeParallel:
+		branches, ok := m["Branches"].([]any)
+		if !ok || len(branches) == 0 {
+			v.errorf(path, `missing required non-empty array field "Branches"`)
+		}
+		for i, branch := range branches {
+			branchPath := fmt.Sprintf("%s/Branches/%d", path, i)
+
+			if branch, ok := branch.(map[string]any); ok {
+				v.stateMachine(branchPath, branch, queryLanguage)
+			} else {
+				v.errorf(branchPath, "branch must be a JSON object")
+			}
+		}
+	case stateTypeTask:
+		if _, ok := m["Resource"].(string); !ok {
+			v.errorf(path, `missing required string field "Resource"`)
+		}
+	case stateTypeWait:
+		n := 0
+		for _, field := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := m[field]; ok {
+				n++
+			}
+		}
+		if n != 1 {
+			v.errorf(path, `exactly one of "Seconds", "SecondsPath", "Timestamp" or "TimestampPath" must be set`)
+		}
+	}
+
+	// Error handling.
+	if retriers, ok := m["Retry"]; ok {
+		if typ != stateTypeTask && typ != stateTypeParallel && typ != stateTypeMap {
+			v.errorf(path+"/Retry", "field is not supported for %s states", typ)
+		}
+		v.errorHandlers(path+"/Retry", retriers, nil)
+	}
+	if catchers, ok := m["Catch"]; ok {
+		if typ != stateTypeTask && typ != stateTypeParallel && typ != stateTypeMap {
+			v.errorf(path+"/Catch", "field is not supported for %s states", typ)
+		}
+		next = append(next, v.errorHandlers(path+"/Catch", catchers, states)...)
+	}
+
+	// Paths, payload templates and expressions.
+	switch queryLanguage {
+	case queryLanguageJSONata:
+		for _, field := range jsonataFields {
+			if value, ok := m[field]; ok {
+				v.jsonataExpressions(path+"/"+field, value)
+			}
+		}
+		for _, field := range []string{"Catch", "Choices"} {
+			rules, _ := m[field].([]any)
+			for i, rule := range rules {
+				rule, ok := rule.(map[string]any)
+				if !ok {
+					continue
+				}
+				for _, k := range jsonataRuleFields {
+					if value, ok := rule[k]; ok {
+						v.jsonataExpressions(fmt.Sprintf("%s/%s/%d/%s", path, field, i, k), value)
+					}
+				}
+			}
+		}
+	case queryLanguageJSONPath:
+		for _, field := range []string{"InputPath", "ItemsPath", "OutputPath", "ResultPath", "SecondsPath", "TimestampPath"} {
+			if value, ok := m[field]; ok {
+				v.referencePath(path+"/"+field, value, field == "InputPath" || field == "OutputPath" || field == "ResultPath")
+			}
+		}
+		for _, field := range []string{"ItemSelector", "Parameters", "ResultSelector"} {
+			if value, ok := m[field]; ok {
+				v.payloadTemplate(path+"/"+field, value)
+			}
+		}
+	}
+
+	return terminal, next
+}
+
+// queryLanguage returns the query language in effect for a state machine or state.
+func (v *aslValidator) queryLanguage(path string, m map[string]any, inherited string) string {
+	value, ok := m["QueryLanguage"]
+	if !ok {
+		return inherited
+	}
+
+	queryLanguage, ok := value.(string)
+	if !ok || !slices.Contains(queryLanguage_Values(), queryLanguage) {
+		v.errorf(path+"/QueryLanguage", "invalid query language %v, expected one of %s", value, strings.Join(queryLanguage_Values(), ", "))
+		return inherited
+	}
+
+	if inherited == queryLanguageJSONata && queryLanguage == queryLanguageJSONPath {
+		v.errorf(path+"/QueryLanguage", "query language cannot be %s when the enclosing query language is %s", queryLanguageJSONPath, queryLanguageJSONata)
+		return inherited
+	}
+
+	return queryLanguage
+}
+
+// transition validates a reference to another state, returning the referenced state's name.
+func (v *aslValidator) transition(path string, value any, states map[string]any) (string, bool) {
+	name, ok := value.(string)
+	if !ok {
+		v.errorf(path, "missing required state name")
+		return "", false
+	}
+
+	if _, ok := states[name]; !ok {
+		v.errorf(path, "state %q does not exist", name)
+		return "", false
+	}
+
+	return name, true
+}
+
+// errorHandlers validates Retry or Catch fields, returning the names of any states transitioned to.
+// Catchers are validated when states is non-nil.
+func (v *aslValidator) errorHandlers(path string, value any, states map[string]any) []string {
+	var next []string
+
+	handlers, ok := value.([]any)
+	if !ok {
+		v.errorf(path, "field must be an array")
+		return nil
+	}
+
+	for i, handler := range handlers {
+		handlerPath := fmt.Sprintf("%s/%d", path, i)
+
+		m, ok := handler.(map[string]any)
+		if !ok {
+			v.errorf(handlerPath, "field must be a JSON object")
+			continue
+		}
+
+		if errorEquals, ok := m["ErrorEquals"].([]any); !ok || len(errorEquals) == 0 {
+			v.errorf(handlerPath, `missing required non-empty array field "ErrorEquals"`)
+		}
+
+		if states != nil {
+			if s, ok := v.transition(handlerPath+"/Next", m["Next"], states); ok {
+				next = append(next, s)
+			}
+		}
+	}
+
+	return next
+}
+
+// choiceRulePaths validates the JSONPath fields of a Choice state rule, including nested And, Or and Not rules.
+func (v *aslValidator) choiceRulePaths(path string, rule map[string]any) {
+	for _, field := range slices.Sorted(maps.Keys(rule)) {
+		value := rule[field]
+		switch {
+		case field == "Variable" || strings.HasSuffix(field, "Path"):
+			v.referencePath(path+"/"+field, value, false)
+		case field == "And" || field == "Or":
+			if rules, ok := value.([]any); ok {
+				for i, rule := range rules {
+					if rule, ok := rule.(map[string]any); ok {
+						v.choiceRulePaths(fmt.Sprintf("%s/%s/%d", path, field, i), rule)
+					}
+				}
+			}
+		case field == "Not":
+			if rule, ok := value.(map[string]any); ok {
+				v.choiceRulePaths(path+"/"+field, rule)
+			}
+		}
+	}
+}
+
+// referencePath validates a JSONPath field value.
+func (v *aslValidator) referencePath(path string, value any, nullable bool) {
+	if value == nil && nullable {
+		return
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		v.errorf(path, "field must be a JSONPath string")
+		return
+	}
+
+	if err := validateJSONPath(s); err != nil {
+		v.errorf(path, "invalid JSONPath %q: %s", s, err)
+	}
+}
+
+// payloadTemplate validates a JSONPath payload template, where the values of fields whose names end in ".$"
+// must be JSONPaths or intrinsic functions.
+func (v *aslValidator) payloadTemplate(path string, value any) {
+	switch value := value.(type) {
+	case map[string]any:
+		for _, k := range slices.Sorted(maps.Keys(value)) {
+			value, fieldPath := value[k], path+"/"+k
+			if !strings.HasSuffix(k, ".$") {
+				v.payloadTemplate(fieldPath, value)
+				continue
+			}
+
+			s, ok := value.(string)
+			if !ok {
+				v.errorf(fieldPath, "field must be a JSONPath or intrinsic function string")
+				continue
+			}
+
+			if strings.HasPrefix(s, "States.") {
+				if err := validateIntrinsicFunction(s); err != nil {
+					v.errorf(fieldPath, "invalid intrinsic function %q: %s", s, err)
+				}
+			} else if err := validateJSONPath(s); err != nil {
+				v.errorf(fieldPath, "invalid JSONPath %q: %s", s, err)
+			}
+		}
+	case []any:
+		for i, value := range value {
+			v.payloadTemplate(fmt.Sprintf("%s/%d", path, i), value)
+		}
+	}
+}
+
+// jsonataExpressions validates any JSONata expressions in a field value.
+func (v *aslValidator) jsonataExpressions(path string, value any) {
+	switch value := value.(type) {
+	case string:
+		if err := validateJSONataExpression(value); err != nil {
+			v.errorf(path, "invalid JSONata expression %q: %s", value, err)
+		}
+	case map[string]any:
+		for _, k := range slices.Sorted(maps.Keys(value)) {
+			v.jsonataExpressions(path+"/"+k, value[k])
+		}
+	case []any:
+		for i, value := range value {
+			v.jsonataExpressions(fmt.Sprintf("%s/%d", path, i), value)
+		}
+	}
+}
+
+// validateJSONPath validates the syntax of a JSONPath reference, e.g. "$.detail.items[0]" or "$$.Execution.Id".
+func validateJSONPath(s string) error {
+	if !strings.HasPrefix(s, "$") {
+		return errors.New(`must begin with "$"`)
+	}
+	if strings.HasSuffix(s, ".") && !strings.HasSuffix(s, "..") {
+		return errors.New(`must not end with "."`)
+	}
+
+	return validateBalanced(strings.TrimPrefix(strings.TrimPrefix(s, "$"), "$"))
+}
+
+// validateIntrinsicFunction validates the syntax of an intrinsic function, e.g. "States.Format('{}', $.name)".
+func validateIntrinsicFunction(s string) error {
+	open := strings.IndexByte(s, '(')
+	if open == -1 || !strings.HasSuffix(s, ")") {
+		return errors.New("must be of the form States.Function(arguments)")
+	}
+
+	return validateBalanced(s[open:])
+}
+
+// validateJSONataExpression validates the syntax of any JSONata expression in a string, e.g. "{% $states.input.name %}".
+func validateJSONataExpression(s string) error {
+	trimmed := strings.TrimSpace(s)
+	hasPrefix, hasSuffix := strings.HasPrefix(trimmed, "{%"), strings.HasSuffix(trimmed, "%}")
+
+	switch {
+	case !hasPrefix && !hasSuffix:
+		return nil
+	case !hasPrefix:
+		return errors.New(`must begin with "{%"`)
+	case !hasSuffix || len(trimmed) < len("{%%}"):
+		return errors.New(`must end with "%}"`)
+	}
+
+	expression := strings.TrimSpace(trimmed[len("{%") : len(trimmed)-len("%}")])
+	if expression == "" {
+		return errors.New("expression is empty")
+	}
+
+	return validateBalanced(expression)
+}
+
+// validateBalanced checks that brackets, braces and parentheses are balanced and that quoted strings are terminated.
+func validateBalanced(s string) error {
+	var stack []rune
+	var quote rune
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+			continue
+		case r == '\\':
+			escaped = true
+			continue
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		switch r {
+		case '\'', '"', '`':
+			quote = r
+		case '(', '[', '{':
+			stack = append(stack, r)
+		case ')', ']', '}':
+			open := map[rune]rune{')': '(', ']': '[', '}': '{'}[r]
+			if len(stack) == 0 || stack[len(stack)-1] != open {
+				return fmt.Errorf("unexpected %q", r)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if quote != 0 {
+		return fmt.Errorf("unterminated string starting with %q", quote)
+	}
+	if len(stack) > 0 {
+		return fmt.Errorf("unclosed %q", stack[len(stack)-1])
+	}
+
+	return nil
+}
@@ -0,0 +1,552 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_sfn_state_machine_definition", name="State Machine Definition")
+// @Region(overrideEnabled=false)
+func newStateMachineDefinitionDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &stateMachineDefinitionDataSource{}, nil
+}
+
+type stateMachineDefinitionDataSource struct {
+	framework.DataSourceWithModel[stateMachineDefinitionDataSourceModel]
+}
+
+func (d *stateMachineDefinitionDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	errorEqualsAttribute := schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringType,
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+	}
+	jsonAttribute := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				fwvalidators.JSON(),
+			},
+		}
+	}
+	queryLanguageAttribute := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(queryLanguage_Values()...),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			"query_language": queryLanguageAttribute(),
+			"start_at": schema.StringAttribute{
+				Required: true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"state": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[stateMachineDefinitionStateModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"arguments": jsonAttribute(),
+						"assign":    jsonAttribute(),
+						"branches": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(fwvalidators.JSON()),
+							},
+						},
+						"cause": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrComment: schema.StringAttribute{
+							Optional: true,
+						},
+						"default": schema.StringAttribute{
+							Optional: true,
+						},
+						"end": schema.BoolAttribute{
+							Optional: true,
+						},
+						"error": schema.StringAttribute{
+							Optional: true,
+						},
+						"heartbeat_seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"input_path": schema.StringAttribute{
+							Optional: true,
+						},
+						"item_processor": jsonAttribute(),
+						"item_selector":  jsonAttribute(),
+						"items_path": schema.StringAttribute{
+							Optional: true,
+						},
+						"max_concurrency": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, stateNameMaxLength),
+							},
+						},
+						"next": schema.StringAttribute{
+							Optional: true,
+						},
+						"output": jsonAttribute(),
+						"output_path": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrParameters: jsonAttribute(),
+						"query_language":     queryLanguageAttribute(),
+						"resource": schema.StringAttribute{
+							Optional: true,
+						},
+						"result": jsonAttribute(),
+						"result_path": schema.StringAttribute{
+							Optional: true,
+						},
+						"result_selector": jsonAttribute(),
+						"seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"seconds_path": schema.StringAttribute{
+							Optional: true,
+						},
+						"timeout_seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"timestamp": schema.StringAttribute{
+							Optional: true,
+						},
+						"timestamp_path": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(stateType_Values()...),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"catch": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[stateMachineDefinitionCatcherModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"error_equals": errorEqualsAttribute,
+									"next": schema.StringAttribute{
+										Required: true,
+									},
+									"output": jsonAttribute(),
+									"result_path": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"choice": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[stateMachineDefinitionChoiceModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCondition: schema.StringAttribute{
+										Optional: true,
+									},
+									"next": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrRule: jsonAttribute(),
+								},
+							},
+						},
+						"retry": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[stateMachineDefinitionRetrierModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"backoff_rate": schema.Float64Attribute{
+										Optional: true,
+									},
+									"error_equals": errorEqualsAttribute,
+									"interval_seconds": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									"jitter_strategy": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("FULL", "NONE"),
+										},
+									},
+									"max_attempts": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+									"max_delay_seconds": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *stateMachineDefinitionDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data stateMachineDefinitionDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	definition, diags := expandStateMachineDefinition(ctx, data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bytes, err := json.MarshalIndent(definition, "", "  ")
+
+	if err != nil {
+		response.Diagnostics.AddError("Marshalling state machine definition to JSON", err.Error())
+
+		return
+	}
+
+	if err := validateStateMachineDefinition(string(bytes)); err != nil {
+		response.Diagnostics.AddError("Invalid state machine definition", err.Error())
+
+		return
+	}
+
+	data.JSON = types.StringValue(string(bytes))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func expandStateMachineDefinition(ctx context.Context, data stateMachineDefinitionDataSourceModel) (*stateMachineDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	states, d := data.States.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject := &stateMachineDefinition{
+		Comment:        data.Comment.ValueString(),
+		QueryLanguage:  data.QueryLanguage.ValueString(),
+		StartAt:        data.StartAt.ValueString(),
+		States:         make(map[string]*stateMachineDefinitionState, len(states)),
+		TimeoutSeconds: data.TimeoutSeconds.ValueInt64Pointer(),
+		Version:        data.Version.ValueString(),
+	}
+
+	for _, v := range states {
+		name := v.Name.ValueString()
+		if _, ok := apiObject.States[name]; ok {
+			diags.AddError("Invalid state machine definition", fmt.Sprintf("duplicate state name %q", name))
+			continue
+		}
+
+		state, d := expandStateMachineDefinitionState(ctx, v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.States[name] = state
+	}
+
+	return apiObject, diags
+}
+
+func expandStateMachineDefinitionState(ctx context.Context, tfObject *stateMachineDefinitionStateModel) (*stateMachineDefinitionState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiObject := &stateMachineDefinitionState{
+		Arguments:        jsonRawMessageValue(tfObject.Arguments),
+		Assign:           jsonRawMessageValue(tfObject.Assign),
+		Cause:            tfObject.Cause.ValueString(),
+		Comment:          tfObject.Comment.ValueString(),
+		Default:          tfObject.Default.ValueString(),
+		Error:            tfObject.Error.ValueString(),
+		HeartbeatSeconds: tfObject.HeartbeatSeconds.ValueInt64Pointer(),
+		InputPath:        tfObject.InputPath.ValueStringPointer(),
+		ItemProcessor:    jsonRawMessageValue(tfObject.ItemProcessor),
+		ItemSelector:     jsonRawMessageValue(tfObject.ItemSelector),
+		ItemsPath:        tfObject.ItemsPath.ValueString(),
+		MaxConcurrency:   tfObject.MaxConcurrency.ValueInt64Pointer(),
+		Next:             tfObject.Next.ValueString(),
+		Output:           jsonRawMessageValue(tfObject.Output),
+		OutputPath:       tfObject.OutputPath.ValueStringPointer(),
+		Parameters:       jsonRawMessageValue(tfObject.Parameters),
+		QueryLanguage:    tfObject.QueryLanguage.ValueString(),
+		Resource:         tfObject.Resource.ValueString(),
+		Result:           jsonRawMessageValue(tfObject.Result),
+		ResultPath:       tfObject.ResultPath.ValueStringPointer(),
+		ResultSelector:   jsonRawMessageValue(tfObject.ResultSelector),
+		Seconds:          tfObject.Seconds.ValueInt64Pointer(),
+		SecondsPath:      tfObject.SecondsPath.ValueString(),
+		TimeoutSeconds:   tfObject.TimeoutSeconds.ValueInt64Pointer(),
+		Timestamp:        tfObject.Timestamp.ValueString(),
+		TimestampPath:    tfObject.TimestampPath.ValueString(),
+		Type:             tfObject.Type.ValueString(),
+	}
+
+	if tfObject.End.ValueBool() {
+		apiObject.End = tfObject.End.ValueBoolPointer()
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, tfObject.Branches) {
+		apiObject.Branches = append(apiObject.Branches, json.RawMessage(v))
+	}
+
+	catchers, d := tfObject.Catchers.ToSlice(ctx)
+	diags.Append(d...)
+	for _, v := range catchers {
+		apiObject.Catch = append(apiObject.Catch, &stateMachineDefinitionCatcher{
+			ErrorEquals: fwflex.ExpandFrameworkStringValueList(ctx, v.ErrorEquals),
+			Next:        v.Next.ValueString(),
+			Output:      jsonRawMessageValue(v.Output),
+			ResultPath:  v.ResultPath.ValueStringPointer(),
+		})
+	}
+
+	choices, d := tfObject.Choices.ToSlice(ctx)
+	diags.Append(d...)
+	for _, v := range choices {
+		// A JSONPath choice rule's comparison operators are specified as JSON and merged with the rule's transition.
+		choice := make(map[string]any)
+		if rule := v.Rule.ValueString(); rule != "" {
+			if err := json.Unmarshal([]byte(rule), &choice); err != nil {
+				diags.AddError("Invalid state machine definition", fmt.Sprintf("state %q choice rule must be a JSON object: %s", tfObject.Name.ValueString(), err))
+				continue
+			}
+		}
+		if condition := v.Condition.ValueString(); condition != "" {
+			choice["Condition"] = condition
+		}
+		choice["Next"] = v.Next.ValueString()
+
+		apiObject.Choices = append(apiObject.Choices, choice)
+	}
+
+	retriers, d := tfObject.Retriers.ToSlice(ctx)
+	diags.Append(d...)
+	for _, v := range retriers {
+		apiObject.Retry = append(apiObject.Retry, &stateMachineDefinitionRetrier{
+			BackoffRate:     v.BackoffRate.ValueFloat64Pointer(),
+			ErrorEquals:     fwflex.ExpandFrameworkStringValueList(ctx, v.ErrorEquals),
+			IntervalSeconds: v.IntervalSeconds.ValueInt64Pointer(),
+			JitterStrategy:  v.JitterStrategy.ValueString(),
+			MaxAttempts:     v.MaxAttempts.ValueInt64Pointer(),
+			MaxDelaySeconds: v.MaxDelaySeconds.ValueInt64Pointer(),
+		})
+	}
+
+	return apiObject, diags
+}
+
+func jsonRawMessageValue(v types.String) json.RawMessage {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	return json.RawMessage(v.ValueString())
+}
+
+type stateMachineDefinitionDataSourceModel struct {
+	Comment        types.String                                                      `tfsdk:"comment"`
+	JSON           types.String                                                      `tfsdk:"json"`
+	QueryLanguage  types.String                                                      `tfsdk:"query_language"`
+	StartAt        types.String                                                      `tfsdk:"start_at"`
+	States         fwtypes.ListNestedObjectValueOf[stateMachineDefinitionStateModel] `tfsdk:"state"`
+	TimeoutSeconds types.Int64                                                       `tfsdk:"timeout_seconds"`
+	Version        types.String                                                      `tfsdk:"version"`
+}
+
+type stateMachineDefinitionStateModel struct {
+	Arguments        types.String                                                        `tfsdk:"arguments"`
+	Assign           types.String                                                        `tfsdk:"assign"`
+	Branches         fwtypes.ListValueOf[types.String]                                   `tfsdk:"branches"`
+	Catchers         fwtypes.ListNestedObjectValueOf[stateMachineDefinitionCatcherModel] `tfsdk:"catch"`
+	Cause            types.String                                                        `tfsdk:"cause"`
+	Choices          fwtypes.ListNestedObjectValueOf[stateMachineDefinitionChoiceModel]  `tfsdk:"choice"`
+	Comment          types.String                                                        `tfsdk:"comment"`
+	Default          types.String                                                        `tfsdk:"default"`
+	End              types.Bool                                                          `tfsdk:"end"`
+	Error            types.String                                                        `tfsdk:"error"`
+	HeartbeatSeconds types.Int64                                                         `tfsdk:"heartbeat_seconds"`
+	InputPath        types.String                                                        `tfsdk:"input_path"`
+	ItemProcessor    types.String                                                        `tfsdk:"item_processor"`
+	ItemSelector     types.String                                                        `tfsdk:"item_selector"`
+	ItemsPath        types.String                                                        `tfsdk:"items_path"`
+	MaxConcurrency   types.Int64                                                         `tfsdk:"max_concurrency"`
+	Name             types.String                                                        `tfsdk:"name"`
+	Next             types.String                                                        `tfsdk:"next"`
+	Output           types.String                                                        `tfsdk:"output"`
+	OutputPath       types.String                                                        `tfsdk:"output_path"`
+	Parameters       types.String                                                        `tfsdk:"parameters"`
+	QueryLanguage    types.String                                                        `tfsdk:"query_language"`
+	Resource         types.String                                                        `tfsdk:"resource"`
+	Result           types.String                                                        `tfsdk:"result"`
+	ResultPath       types.String                                                        `tfsdk:"result_path"`
+	ResultSelector   types.String                                                        `tfsdk:"result_selector"`
+	Retriers         fwtypes.ListNestedObjectValueOf[stateMachineDefinitionRetrierModel] `tfsdk:"retry"`
+	Seconds          types.Int64                                                         `tfsdk:"seconds"`
+	SecondsPath      types.String                                                        `tfsdk:"seconds_path"`
+	TimeoutSeconds   types.Int64                                                         `tfsdk:"timeout_seconds"`
+	Timestamp        types.String                                                        `tfsdk:"timestamp"`
+	TimestampPath    types.String                                                        `tfsdk:"timestamp_path"`
+	Type             types.String                                                        `tfsdk:"type"`
+}
+
+type stateMachineDefinitionCatcherModel struct {
+	ErrorEquals fwtypes.ListValueOf[types.String] `tfsdk:"error_equals"`
+	Next        types.String                      `tfsdk:"next"`
+	Output      types.String                      `tfsdk:"output"`
+	ResultPath  types.String                      `tfsdk:"result_path"`
+}
+
+type stateMachineDefinitionChoiceModel struct {
+	Condition types.String `tfsdk:"condition"`
+	Next      types.String `tfsdk:"next"`
+	Rule      types.String `tfsdk:"rule"`
+}
+
+type stateMachineDefinitionRetrierModel struct {
+	BackoffRate     types.Float64                     `tfsdk:"backoff_rate"`
+	ErrorEquals     fwtypes.ListValueOf[types.String] `tfsdk:"error_equals"`
+	IntervalSeconds types.Int64                       `tfsdk:"interval_seconds"`
+	JitterStrategy  types.String                      `tfsdk:"jitter_strategy"`
+	MaxAttempts     types.Int64                       `tfsdk:"max_attempts"`
+	MaxDelaySeconds types.Int64                       `tfsdk:"max_delay_seconds"`
+}
+
+// Amazon States Language (ASL) state machine definition.
+// Fields are declared in the order used by the ASL specification's examples.
+type stateMachineDefinition struct {
+	Comment        string                                  `json:"Comment,omitempty"`
+	QueryLanguage  string                                  `json:"QueryLanguage,omitempty"`
+	StartAt        string                                  `json:"StartAt"`
+	States         map[string]*stateMachineDefinitionState `json:"States"`
+	Version        string                                  `json:"Version,omitempty"`
+	TimeoutSeconds *int64                                  `json:"TimeoutSeconds,omitempty"`
+}
+
+type stateMachineDefinitionState struct {
+	Type             string                           `json:"Type"`
+	Comment          string                           `json:"Comment,omitempty"`
+	QueryLanguage    string                           `json:"QueryLanguage,omitempty"`
+	Resource         string                           `json:"Resource,omitempty"`
+	InputPath        *string                          `json:"InputPath,omitempty"`
+	Parameters       json.RawMessage                  `json:"Parameters,omitempty"`
+	Arguments        json.RawMessage                  `json:"Arguments,omitempty"`
+	Result           json.RawMessage                  `json:"Result,omitempty"`
+	ResultSelector   json.RawMessage                  `json:"ResultSelector,omitempty"`
+	ResultPath       *string                          `json:"ResultPath,omitempty"`
+	OutputPath       *string                          `json:"OutputPath,omitempty"`
+	Output           json.RawMessage                  `json:"Output,omitempty"`
+	Assign           json.RawMessage                  `json:"Assign,omitempty"`
+	Choices          []map[string]any                 `json:"Choices,omitempty"`
+	Default          string                           `json:"Default,omitempty"`
+	Seconds          *int64                           `json:"Seconds,omitempty"`
+	SecondsPath      string                           `json:"SecondsPath,omitempty"`
+	Timestamp        string                           `json:"Timestamp,omitempty"`
+	TimestampPath    string                           `json:"TimestampPath,omitempty"`
+	Branches         []json.RawMessage                `json:"Branches,omitempty"`
+	ItemsPath        string                           `json:"ItemsPath,omitempty"`
+	ItemSelector     json.RawMessage                  `json:"ItemSelector,omitempty"`
+	ItemProcessor    json.RawMessage                  `json:"ItemProcessor,omitempty"`
+	MaxConcurrency   *int64                           `json:"MaxConcurrency,omitempty"`
+	TimeoutSeconds   *int64                           `json:"TimeoutSeconds,omitempty"`
+	HeartbeatSeconds *int64                           `json:"HeartbeatSeconds,omitempty"`
+	Retry            []*stateMachineDefinitionRetrier `json:"Retry,omitempty"`
+	Catch            []*stateMachineDefinitionCatcher `json:"Catch,omitempty"`
+	Error            string                           `json:"Error,omitempty"`
+	Cause            string                           `json:"Cause,omitempty"`
+	Next             string                           `json:"Next,omitempty"`
+	End              *bool                            `json:"End,omitempty"`
+}
+
+type stateMachineDefinitionRetrier struct {
+	ErrorEquals     []string `json:"ErrorEquals"`
+	IntervalSeconds *int64   `json:"IntervalSeconds,omitempty"`
+	MaxAttempts     *int64   `json:"MaxAttempts,omitempty"`
+	BackoffRate     *float64 `json:"BackoffRate,omitempty"`
+	MaxDelaySeconds *int64   `json:"MaxDelaySeconds,omitempty"`
+	JitterStrategy  string   `json:"JitterStrategy,omitempty"`
+}
+
+type stateMachineDefinitionCatcher struct {
+	ErrorEquals []string        `json:"ErrorEquals"`
+	ResultPath  *string         `json:"ResultPath,omitempty"`
+	Output      json.RawMessage `json:"Output,omitempty"`
+	Next        string          `json:"Next"`
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNStateMachineDefinitionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccStateMachineDefinitionDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDataSource_parallel(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDataSourceConfig_parallel,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccStateMachineDefinitionDataSourceExpectedJSON_parallel),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDefinitionDataSourceConfig_invalid,
+				ExpectError: regexache.MustCompile(`state "Missing" does not exist`),
+			},
+		},
+	})
+}
+
+const testAccStateMachineDefinitionDataSourceConfig_basic = `
+data "aws_sfn_state_machine_definition" "test" {
+  comment  = "A Hello World example"
+  start_at = "Hello"
+
+  state {
+    name     = "Hello"
+    type     = "Task"
+    resource = "arn:aws:states:::lambda:invoke"
+    parameters = jsonencode({
+      "FunctionName" = "hello"
+      "Payload.$"    = "$"
+    })
+    result_path = "$.result"
+    next        = "Check"
+
+    retry {
+      error_equals     = ["States.ALL"]
+      interval_seconds = 5
+      max_attempts     = 3
+      backoff_rate     = 2
+    }
+
+    catch {
+      error_equals = ["States.ALL"]
+      next         = "Failed"
+    }
+  }
+
+  state {
+    name    = "Check"
+    type    = "Choice"
+    default = "Failed"
+
+    choice {
+      rule = jsonencode({
+        "Variable"      = "$.result.StatusCode"
+        "NumericEquals" = 200
+      })
+      next = "Done"
+    }
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+
+  state {
+    name  = "Failed"
+    type  = "Fail"
+    error = "HelloFailed"
+    cause = "Hello did not succeed"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDataSourceExpectedJSON_basic = `{
+  "Comment": "A Hello World example",
+  "StartAt": "Hello",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [
+        {
+          "NumericEquals": 200,
+          "Next": "Done",
+          "Variable": "$.result.StatusCode"
+        }
+      ],
+      "Default": "Failed"
+    },
+    "Done": {
+      "Type": "Succeed"
+    },
+    "Failed": {
+      "Type": "Fail",
+      "Error": "HelloFailed",
+      "Cause": "Hello did not succeed"
+    },
+    "Hello": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {
+        "FunctionName": "hello",
+        "Payload.$": "$"
+      },
+      "ResultPath": "$.result",
+      "Retry": [
+        {
+          "ErrorEquals": ["States.ALL"],
+          "IntervalSeconds": 5,
+          "MaxAttempts": 3,
+          "BackoffRate": 2
+        }
+      ],
+      "Catch": [
+        {
+          "ErrorEquals": ["States.ALL"],
+          "Next": "Failed"
+        }
+      ],
+      "Next": "Check"
+    }
+  }
+}`
+
+const testAccStateMachineDefinitionDataSourceConfig_parallel = `
+data "aws_sfn_state_machine_definition" "branch" {
+  start_at = "Greet"
+
+  state {
+    name           = "Greet"
+    type           = "Pass"
+    query_language = "JSONata"
+    output         = jsonencode({ "greeting" = "{% 'Hello ' & $states.input.name %}" })
+    end            = true
+  }
+}
+
+data "aws_sfn_state_machine_definition" "test" {
+  query_language = "JSONata"
+  start_at       = "Fan"
+
+  state {
+    name     = "Fan"
+    type     = "Parallel"
+    branches = [data.aws_sfn_state_machine_definition.branch.json]
+    end      = true
+  }
+}
+`
+
+const testAccStateMachineDefinitionDataSourceExpectedJSON_parallel = `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Fan",
+  "States": {
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [
+        {
+          "StartAt": "Greet",
+          "States": {
+            "Greet": {
+              "Type": "Pass",
+              "QueryLanguage": "JSONata",
+              "Output": {
+                "greeting": "{% 'Hello ' & $states.input.name %}"
+              },
+              "End": true
+            }
+          }
+        }
+      ],
+      "End": true
+    }
+  }
+}`
+
+const testAccStateMachineDefinitionDataSourceConfig_invalid = `
+data "aws_sfn_state_machine_definition" "test" {
+  start_at = "Hello"
+
+  state {
+    name = "Hello"
+    type = "Pass"
+    next = "Missing"
+  }
+}
+`
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestValidateStateMachineDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition  string
+		expectedErr string
+	}{
+		"valid": {
+			definition: `{
+  "StartAt": "Hello",
+  "States": {
+    "Hello": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-west-2:123456789012:function:hello",
+      "Parameters": {
+        "name.$": "$.detail.name",
+        "message.$": "States.Format('Hello {}', $.detail.name)"
+      },
+      "Retry": [{"ErrorEquals": ["States.ALL"], "MaxAttempts": 2}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}],
+      "ResultPath": "$.result",
+      "Next": "Check"
+    },
+    "Check": {
+      "Type": "Choice",
+      "Choices": [{"Variable": "$.result.ok", "BooleanEquals": true, "Next": "Done"}],
+      "Default": "Failed"
+    },
+    "Done": {"Type": "Succeed"},
+    "Failed": {"Type": "Fail", "Error": "Failed"}
+  }
+}`,
+		},
+		"valid JSONata": {
+			definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Wait",
+  "States": {
+    "Wait": {
+      "Type": "Wait",
+      "Seconds": "{% $states.input.delay %}",
+      "Next": "Check"
+    },
+    "Check": {
+      "Type": "Choice",
+      "Choices": [{"Condition": "{% $states.input.ok = true %}", "Next": "Done"}],
+      "Default": "Done"
+    },
+    "Done": {"Type": "Pass", "Output": {"items": "{% $states.input.items[0] %}"}, "End": true}
+  }
+}`,
+		},
+		"valid Parallel and Map": {
+			definition: `{
+  "StartAt": "Fan",
+  "States": {
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}
+      ],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "ItemsPath": "$.items",
+      "ItemProcessor": {"StartAt": "B", "States": {"B": {"Type": "Succeed"}}},
+      "End": true
+    }
+  }
+}`,
+		},
+		"invalid JSON": {
+			definition:  `{"StartAt": `,
+			expectedErr: `parsing JSON`,
+		},
+		"not an object": {
+			definition:  `[]`,
+			expectedErr: `definition must be a JSON object`,
+		},
+		"missing States": {
+			definition:  `{"StartAt": "Hello", "Status": {}}`,
+			expectedErr: `missing required non-empty object field "States"`,
+		},
+		"StartAt does not exist": {
+			definition:  `{"StartAt": "Goodbye", "States": {"Hello": {"Type": "Succeed"}}}`,
+			expectedErr: `/StartAt: state "Goodbye" does not exist`,
+		},
+		"invalid Type": {
+			definition:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Lambda", "End": true}}}`,
+			expectedErr: `/States/Hello/Type: invalid state type "Lambda"`,
+		},
+		"Next does not exist": {
+			definition:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "Next": "Done"}, "Other": {"Type": "Succeed"}}}`,
+			expectedErr: `/States/Hello/Next: state "Done" does not exist`,
+		},
+		"missing Next and End": {
+			definition:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass"}, "Done": {"Type": "Succeed"}}}`,
+			expectedErr: `/States/Hello: one of "Next" or "End" must be set`,
+		},
+		"Next on terminal state": {
+			definition:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Succeed", "Next": "Hello"}}}`,
+			expectedErr: `/States/Hello/Next: field is not supported for Succeed states`,
+		},
+		"no terminal state": {
+			definition:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "Next": "Hello"}}}`,
+			expectedErr: `no terminal state`,
+		},
+		"unreachable state": {
+			definition:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Succeed"}, "Orphan": {"Type": "Succeed"}}}`,
+			expectedErr: `/States/Orphan: state is not reachable from "Hello"`,
+		},
+		"missing Task Resource": {
+			definition:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Task", "End": true}}}`,
+			expectedErr: `missing required string field "Resource"`,
+		},
+		"Wait with multiple durations": {
+			definition:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Wait", "Seconds": 10, "SecondsPath": "$.delay", "End": true}}}`,
+			expectedErr: `exactly one of "Seconds", "SecondsPath", "Timestamp" or "TimestampPath" must be set`,
+		},
+		"invalid JSONPath": {
+			definition:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "InputPath": "detail", "End": true}}}`,
+			expectedErr: `/States/Hello/InputPath: invalid JSONPath "detail": must begin with "\$"`,
+		},
+		"unbalanced JSONPath": {
+			definition:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "Parameters": {"item.$": "$.items[0"}, "End": true}}}`,
+			expectedErr: `/States/Hello/Parameters/item.\$: invalid JSONPath`,
+		},
+		"invalid JSONata expression": {
+			definition:  `{"QueryLanguage": "JSONata", "StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "Output": "{% $states.input.items[0 %}", "End": true}}}`,
+			expectedErr: `/States/Hello/Output: invalid JSONata expression`,
+		},
+		"JSONata Comment ending with expression suffix": {
+			definition: `{"QueryLanguage": "JSONata", "StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "Comment": "Completes at 100%}", "End": true}}}`,
+		},
+		"invalid JSONata Choice rule condition": {
+			definition:  `{"QueryLanguage": "JSONata", "StartAt": "Check", "States": {"Check": {"Type": "Choice", "Choices": [{"Condition": "{% $states.input.ok = (true %}", "Next": "Done"}], "Default": "Done"}, "Done": {"Type": "Succeed"}}}`,
+			expectedErr: `/States/Check/Choices/0/Condition: invalid JSONata expression`,
+		},
+		"JSONPath field with JSONata": {
+			definition:  `{"QueryLanguage": "JSONata", "StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "InputPath": "$.detail", "End": true}}}`,
+			expectedErr: `/States/Hello/InputPath: field is not supported when the query language is JSONata`,
+		},
+		"invalid Parallel branch": {
+			definition:  `{"StartAt": "Fan", "States": {"Fan": {"Type": "Parallel", "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass"}}}], "End": true}}}`,
+			expectedErr: `/States/Fan/Branches/0/States/A: one of "Next" or "End" must be set`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateStateMachineDefinition(testCase.definition)
+
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !regexache.MustCompile(testCase.expectedErr).MatchString(err.Error()) {
+				t.Errorf("expected error matching %q, got %q", testCase.expectedErr, err)
+			}
+		})
+	}
+}
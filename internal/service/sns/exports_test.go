@@ -14,6 +14,7 @@ var (
 	FindDataProtectionPolicyByARN                  = findDataProtectionPolicyByARN
 	FindPlatformApplicationAttributesByARN         = findPlatformApplicationAttributesByARN
 	FindSubscriptionAttributesByARN                = findSubscriptionAttributesByARN
+	FindSubscriptionsByTopicARN                    = findSubscriptionsByTopicARN
 	FindTopicAttributesByARN                       = findTopicAttributesByARN
 	FindTopicAttributesWithValidAWSPrincipalsByARN = findTopicAttributesWithValidAWSPrincipalsByARN // nosemgrep:ci.aws-in-var-name
 
@@ -22,7 +22,14 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
-	return []*inttypes.ServicePackageFrameworkResource{}
+	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newTopicSubscriptionsExclusiveResource,
+			TypeName: "aws_sns_topic_subscriptions_exclusive",
+			Name:     "Topic Subscriptions Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
@@ -67,6 +74,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
 			},
+			IAMActions: inttypes.ServicePackageResourceIAMActions{
+				Create: []string{"sns:CreateTopic", "sns:SetTopicAttributes", "sns:TagResource", "sns:GetTopicAttributes", "sns:ListTagsForResource"},
+				Update: []string{"sns:SetTopicAttributes", "sns:TagResource", "sns:UntagResource", "sns:GetTopicAttributes", "sns:ListTagsForResource"},
+				Delete: []string{"sns:DeleteTopic"},
+			},
 		},
 		{
 			Factory:  resourceTopicDataProtectionPolicy,
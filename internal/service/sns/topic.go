@@ -247,6 +247,7 @@ var (
 
 // @SDKResource("aws_sns_topic", name="Topic")
 // @Tags(identifierAttribute="arn")
+// @IAMActions(create="sns:CreateTopic;sns:SetTopicAttributes;sns:TagResource;sns:GetTopicAttributes;sns:ListTagsForResource", update="sns:SetTopicAttributes;sns:TagResource;sns:UntagResource;sns:GetTopicAttributes;sns:ListTagsForResource", delete="sns:DeleteTopic")
 // @ArnIdentity
 // @Testing(preIdentityVersion="v6.4.0")
 // @Testing(existsType="map[string]string")
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_sns_topic_subscriptions_exclusive", name="Topic Subscriptions Exclusive")
+func newTopicSubscriptionsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &topicSubscriptionsExclusiveResource{}
+	r.SetExclusiveCollection(r)
+
+	return r, nil
+}
+
+const (
+	ResNameTopicSubscriptionsExclusive = "Topic Subscriptions Exclusive"
+)
+
+// topicSubscriptionsExclusiveResource keeps the subscriptions to an SNS topic
+// in sync with the configured subscriptions.
+//
+// Subscriptions configured on this resource but not present on the topic will
+// be created. Subscriptions to the topic but not configured on this resource
+// will be deleted.
+type topicSubscriptionsExclusiveResource struct {
+	framework.ResourceWithExclusiveCollection[topicSubscriptionsExclusiveResourceModel, topicSubscriptionMember]
+}
+
+func (r *topicSubscriptionsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrTopicARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"subscription": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[topicSubscriptionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrEndpoint: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *topicSubscriptionsExclusiveResource) ConfiguredMembers(ctx context.Context, data *topicSubscriptionsExclusiveResourceModel) ([]topicSubscriptionMember, diag.Diagnostics) {
+	subscriptions, diags := data.Subscriptions.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var members []topicSubscriptionMember
+	for _, v := range subscriptions {
+		members = append(members, topicSubscriptionMember{
+			endpoint: v.Endpoint.ValueString(),
+			protocol: v.Protocol.ValueString(),
+		})
+	}
+
+	return members, diags
+}
+
+func (r *topicSubscriptionsExclusiveResource) SetMembers(ctx context.Context, data *topicSubscriptionsExclusiveResourceModel, members []topicSubscriptionMember) diag.Diagnostics {
+	subscriptions := make([]topicSubscriptionModel, 0, len(members))
+	for _, v := range members {
+		subscriptions = append(subscriptions, topicSubscriptionModel{
+			Endpoint: types.StringValue(v.endpoint),
+			Protocol: types.StringValue(v.protocol),
+		})
+	}
+
+	data.Subscriptions = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, subscriptions)
+
+	return nil
+}
+
+func (r *topicSubscriptionsExclusiveResource) ListMembers(ctx context.Context, data *topicSubscriptionsExclusiveResourceModel) ([]topicSubscriptionMember, error) {
+	conn := r.Meta().SNSClient(ctx)
+
+	topicARN := data.TopicARN.ValueString()
+	if _, err := findTopicAttributesByARN(ctx, conn, topicARN); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := findSubscriptionsByTopicARN(ctx, conn, topicARN)
+	if err != nil {
+		return nil, err
+	}
+
+	configured, diags := r.ConfiguredMembers(ctx, data)
+	if diags.HasError() {
+		return nil, fwdiag.DiagnosticsError(diags)
+	}
+
+	var members []topicSubscriptionMember
+	for _, v := range subscriptions {
+		member := topicSubscriptionMember{
+			endpoint:        aws.ToString(v.Endpoint),
+			protocol:        aws.ToString(v.Protocol),
+			subscriptionARN: aws.ToString(v.SubscriptionArn),
+		}
+
+		// Subscriptions pending confirmation can't be deleted. They expire after 3 days.
+		// Only report those that are configured, so that they are neither subscribed again nor planned for removal.
+		if member.subscriptionARN == subscriptionAttributeNamePendingConfirmation && !slices.ContainsFunc(configured, member.equal) {
+			continue
+		}
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// DiffMembers compares subscriptions by protocol and endpoint, ignoring subscription ARNs.
+func (r *topicSubscriptionsExclusiveResource) DiffMembers(have, want []topicSubscriptionMember) ([]topicSubscriptionMember, []topicSubscriptionMember) {
+	add, remove, _ := intflex.DiffSlices(have, want, topicSubscriptionMember.equal)
+
+	return add, remove
+}
+
+func (r *topicSubscriptionsExclusiveResource) AddMembers(ctx context.Context, data *topicSubscriptionsExclusiveResourceModel, members []topicSubscriptionMember) error {
+	conn := r.Meta().SNSClient(ctx)
+
+	for _, v := range members {
+		input := sns.SubscribeInput{
+			Endpoint: aws.String(v.endpoint),
+			Protocol: aws.String(v.protocol),
+			TopicArn: data.TopicARN.ValueStringPointer(),
+		}
+
+		if _, err := conn.Subscribe(ctx, &input); err != nil {
+			return fmt.Errorf("creating SNS Topic Subscription (%s/%s): %w", v.protocol, v.endpoint, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *topicSubscriptionsExclusiveResource) RemoveMembers(ctx context.Context, data *topicSubscriptionsExclusiveResourceModel, members []topicSubscriptionMember) error {
+	conn := r.Meta().SNSClient(ctx)
+
+	for _, v := range members {
+		input := sns.UnsubscribeInput{
+			SubscriptionArn: aws.String(v.subscriptionARN),
+		}
+
+		_, err := conn.Unsubscribe(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting SNS Topic Subscription (%s): %w", v.subscriptionARN, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *topicSubscriptionsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrTopicARN), request, response)
+}
+
+func findSubscriptionsByTopicARN(ctx context.Context, conn *sns.Client, topicARN string) ([]awstypes.Subscription, error) {
+	input := sns.ListSubscriptionsByTopicInput{
+		TopicArn: aws.String(topicARN),
+	}
+	var output []awstypes.Subscription
+
+	pages := sns.NewListSubscriptionsByTopicPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: &input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Subscriptions...)
+	}
+
+	return output, nil
+}
+
+type topicSubscriptionsExclusiveResourceModel struct {
+	framework.WithRegionModel
+	Subscriptions fwtypes.SetNestedObjectValueOf[topicSubscriptionModel] `tfsdk:"subscription"`
+	TopicARN      fwtypes.ARN                                            `tfsdk:"topic_arn"`
+}
+
+type topicSubscriptionModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+type topicSubscriptionMember struct {
+	endpoint        string
+	protocol        string
+	subscriptionARN string
+}
+
+// equal reports whether two subscriptions have the same protocol and endpoint.
+func (m topicSubscriptionMember) equal(other topicSubscriptionMember) bool {
+	return m.endpoint == other.endpoint && m.protocol == other.protocol
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSNSTopicSubscriptionsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributes map[string]string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sns_topic_subscriptions_exclusive.test"
+	topicResourceName := "aws_sns_topic.test"
+	queueResourceName := "aws_sqs_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, topicResourceName, &attributes),
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTopicARN, topicResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "subscription.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "subscription.*.endpoint", queueResourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscription.*", map[string]string{
+						names.AttrProtocol: "sqs",
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrTopicARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrTopicARN,
+			},
+		},
+	})
+}
+
+func TestAccSNSTopicSubscriptionsExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributes map[string]string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sns_topic_subscriptions_exclusive.test"
+	topicResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, topicResourceName, &attributes),
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subscription.#", "1"),
+				),
+			},
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, topicResourceName, &attributes),
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subscription.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// A subscription added out of band should be removed
+func TestAccSNSTopicSubscriptionsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributes map[string]string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sns_topic_subscriptions_exclusive.test"
+	topicResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_outOfBandAddition(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, topicResourceName, &attributes),
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					testAccCheckTopicSubscriptionsExclusiveSubscribe(ctx, topicResourceName, "aws_sqs_queue.test2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_outOfBandAddition(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, topicResourceName, &attributes),
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subscription.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopicSubscriptionsExclusive_outOfBandPendingConfirmation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributes map[string]string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sns_topic_subscriptions_exclusive.test"
+	topicResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, topicResourceName, &attributes),
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					// Email subscriptions remain pending confirmation.
+					testAccCheckTopicSubscriptionsExclusiveSubscribeEmail(ctx, topicResourceName, acctest.DefaultEmailAddress),
+				),
+			},
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_basic(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "subscription.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTopicSubscriptionsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, n, errors.New("not found"))
+		}
+
+		topicARN := rs.Primary.Attributes[names.AttrTopicARN]
+		if topicARN == "" {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, n, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSClient(ctx)
+
+		output, err := tfsns.FindSubscriptionsByTopicARN(ctx, conn, topicARN)
+		if err != nil {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, topicARN, err)
+		}
+
+		if count := rs.Primary.Attributes["subscription.#"]; count != strconv.Itoa(len(output)) {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, topicARN, errors.New("unexpected subscription count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTopicSubscriptionsExclusiveSubscribe(ctx context.Context, topicResourceName, queueResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSClient(ctx)
+
+		input := sns.SubscribeInput{
+			Endpoint: aws.String(s.RootModule().Resources[queueResourceName].Primary.Attributes[names.AttrARN]),
+			Protocol: aws.String("sqs"),
+			TopicArn: aws.String(s.RootModule().Resources[topicResourceName].Primary.Attributes[names.AttrARN]),
+		}
+
+		_, err := conn.Subscribe(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccCheckTopicSubscriptionsExclusiveSubscribeEmail(ctx context.Context, topicResourceName, emailAddress string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSClient(ctx)
+
+		input := sns.SubscribeInput{
+			Endpoint: aws.String(emailAddress),
+			Protocol: aws.String("email"),
+			TopicArn: aws.String(s.RootModule().Resources[topicResourceName].Primary.Attributes[names.AttrARN]),
+		}
+
+		_, err := conn.Subscribe(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccTopicSubscriptionsExclusiveConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccTopicSubscriptionsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTopicSubscriptionsExclusiveConfig_base(rName), `
+resource "aws_sns_topic_subscriptions_exclusive" "test" {
+  topic_arn = aws_sns_topic.test.arn
+
+  subscription {
+    protocol = "sqs"
+    endpoint = aws_sqs_queue.test.arn
+  }
+}
+`)
+}
+
+func testAccTopicSubscriptionsExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccTopicSubscriptionsExclusiveConfig_base(rName), `
+resource "aws_sns_topic_subscriptions_exclusive" "test" {
+  topic_arn = aws_sns_topic.test.arn
+}
+`)
+}
+
+func testAccTopicSubscriptionsExclusiveConfig_outOfBandAddition(rName string) string {
+	return acctest.ConfigCompose(testAccTopicSubscriptionsExclusiveConfig_basic(rName), fmt.Sprintf(`
+resource "aws_sqs_queue" "test2" {
+  name = "%[1]s-2"
+}
+`, rName))
+}
@@ -194,6 +194,7 @@ var (
 
 // @SDKResource("aws_sqs_queue", name="Queue")
 // @Tags(identifierAttribute="id")
+// @IAMActions(create="sqs:CreateQueue;sqs:TagQueue;sqs:GetQueueAttributes;sqs:ListQueueTags", update="sqs:SetQueueAttributes;sqs:TagQueue;sqs:UntagQueue;sqs:GetQueueAttributes;sqs:ListQueueTags", delete="sqs:DeleteQueue;sqs:GetQueueAttributes")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sqs/types;awstypes;map[awstypes.QueueAttributeName]string")
 // @IdentityAttribute("url")
 // @Testing(preIdentityVersion="v6.9.0")
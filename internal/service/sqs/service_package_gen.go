@@ -59,6 +59,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
 			},
+			IAMActions: inttypes.ServicePackageResourceIAMActions{
+				Create: []string{"sqs:CreateQueue", "sqs:TagQueue", "sqs:GetQueueAttributes", "sqs:ListQueueTags"},
+				Update: []string{"sqs:SetQueueAttributes", "sqs:TagQueue", "sqs:UntagQueue", "sqs:GetQueueAttributes", "sqs:ListQueueTags"},
+				Delete: []string{"sqs:DeleteQueue", "sqs:GetQueueAttributes"},
+			},
 		},
 		{
 			Factory:  resourceQueuePolicy,
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_sts_assume_role", name="Assume Role")
+func newAssumeRoleEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &assumeRoleEphemeralResource{}, nil
+}
+
+type assumeRoleEphemeralResource struct {
+	framework.EphemeralResourceWithModel[assumeRoleEphemeralResourceModel]
+}
+
+func (e *assumeRoleEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := assumeRoleCommonAttributes()
+	attributes["external_id"] = schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(2, 1224),
+			stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@:\/\-]*$`), ""),
+		},
+	}
+	attributes["source_identity"] = schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(2, 64),
+			stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), ""),
+		},
+	}
+	attributes["tags"] = schema.MapAttribute{
+		CustomType: fwtypes.MapOfStringType,
+		Optional:   true,
+		Validators: []validator.Map{
+			mapvalidator.KeysAre(
+				stringvalidator.LengthBetween(1, 128),
+			),
+			mapvalidator.ValueStringsAre(
+				stringvalidator.LengthBetween(0, 256),
+			),
+			mapvalidator.SizeAtMost(50),
+		},
+	}
+	attributes["transitive_tag_keys"] = schema.SetAttribute{
+		CustomType: fwtypes.SetOfStringType,
+		Optional:   true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtMost(50),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *assumeRoleEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data assumeRoleEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	roleARN := data.RoleARN.ValueString()
+	input := sts.AssumeRoleInput{
+		DurationSeconds:   fwflex.Int32FromFrameworkInt64(ctx, data.DurationSeconds),
+		ExternalId:        fwflex.StringFromFramework(ctx, data.ExternalID),
+		Policy:            fwflex.StringFromFramework(ctx, data.Policy),
+		PolicyArns:        expandPolicyDescriptorTypes(ctx, data.PolicyARNs),
+		RoleArn:           aws.String(roleARN),
+		RoleSessionName:   fwflex.StringFromFramework(ctx, data.RoleSessionName),
+		SourceIdentity:    fwflex.StringFromFramework(ctx, data.SourceIdentity),
+		Tags:              expandTags(ctx, data.Tags),
+		TransitiveTagKeys: fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys),
+	}
+
+	output, err := conn.AssumeRole(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("assuming IAM Role (%s)", roleARN), err.Error())
+
+		return
+	}
+
+	data.AssumedRoleARN = fwflex.StringToFramework(ctx, output.AssumedRoleUser.Arn)
+	data.AssumedRoleID = fwflex.StringToFramework(ctx, output.AssumedRoleUser.AssumedRoleId)
+	data.PackedPolicySize = fwflex.Int32ToFrameworkInt64(ctx, output.PackedPolicySize)
+	response.Diagnostics.Append(data.credentialsModel.flatten(ctx, output.Credentials)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// @EphemeralResource("aws_sts_assume_role_with_web_identity", name="Assume Role With Web Identity")
+func newAssumeRoleWithWebIdentityEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &assumeRoleWithWebIdentityEphemeralResource{}, nil
+}
+
+type assumeRoleWithWebIdentityEphemeralResource struct {
+	framework.EphemeralResourceWithModel[assumeRoleWithWebIdentityEphemeralResourceModel]
+}
+
+func (e *assumeRoleWithWebIdentityEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := assumeRoleCommonAttributes()
+	attributes["provider_id"] = schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(4, 2048),
+		},
+	}
+	attributes["audience"] = schema.StringAttribute{
+		Computed: true,
+	}
+	attributes["identity_provider"] = schema.StringAttribute{
+		Computed: true,
+	}
+	attributes["subject_from_web_identity_token"] = schema.StringAttribute{
+		Computed: true,
+	}
+	attributes["web_identity_token"] = schema.StringAttribute{
+		Required:  true,
+		Sensitive: true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(4, 20000),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *assumeRoleWithWebIdentityEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data assumeRoleWithWebIdentityEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	roleARN := data.RoleARN.ValueString()
+	input := sts.AssumeRoleWithWebIdentityInput{
+		DurationSeconds:  fwflex.Int32FromFrameworkInt64(ctx, data.DurationSeconds),
+		Policy:           fwflex.StringFromFramework(ctx, data.Policy),
+		PolicyArns:       expandPolicyDescriptorTypes(ctx, data.PolicyARNs),
+		ProviderId:       fwflex.StringFromFramework(ctx, data.ProviderID),
+		RoleArn:          aws.String(roleARN),
+		RoleSessionName:  fwflex.StringFromFramework(ctx, data.RoleSessionName),
+		WebIdentityToken: fwflex.StringFromFramework(ctx, data.WebIdentityToken),
+	}
+
+	output, err := conn.AssumeRoleWithWebIdentity(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("assuming IAM Role (%s) with web identity", roleARN), err.Error())
+
+		return
+	}
+
+	data.AssumedRoleARN = fwflex.StringToFramework(ctx, output.AssumedRoleUser.Arn)
+	data.AssumedRoleID = fwflex.StringToFramework(ctx, output.AssumedRoleUser.AssumedRoleId)
+	data.Audience = fwflex.StringToFramework(ctx, output.Audience)
+	data.PackedPolicySize = fwflex.Int32ToFrameworkInt64(ctx, output.PackedPolicySize)
+	data.IdentityProvider = fwflex.StringToFramework(ctx, output.Provider)
+	data.SourceIdentity = fwflex.StringToFramework(ctx, output.SourceIdentity)
+	data.SubjectFromWebIdentityToken = fwflex.StringToFramework(ctx, output.SubjectFromWebIdentityToken)
+	response.Diagnostics.Append(data.credentialsModel.flatten(ctx, output.Credentials)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// assumeRoleCommonAttributes returns the schema attributes shared by all of the STS assume role ephemeral resources.
+func assumeRoleCommonAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"access_key_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"assumed_role_arn": schema.StringAttribute{
+			Computed: true,
+		},
+		"assumed_role_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"duration_seconds": schema.Int64Attribute{
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.Between(900, 43200),
+			},
+		},
+		"expiration": schema.StringAttribute{
+			CustomType: timetypes.RFC3339Type{},
+			Computed:   true,
+		},
+		"packed_policy_size": schema.Int64Attribute{
+			Computed: true,
+		},
+		names.AttrPolicy: schema.StringAttribute{
+			CustomType: fwtypes.IAMPolicyType,
+			Optional:   true,
+		},
+		"policy_arns": schema.SetAttribute{
+			CustomType: fwtypes.SetOfARNType,
+			Optional:   true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtMost(10),
+			},
+		},
+		names.AttrRoleARN: schema.StringAttribute{
+			CustomType: fwtypes.ARNType,
+			Required:   true,
+		},
+		"role_session_name": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 64),
+				stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), ""),
+			},
+		},
+		"secret_access_key": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+		"session_token": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+		"source_identity": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
+
+func expandPolicyDescriptorTypes(ctx context.Context, v fwtypes.SetOfARN) []awstypes.PolicyDescriptorType {
+	var apiObjects []awstypes.PolicyDescriptorType
+
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, v) {
+		apiObjects = append(apiObjects, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandTags(ctx context.Context, v fwtypes.MapOfString) []awstypes.Tag {
+	var apiObjects []awstypes.Tag
+
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, v) {
+		apiObjects = append(apiObjects, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+type credentialsModel struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+}
+
+func (m *credentialsModel) flatten(ctx context.Context, apiObject *awstypes.Credentials) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return diags
+	}
+
+	m.AccessKeyID = fwflex.StringToFramework(ctx, apiObject.AccessKeyId)
+	m.Expiration = timetypes.NewRFC3339TimePointerValue(apiObject.Expiration)
+	m.SecretAccessKey = fwflex.StringToFramework(ctx, apiObject.SecretAccessKey)
+	m.SessionToken = fwflex.StringToFramework(ctx, apiObject.SessionToken)
+
+	return diags
+}
+
+type assumeRoleCommonModel struct {
+	credentialsModel
+	AssumedRoleARN   types.String      `tfsdk:"assumed_role_arn"`
+	AssumedRoleID    types.String      `tfsdk:"assumed_role_id"`
+	DurationSeconds  types.Int64       `tfsdk:"duration_seconds"`
+	PackedPolicySize types.Int64       `tfsdk:"packed_policy_size"`
+	Policy           fwtypes.IAMPolicy `tfsdk:"policy"`
+	PolicyARNs       fwtypes.SetOfARN  `tfsdk:"policy_arns"`
+	RoleARN          fwtypes.ARN       `tfsdk:"role_arn"`
+	RoleSessionName  types.String      `tfsdk:"role_session_name"`
+	SourceIdentity   types.String      `tfsdk:"source_identity"`
+}
+
+type assumeRoleEphemeralResourceModel struct {
+	framework.WithRegionModel
+	assumeRoleCommonModel
+	ExternalID        types.String        `tfsdk:"external_id"`
+	Tags              fwtypes.MapOfString `tfsdk:"tags"`
+	TransitiveTagKeys fwtypes.SetOfString `tfsdk:"transitive_tag_keys"`
+}
+
+type assumeRoleWithWebIdentityEphemeralResourceModel struct {
+	framework.WithRegionModel
+	assumeRoleCommonModel
+	Audience                    types.String `tfsdk:"audience"`
+	IdentityProvider            types.String `tfsdk:"identity_provider"`
+	ProviderID                  types.String `tfsdk:"provider_id"`
+	SubjectFromWebIdentityToken types.String `tfsdk:"subject_from_web_identity_token"`
+	WebIdentityToken            types.String `tfsdk:"web_identity_token"`
+}
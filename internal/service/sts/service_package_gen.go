@@ -17,6 +17,23 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newAssumeRoleEphemeralResource,
+			TypeName: "aws_sts_assume_role",
+			Name:     "Assume Role",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newAssumeRoleWithWebIdentityEphemeralResource,
+			TypeName: "aws_sts_assume_role_with_web_identity",
+			Name:     "Assume Role With Web Identity",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
 		{
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+// Exports for use in tests only.
+var (
+	ResourceLens           = newLensResource
+	ResourceProfile        = newProfileResource
+	ResourceReviewTemplate = newReviewTemplateResource
+	ResourceWorkload       = newWorkloadResource
+
+	FindLensByARN           = findLensByARN
+	FindProfileByARN        = findProfileByARN
+	FindReviewTemplateByARN = findReviewTemplateByARN
+	FindWorkloadByID        = findWorkloadByID
+)
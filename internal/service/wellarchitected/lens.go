@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	lensImportTimeout = 5 * time.Minute
+)
+
+// @FrameworkResource("aws_wellarchitected_lens", name="Lens")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.LensSummary")
+// @Testing(importIgnore="lens_json;version_name;is_major_version")
+func newLensResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &lensResource{}
+
+	return r, nil
+}
+
+type lensResource struct {
+	framework.ResourceWithModel[lensResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *lensResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"is_major_version": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"lens_json": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+			"lens_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"version_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+		},
+	}
+}
+
+func (r *lensResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := wellarchitected.ImportLensInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		JSONString:         fwflex.StringFromFramework(ctx, data.LensJSON),
+		Tags:               getTagsIn(ctx),
+	}
+	output, err := conn.ImportLens(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("importing Well-Architected Lens", err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.LensArn)
+
+	// The lens is imported asynchronously.
+	if _, err := tfresource.RetryWhenNotFound(ctx, lensImportTimeout, func(ctx context.Context) (*awstypes.LensSummary, error) {
+		return findLensByARN(ctx, conn, arn)
+	}); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Well-Architected Lens (%s) import", arn), err.Error())
+
+		return
+	}
+
+	if !data.VersionName.IsNull() {
+		if err := publishLens(ctx, conn, arn, data.VersionName.ValueString(), data.IsMajorVersion.ValueBool()); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	lens, err := findLensByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, lens, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *lensResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.LensARN.ValueString()
+	output, err := findLensByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := new.LensARN.ValueString()
+
+	// Re-importing a lens document updates the lens' draft.
+	if !new.LensJSON.Equal(old.LensJSON) {
+		input := wellarchitected.ImportLensInput{
+			ClientRequestToken: aws.String(sdkid.UniqueId()),
+			JSONString:         fwflex.StringFromFramework(ctx, new.LensJSON),
+			LensAlias:          aws.String(arn),
+		}
+		_, err := conn.ImportLens(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("importing Well-Architected Lens (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.VersionName.IsNull() && !new.VersionName.Equal(old.VersionName) {
+		if err := publishLens(ctx, conn, arn, new.VersionName.ValueString(), new.IsMajorVersion.ValueBool()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	lens, err := findLensByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, lens, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *lensResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.LensARN.ValueString()
+	input := wellarchitected.DeleteLensInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		LensAlias:          aws.String(arn),
+		LensStatus:         awstypes.LensStatusTypeAll,
+	}
+	_, err := conn.DeleteLens(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func publishLens(ctx context.Context, conn *wellarchitected.Client, arn, versionName string, isMajorVersion bool) error {
+	input := wellarchitected.CreateLensVersionInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		IsMajorVersion:     aws.Bool(isMajorVersion),
+		LensAlias:          aws.String(arn),
+		LensVersion:        aws.String(versionName),
+	}
+
+	// A ConflictException is returned while a lens import is still in progress.
+	_, err := tfresource.RetryWhenIsA[any, *awstypes.ConflictException](ctx, lensImportTimeout, func(ctx context.Context) (any, error) {
+		return conn.CreateLensVersion(ctx, &input)
+	})
+
+	return err
+}
+
+func findLensByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.LensSummary, error) {
+	input := wellarchitected.ListLensesInput{
+		LensStatus: awstypes.LensStatusTypeAll,
+		LensType:   awstypes.LensTypeCustomSelf,
+	}
+
+	return findLens(ctx, conn, &input, func(v *awstypes.LensSummary) bool {
+		return aws.ToString(v.LensArn) == arn
+	})
+}
+
+func findLens(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensesInput, filter tfslices.Predicate[*awstypes.LensSummary]) (*awstypes.LensSummary, error) {
+	output, err := findLenses(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findLenses(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensesInput, filter tfslices.Predicate[*awstypes.LensSummary]) ([]awstypes.LensSummary, error) {
+	var output []awstypes.LensSummary
+
+	pages := wellarchitected.NewListLensesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LensSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type lensResourceModel struct {
+	framework.WithRegionModel
+	Description    types.String         `tfsdk:"description"`
+	IsMajorVersion types.Bool           `tfsdk:"is_major_version"`
+	LensARN        types.String         `tfsdk:"arn"`
+	LensJSON       jsontypes.Normalized `tfsdk:"lens_json"`
+	LensName       types.String         `tfsdk:"name"`
+	LensVersion    types.String         `tfsdk:"lens_version"`
+	Owner          types.String         `tfsdk:"owner"`
+	Tags           tftags.Map           `tfsdk:"tags"`
+	TagsAll        tftags.Map           `tfsdk:"tags_all"`
+	VersionName    types.String         `tfsdk:"version_name"`
+}
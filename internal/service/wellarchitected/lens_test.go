@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedLens_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LensSummary
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_lens.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLensDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensConfig_basic(rName, "Test lens"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, t, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "wellarchitected", regexache.MustCompile(`lens/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test lens"),
+					resource.TestCheckResourceAttr(resourceName, "is_major_version", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckNoResourceAttr(resourceName, "version_name"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+				ImportStateVerifyIgnore:              []string{"is_major_version", "lens_json", "version_name"},
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedLens_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LensSummary
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_lens.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLensDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensConfig_basic(rName, "Test lens"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceLens, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedLens_publish(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LensSummary
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_lens.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLensDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensConfig_versionName(rName, "Test lens", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test lens"),
+					resource.TestCheckResourceAttr(resourceName, "lens_version", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "v1"),
+				),
+			},
+			{
+				Config: testAccLensConfig_versionName(rName, "Updated test lens", "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated test lens"),
+					resource.TestCheckResourceAttr(resourceName, "lens_version", "v2"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedLens_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LensSummary
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_lens.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLensDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccLensConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccLensConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLensDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_lens" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindLensByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Lens %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLensExists(ctx context.Context, t *testing.T, n string, v *awstypes.LensSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindLensByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLensConfig_base(rName, description string) string {
+	return fmt.Sprintf(`
+locals {
+  lens_json = jsonencode({
+    schemaVersion = "2021-11-01"
+    name          = %[1]q
+    description   = %[2]q
+    pillars = [{
+      id   = "pillar1"
+      name = "Pillar 1"
+      questions = [{
+        id    = "question1"
+        title = "Is the workload documented?"
+        choices = [{
+          id    = "choice1"
+          title = "Yes"
+        }, {
+          id    = "choice_none"
+          title = "None of these"
+        }]
+        riskRules = [{
+          condition = "choice1"
+          risk      = "NO_RISK"
+        }, {
+          condition = "default"
+          risk      = "HIGH_RISK"
+        }]
+      }]
+    }]
+  })
+}
+`, rName, description)
+}
+
+func testAccLensConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccLensConfig_base(rName, description), `
+resource "aws_wellarchitected_lens" "test" {
+  lens_json = local.lens_json
+}
+`)
+}
+
+func testAccLensConfig_versionName(rName, description, versionName string) string {
+	return acctest.ConfigCompose(testAccLensConfig_base(rName, description), fmt.Sprintf(`
+resource "aws_wellarchitected_lens" "test" {
+  lens_json    = local.lens_json
+  version_name = %[1]q
+}
+`, versionName))
+}
+
+func testAccLensConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccLensConfig_base(rName, "Test lens"), fmt.Sprintf(`
+resource "aws_wellarchitected_lens" "test" {
+  lens_json = local.lens_json
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccLensConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccLensConfig_base(rName, "Test lens"), fmt.Sprintf(`
+resource "aws_wellarchitected_lens" "test" {
+  lens_json = local.lens_json
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_profile", name="Profile")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.Profile")
+func newProfileResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &profileResource{}
+
+	return r, nil
+}
+
+type profileResource struct {
+	framework.ResourceWithModel[profileResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *profileResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"question": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[profileQuestionModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"question_id": schema.StringAttribute{
+							Required: true,
+						},
+						"selected_choice_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *profileResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data profileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	name := data.ProfileName.ValueString()
+	var input wellarchitected.CreateProfileInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateProfile(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Profile (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.ProfileArn)
+	profile, err := findProfileByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Profile (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Owner = fwflex.StringToFramework(ctx, profile.Owner)
+	data.ProfileARN = fwflex.StringValueToFramework(ctx, arn)
+	data.ProfileVersion = fwflex.StringToFramework(ctx, output.ProfileVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *profileResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data profileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.ProfileARN.ValueString()
+	output, err := findProfileByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Profile (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Only questions with selected choices are tracked.
+	output.ProfileQuestions = tfslices.Filter(output.ProfileQuestions, func(v awstypes.ProfileQuestion) bool {
+		return len(v.SelectedChoiceIds) > 0
+	})
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *profileResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old profileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	arn := new.ProfileARN.ValueString()
+
+	if diff.HasChanges() {
+		var input wellarchitected.UpdateProfileInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Questions removed from configuration have their selected choices cleared.
+		newQuestions, d := new.ProfileQuestions.ToSlice(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		oldQuestions, d := old.ProfileQuestions.ToSlice(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		for _, v := range oldQuestions {
+			if !tfslices.Any(newQuestions, func(q *profileQuestionModel) bool {
+				return q.QuestionID.Equal(v.QuestionID)
+			}) {
+				input.ProfileQuestions = append(input.ProfileQuestions, awstypes.ProfileQuestionUpdate{
+					QuestionId:        fwflex.StringFromFramework(ctx, v.QuestionID),
+					SelectedChoiceIds: []string{},
+				})
+			}
+		}
+
+		_, err := conn.UpdateProfile(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Profile (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	profile, err := findProfileByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Profile (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	new.ProfileVersion = fwflex.StringToFramework(ctx, profile.ProfileVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *profileResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data profileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.ProfileARN.ValueString()
+	input := wellarchitected.DeleteProfileInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		ProfileArn:         aws.String(arn),
+	}
+	_, err := conn.DeleteProfile(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Profile (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func findProfileByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.Profile, error) {
+	input := wellarchitected.GetProfileInput{
+		ProfileArn: aws.String(arn),
+	}
+	output, err := conn.GetProfile(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Profile == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Profile, nil
+}
+
+type profileResourceModel struct {
+	framework.WithRegionModel
+	Owner              types.String                                         `tfsdk:"owner"`
+	ProfileARN         types.String                                         `tfsdk:"arn"`
+	ProfileDescription types.String                                         `tfsdk:"description"`
+	ProfileName        types.String                                         `tfsdk:"name"`
+	ProfileVersion     types.String                                         `tfsdk:"profile_version"`
+	ProfileQuestions   fwtypes.SetNestedObjectValueOf[profileQuestionModel] `tfsdk:"question"`
+	Tags               tftags.Map                                           `tfsdk:"tags"`
+	TagsAll            tftags.Map                                           `tfsdk:"tags_all"`
+}
+
+type profileQuestionModel struct {
+	QuestionID        types.String        `tfsdk:"question_id"`
+	SelectedChoiceIDs fwtypes.SetOfString `tfsdk:"selected_choice_ids"`
+}
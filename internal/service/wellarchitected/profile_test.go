@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	questionID := acctest.SkipIfEnvVarNotSet(t, "AWS_WELLARCHITECTED_PROFILE_QUESTION_ID")
+	choiceID := acctest.SkipIfEnvVarNotSet(t, "AWS_WELLARCHITECTED_PROFILE_CHOICE_ID")
+	var v awstypes.Profile
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_profile.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_basic(rName, "Test profile", questionID, choiceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, t, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "wellarchitected", regexache.MustCompile(`profile/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test profile"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttrSet(resourceName, "profile_version"),
+					resource.TestCheckResourceAttr(resourceName, "question.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "question.*", map[string]string{
+						"question_id":           questionID,
+						"selected_choice_ids.#": "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	questionID := acctest.SkipIfEnvVarNotSet(t, "AWS_WELLARCHITECTED_PROFILE_QUESTION_ID")
+	choiceID := acctest.SkipIfEnvVarNotSet(t, "AWS_WELLARCHITECTED_PROFILE_CHOICE_ID")
+	var v awstypes.Profile
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_profile.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_basic(rName, "Test profile", questionID, choiceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceProfile, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedProfile_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	questionID := acctest.SkipIfEnvVarNotSet(t, "AWS_WELLARCHITECTED_PROFILE_QUESTION_ID")
+	choiceID := acctest.SkipIfEnvVarNotSet(t, "AWS_WELLARCHITECTED_PROFILE_CHOICE_ID")
+	var v awstypes.Profile
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_profile.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_basic(rName, "Test profile", questionID, choiceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test profile"),
+				),
+			},
+			{
+				Config: testAccProfileConfig_basic(rName, "Updated test profile", questionID, choiceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated test profile"),
+					resource.TestCheckResourceAttrSet(resourceName, "profile_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedProfile_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	questionID := acctest.SkipIfEnvVarNotSet(t, "AWS_WELLARCHITECTED_PROFILE_QUESTION_ID")
+	choiceID := acctest.SkipIfEnvVarNotSet(t, "AWS_WELLARCHITECTED_PROFILE_CHOICE_ID")
+	var v awstypes.Profile
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_profile.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_tags1(rName, questionID, choiceID, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccProfileConfig_tags2(rName, questionID, choiceID, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccProfileConfig_tags1(rName, questionID, choiceID, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProfileDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_profile" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindProfileByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Profile %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProfileExists(ctx context.Context, t *testing.T, n string, v *awstypes.Profile) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindProfileByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccProfileConfig_basic(rName, description, questionID, choiceID string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_profile" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  question {
+    question_id         = %[3]q
+    selected_choice_ids = [%[4]q]
+  }
+}
+`, rName, description, questionID, choiceID)
+}
+
+func testAccProfileConfig_tags1(rName, questionID, choiceID, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_profile" "test" {
+  name        = %[1]q
+  description = "Test profile"
+
+  question {
+    question_id         = %[2]q
+    selected_choice_ids = [%[3]q]
+  }
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, rName, questionID, choiceID, tagKey1, tagValue1)
+}
+
+func testAccProfileConfig_tags2(rName, questionID, choiceID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_profile" "test" {
+  name        = %[1]q
+  description = "Test profile"
+
+  question {
+    question_id         = %[2]q
+    selected_choice_ids = [%[3]q]
+  }
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, rName, questionID, choiceID, tagKey1, tagValue1, tagKey2, tagValue2)
+}
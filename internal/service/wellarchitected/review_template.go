@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_review_template", name="Review Template")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.ReviewTemplate")
+func newReviewTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &reviewTemplateResource{}
+
+	return r, nil
+}
+
+type reviewTemplateResource struct {
+	framework.ResourceWithModel[reviewTemplateResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *reviewTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 250),
+				},
+			},
+			"lenses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2084),
+				},
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *reviewTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data reviewTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	name := data.TemplateName.ValueString()
+	var input wellarchitected.CreateReviewTemplateInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateReviewTemplate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Review Template (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.TemplateArn)
+	template, err := findReviewTemplateByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Review Template (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Owner = fwflex.StringToFramework(ctx, template.Owner)
+	data.TemplateARN = fwflex.StringValueToFramework(ctx, arn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *reviewTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data reviewTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.TemplateARN.ValueString()
+	output, err := findReviewTemplateByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Review Template (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *reviewTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old reviewTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		arn := new.TemplateARN.ValueString()
+		var input wellarchitected.UpdateReviewTemplateInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !new.Lenses.Equal(old.Lenses) {
+			newLenses, oldLenses := fwflex.ExpandFrameworkStringValueSet(ctx, new.Lenses), fwflex.ExpandFrameworkStringValueSet(ctx, old.Lenses)
+
+			input.LensesToAssociate = newLenses.Difference(oldLenses)
+			input.LensesToDisassociate = oldLenses.Difference(newLenses)
+		}
+
+		_, err := conn.UpdateReviewTemplate(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Review Template (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *reviewTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data reviewTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.TemplateARN.ValueString()
+	input := wellarchitected.DeleteReviewTemplateInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		TemplateArn:        aws.String(arn),
+	}
+	_, err := conn.DeleteReviewTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Review Template (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func findReviewTemplateByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.ReviewTemplate, error) {
+	input := wellarchitected.GetReviewTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+	output, err := conn.GetReviewTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReviewTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReviewTemplate, nil
+}
+
+type reviewTemplateResourceModel struct {
+	framework.WithRegionModel
+	Description  types.String        `tfsdk:"description"`
+	Lenses       fwtypes.SetOfString `tfsdk:"lenses"`
+	Notes        types.String        `tfsdk:"notes"`
+	Owner        types.String        `tfsdk:"owner"`
+	Tags         tftags.Map          `tfsdk:"tags"`
+	TagsAll      tftags.Map          `tfsdk:"tags_all"`
+	TemplateARN  types.String        `tfsdk:"arn"`
+	TemplateName types.String        `tfsdk:"name"`
+}
@@ -0,0 +1,487 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_workload", name="Workload")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("workload_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.Workload")
+func newWorkloadResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workloadResource{}
+
+	return r, nil
+}
+
+type workloadResource struct {
+	framework.ResourceWithModel[workloadResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *workloadResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(100),
+					setvalidator.ValueStringsAre(fwvalidators.AWSAccountID()),
+				},
+			},
+			"applications": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(1),
+				},
+			},
+			"architectural_design": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2048),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(50),
+					setvalidator.AtLeastOneOf(path.MatchRoot("non_aws_regions")),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 250),
+				},
+			},
+			names.AttrEnvironment: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadEnvironment](),
+				Required:   true,
+			},
+			"industry": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"industry_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"lenses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			"non_aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(5),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2084),
+				},
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pillar_priorities": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(1),
+				},
+			},
+			"review_owner": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"review_template_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"workload_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"discovery_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[workloadDiscoveryConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"trusted_advisor_integration_status": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.TrustedAdvisorIntegrationStatus](),
+							Optional:   true,
+						},
+						"workload_resource_definition": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringEnumType[awstypes.DefinitionType](),
+							ElementType: fwtypes.StringEnumType[awstypes.DefinitionType](),
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *workloadResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	name := data.WorkloadName.ValueString()
+	var input wellarchitected.CreateWorkloadInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWorkload(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s)", name), err.Error())
+
+		return
+	}
+
+	workloadID := aws.ToString(output.WorkloadId)
+	workload, err := findWorkloadByID(ctx, conn, workloadID)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("workload_id"), workloadID) // Set 'workload_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", workloadID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Owner = fwflex.StringToFramework(ctx, workload.Owner)
+	data.PillarPriorities = fwflex.FlattenFrameworkStringValueListOfString(ctx, workload.PillarPriorities)
+	data.WorkloadARN = fwflex.StringToFramework(ctx, output.WorkloadArn)
+	data.WorkloadID = fwflex.StringValueToFramework(ctx, workloadID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *workloadResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID := data.WorkloadID.ValueString()
+	output, err := findWorkloadByID(ctx, conn, workloadID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", workloadID), err.Error())
+
+		return
+	}
+
+	// The API always returns a discovery configuration, so only refresh it when it's been configured.
+	var opts []fwflex.AutoFlexOptionsFunc
+	if data.DiscoveryConfig.IsNull() {
+		opts = append(opts, fwflex.WithIgnoredFieldNamesAppend("DiscoveryConfig"))
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, opts...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ProfileARNs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, tfslices.ApplyToAll(output.Profiles, func(v awstypes.WorkloadProfile) string {
+		return aws.ToString(v.ProfileArn)
+	}))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workloadResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old workloadResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID := new.WorkloadID.ValueString()
+
+	if !new.Lenses.Equal(old.Lenses) {
+		newLenses, oldLenses := fwflex.ExpandFrameworkStringValueSet(ctx, new.Lenses), fwflex.ExpandFrameworkStringValueSet(ctx, old.Lenses)
+
+		if add := newLenses.Difference(oldLenses); len(add) > 0 {
+			input := wellarchitected.AssociateLensesInput{
+				LensAliases: add,
+				WorkloadId:  aws.String(workloadID),
+			}
+			_, err := conn.AssociateLenses(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Well-Architected Workload (%s) lenses", workloadID), err.Error())
+
+				return
+			}
+		}
+
+		if del := oldLenses.Difference(newLenses); len(del) > 0 {
+			input := wellarchitected.DisassociateLensesInput{
+				LensAliases: del,
+				WorkloadId:  aws.String(workloadID),
+			}
+			_, err := conn.DisassociateLenses(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Well-Architected Workload (%s) lenses", workloadID), err.Error())
+
+				return
+			}
+		}
+	}
+
+	if !new.ProfileARNs.Equal(old.ProfileARNs) {
+		newProfiles, oldProfiles := fwflex.ExpandFrameworkStringValueSet(ctx, new.ProfileARNs), fwflex.ExpandFrameworkStringValueSet(ctx, old.ProfileARNs)
+
+		// Disassociate first as a workload can only be associated with a single profile.
+		if del := oldProfiles.Difference(newProfiles); len(del) > 0 {
+			input := wellarchitected.DisassociateProfilesInput{
+				ProfileArns: del,
+				WorkloadId:  aws.String(workloadID),
+			}
+			_, err := conn.DisassociateProfiles(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Well-Architected Workload (%s) profiles", workloadID), err.Error())
+
+				return
+			}
+		}
+
+		if add := newProfiles.Difference(oldProfiles); len(add) > 0 {
+			input := wellarchitected.AssociateProfilesInput{
+				ProfileArns: add,
+				WorkloadId:  aws.String(workloadID),
+			}
+			_, err := conn.AssociateProfiles(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Well-Architected Workload (%s) profiles", workloadID), err.Error())
+
+				return
+			}
+		}
+	}
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Lenses"), fwflex.WithIgnoredField("ProfileARNs"))
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input wellarchitected.UpdateWorkloadInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateWorkload(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Workload (%s)", workloadID), err.Error())
+
+			return
+		}
+
+		if output.Workload != nil {
+			new.PillarPriorities = fwflex.FlattenFrameworkStringValueListOfString(ctx, output.Workload.PillarPriorities)
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *workloadResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID := data.WorkloadID.ValueString()
+	input := wellarchitected.DeleteWorkloadInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		WorkloadId:         aws.String(workloadID),
+	}
+	_, err := conn.DeleteWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Workload (%s)", workloadID), err.Error())
+
+		return
+	}
+}
+
+func findWorkloadByID(ctx context.Context, conn *wellarchitected.Client, id string) (*awstypes.Workload, error) {
+	input := wellarchitected.GetWorkloadInput{
+		WorkloadId: aws.String(id),
+	}
+	output, err := conn.GetWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workload == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workload, nil
+}
+
+type workloadResourceModel struct {
+	framework.WithRegionModel
+	AccountIDs          fwtypes.SetOfString                                           `tfsdk:"account_ids"`
+	Applications        fwtypes.SetOfString                                           `tfsdk:"applications"`
+	ArchitecturalDesign types.String                                                  `tfsdk:"architectural_design"`
+	AWSRegions          fwtypes.SetOfString                                           `tfsdk:"aws_regions"`
+	Description         types.String                                                  `tfsdk:"description"`
+	DiscoveryConfig     fwtypes.ListNestedObjectValueOf[workloadDiscoveryConfigModel] `tfsdk:"discovery_config"`
+	Environment         fwtypes.StringEnum[awstypes.WorkloadEnvironment]              `tfsdk:"environment"`
+	Industry            types.String                                                  `tfsdk:"industry"`
+	IndustryType        types.String                                                  `tfsdk:"industry_type"`
+	Lenses              fwtypes.SetOfString                                           `tfsdk:"lenses"`
+	NonAWSRegions       fwtypes.SetOfString                                           `tfsdk:"non_aws_regions"`
+	Notes               types.String                                                  `tfsdk:"notes"`
+	Owner               types.String                                                  `tfsdk:"owner"`
+	PillarPriorities    fwtypes.ListOfString                                          `tfsdk:"pillar_priorities"`
+	ProfileARNs         fwtypes.SetOfString                                           `tfsdk:"profile_arns"`
+	ReviewOwner         types.String                                                  `tfsdk:"review_owner"`
+	ReviewTemplateARNs  fwtypes.SetOfString                                           `tfsdk:"review_template_arns"`
+	Tags                tftags.Map                                                    `tfsdk:"tags"`
+	TagsAll             tftags.Map                                                    `tfsdk:"tags_all"`
+	WorkloadARN         types.String                                                  `tfsdk:"arn"`
+	WorkloadID          types.String                                                  `tfsdk:"workload_id"`
+	WorkloadName        types.String                                                  `tfsdk:"name"`
+}
+
+type workloadDiscoveryConfigModel struct {
+	TrustedAdvisorIntegrationStatus fwtypes.StringEnum[awstypes.TrustedAdvisorIntegrationStatus] `tfsdk:"trusted_advisor_integration_status"`
+	WorkloadResourceDefinition      fwtypes.SetOfStringEnum[awstypes.DefinitionType]             `tfsdk:"workload_resource_definition"`
+}
@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedWorkload_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "wellarchitected", regexache.MustCompile(`workload/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "aws_regions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test workload"),
+					resource.TestCheckResourceAttr(resourceName, "discovery_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnvironment, "PREPRODUCTION"),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "lenses.*", "wellarchitected"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, "profile_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "workload_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "workload_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "workload_id",
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceWorkload, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "workload_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "workload_id",
+			},
+			{
+				Config: testAccWorkloadConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccWorkloadConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnvironment, "PREPRODUCTION"),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", "1"),
+				),
+			},
+			{
+				Config: testAccWorkloadConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated test workload"),
+					resource.TestCheckResourceAttr(resourceName, "discovery_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "discovery_config.0.trusted_advisor_integration_status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnvironment, "PRODUCTION"),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "lenses.*", "wellarchitected"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "lenses.*", "serverless"),
+					resource.TestCheckResourceAttr(resourceName, "notes", "Reviewed quarterly"),
+					resource.TestCheckResourceAttr(resourceName, "review_owner", "architecture-review-board@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkloadDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_workload" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindWorkloadByID(ctx, conn, rs.Primary.Attributes["workload_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Workload %s still exists", rs.Primary.Attributes["workload_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkloadExists(ctx context.Context, t *testing.T, n string, v *awstypes.Workload) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindWorkloadByID(ctx, conn, rs.Primary.Attributes["workload_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccWorkloadConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  name        = %[1]q
+  description = "Test workload"
+  environment = "PREPRODUCTION"
+  lenses      = ["wellarchitected"]
+  aws_regions = [data.aws_region.current.region]
+}
+`, rName)
+}
+
+func testAccWorkloadConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  name         = %[1]q
+  description  = "Updated test workload"
+  environment  = "PRODUCTION"
+  lenses       = ["wellarchitected", "serverless"]
+  aws_regions  = [data.aws_region.current.region]
+  notes        = "Reviewed quarterly"
+  review_owner = "architecture-review-board@example.com"
+
+  discovery_config {
+    trusted_advisor_integration_status = "DISABLED"
+  }
+}
+`, rName)
+}
+
+func testAccWorkloadConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  name        = %[1]q
+  description = "Test workload"
+  environment = "PREPRODUCTION"
+  lenses      = ["wellarchitected"]
+  aws_regions = [data.aws_region.current.region]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccWorkloadConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  name        = %[1]q
+  description = "Test workload"
+  environment = "PREPRODUCTION"
+  lenses      = ["wellarchitected"]
+  aws_regions = [data.aws_region.current.region]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_access_control_rule", name="Access Control Rule")
+// @IdentityAttribute("organization_id")
+// @IdentityAttribute("name")
+// @ImportIDHandler("accessControlRuleImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/workmail/types;awstypes;awstypes.AccessControlRule")
+func newAccessControlRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &accessControlRuleResource{}
+
+	return r, nil
+}
+
+type accessControlRuleResource struct {
+	framework.ResourceWithModel[accessControlRuleResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *accessControlRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	stringSet := func(conflictsWith string) schema.SetAttribute {
+		return schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.ConflictsWith(path.MatchRoot(conflictsWith)),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrActions: stringSet("not_actions"),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"effect": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AccessControlRuleEffect](),
+				Required:   true,
+			},
+			"impersonation_role_ids": stringSet("not_impersonation_role_ids"),
+			"ip_ranges":              stringSet("not_ip_ranges"),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"not_actions":                stringSet(names.AttrActions),
+			"not_impersonation_role_ids": stringSet("impersonation_role_ids"),
+			"not_ip_ranges":              stringSet("ip_ranges"),
+			"not_user_ids":               stringSet("user_ids"),
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": stringSet("not_user_ids"),
+		},
+	}
+}
+
+func (r *accessControlRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data accessControlRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	var input workmail.PutAccessControlRuleInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutAccessControlRule(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail Access Control Rule (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *accessControlRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data accessControlRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, name := data.OrganizationID.ValueString(), data.Name.ValueString()
+	output, err := findAccessControlRuleByTwoPartKey(ctx, conn, organizationID, name)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Access Control Rule (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *accessControlRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new accessControlRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := new.Name.ValueString()
+	var input workmail.PutAccessControlRuleInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutAccessControlRule(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail Access Control Rule (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *accessControlRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data accessControlRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	input := workmail.DeleteAccessControlRuleInput{
+		Name:           aws.String(name),
+		OrganizationId: data.OrganizationID.ValueStringPointer(),
+	}
+	_, err := conn.DeleteAccessControlRule(ctx, &input)
+
+	if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkMail Access Control Rule (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func findAccessControlRuleByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, name string) (*awstypes.AccessControlRule, error) {
+	input := workmail.ListAccessControlRulesInput{
+		OrganizationId: aws.String(organizationID),
+	}
+	output, err := conn.ListAccessControlRules(ctx, &input)
+
+	if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(output.Rules, func(v awstypes.AccessControlRule) bool {
+		return aws.ToString(v.Name) == name
+	}))
+}
+
+var _ inttypes.ImportIDParser = accessControlRuleImportID{}
+
+type accessControlRuleImportID struct{}
+
+func (accessControlRuleImportID) Parse(id string) (string, map[string]string, error) {
+	return parseOrganizationScopedImportID(id, names.AttrName)
+}
+
+type accessControlRuleResourceModel struct {
+	framework.WithRegionModel
+	Actions                 fwtypes.SetOfString                                  `tfsdk:"actions"`
+	Description             types.String                                         `tfsdk:"description"`
+	Effect                  fwtypes.StringEnum[awstypes.AccessControlRuleEffect] `tfsdk:"effect"`
+	ImpersonationRoleIDs    fwtypes.SetOfString                                  `tfsdk:"impersonation_role_ids"`
+	IPRanges                fwtypes.SetOfString                                  `tfsdk:"ip_ranges"`
+	Name                    types.String                                         `tfsdk:"name"`
+	NotActions              fwtypes.SetOfString                                  `tfsdk:"not_actions"`
+	NotImpersonationRoleIDs fwtypes.SetOfString                                  `tfsdk:"not_impersonation_role_ids"`
+	NotIPRanges             fwtypes.SetOfString                                  `tfsdk:"not_ip_ranges"`
+	NotUserIDs              fwtypes.SetOfString                                  `tfsdk:"not_user_ids"`
+	OrganizationID          types.String                                         `tfsdk:"organization_id"`
+	UserIDs                 fwtypes.SetOfString                                  `tfsdk:"user_ids"`
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkMailAccessControlRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AccessControlRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_access_control_rule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessControlRuleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessControlRuleConfig_basic(rName, "Deny outside corporate network", "10.0.0.0/8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessControlRuleExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrActions+".#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, names.AttrActions+".*", "IMAP"),
+					resource.TestCheckTypeSetElemAttr(resourceName, names.AttrActions+".*", "SMTP"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Deny outside corporate network"),
+					resource.TestCheckResourceAttr(resourceName, "effect", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "not_ip_ranges.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "not_ip_ranges.*", "10.0.0.0/8"),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", "aws_workmail_organization.test", "organization_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "organization_id", names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccWorkMailAccessControlRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AccessControlRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_access_control_rule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessControlRuleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessControlRuleConfig_basic(rName, "Deny outside corporate network", "10.0.0.0/8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessControlRuleExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceAccessControlRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkMailAccessControlRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AccessControlRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_access_control_rule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessControlRuleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessControlRuleConfig_basic(rName, "Deny outside corporate network", "10.0.0.0/8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessControlRuleExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Deny outside corporate network"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "not_ip_ranges.*", "10.0.0.0/8"),
+				),
+			},
+			{
+				Config: testAccAccessControlRuleConfig_basic(rName, "Deny outside office network", "192.168.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessControlRuleExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Deny outside office network"),
+					resource.TestCheckResourceAttr(resourceName, "not_ip_ranges.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "not_ip_ranges.*", "192.168.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessControlRuleDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_access_control_rule" {
+				continue
+			}
+
+			_, err := tfworkmail.FindAccessControlRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes[names.AttrName])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Access Control Rule %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessControlRuleExists(ctx context.Context, t *testing.T, n string, v *awstypes.AccessControlRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		output, err := tfworkmail.FindAccessControlRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAccessControlRuleConfig_basic(rName, description, ipRange string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_access_control_rule" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  name            = %[1]q
+  description     = %[2]q
+  effect          = "DENY"
+
+  actions       = ["IMAP", "SMTP"]
+  not_ip_ranges = [%[3]q]
+}
+`, rName, description, ipRange))
+}
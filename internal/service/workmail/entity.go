@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Users, groups and resources are all WorkMail entities that are given a mailbox by registering them with an email address.
+
+const (
+	entityStateTimeout = 5 * time.Minute
+)
+
+func registerEntity(ctx context.Context, conn *workmail.Client, organizationID, entityID, email string) error {
+	input := workmail.RegisterToWorkMailInput{
+		Email:          aws.String(email),
+		EntityId:       aws.String(entityID),
+		OrganizationId: aws.String(organizationID),
+	}
+	_, err := conn.RegisterToWorkMail(ctx, &input)
+
+	return err
+}
+
+func deregisterEntity(ctx context.Context, conn *workmail.Client, organizationID, entityID string) error {
+	input := workmail.DeregisterFromWorkMailInput{
+		EntityId:       aws.String(entityID),
+		OrganizationId: aws.String(organizationID),
+	}
+	_, err := conn.DeregisterFromWorkMail(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+// updateEntityEmail registers, re-addresses or deregisters an entity to reflect a change in its configured email address.
+func updateEntityEmail(ctx context.Context, conn *workmail.Client, organizationID, entityID string, old, new types.String) error {
+	switch {
+	case new.Equal(old):
+		return nil
+	case new.IsNull():
+		return deregisterEntity(ctx, conn, organizationID, entityID)
+	case old.IsNull():
+		return registerEntity(ctx, conn, organizationID, entityID, new.ValueString())
+	default:
+		input := workmail.UpdatePrimaryEmailAddressInput{
+			Email:          new.ValueStringPointer(),
+			EntityId:       aws.String(entityID),
+			OrganizationId: aws.String(organizationID),
+		}
+		_, err := conn.UpdatePrimaryEmailAddress(ctx, &input)
+
+		return err
+	}
+}
+
+// deleteEntity deregisters an entity and then deletes it, retrying while the deregistration completes.
+func deleteEntity(ctx context.Context, conn *workmail.Client, organizationID, entityID string, email types.String, f func(context.Context) error) error {
+	if !email.IsNull() {
+		if err := deregisterEntity(ctx, conn, organizationID, entityID); err != nil {
+			return err
+		}
+	}
+
+	_, err := tfresource.RetryWhenIsA[any, *awstypes.EntityStateException](ctx, entityStateTimeout, func(ctx context.Context) (any, error) {
+		return nil, f(ctx)
+	})
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
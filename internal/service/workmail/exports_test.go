@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+// Exports for use in tests only.
+var (
+	ResourceAccessControlRule      = newAccessControlRuleResource
+	ResourceGroup                  = newGroupResource
+	ResourceGroupMember            = newGroupMemberResource
+	ResourceMobileDeviceAccessRule = newMobileDeviceAccessRuleResource
+	ResourceOrganization           = newOrganizationResource
+	ResourceResource               = newResourceResource
+	ResourceUser                   = newUserResource
+
+	FindAccessControlRuleByTwoPartKey      = findAccessControlRuleByTwoPartKey
+	FindGroupByTwoPartKey                  = findGroupByTwoPartKey
+	FindGroupMemberByThreePartKey          = findGroupMemberByThreePartKey
+	FindMobileDeviceAccessRuleByTwoPartKey = findMobileDeviceAccessRuleByTwoPartKey
+	FindOrganizationByID                   = findOrganizationByID
+	FindResourceByTwoPartKey               = findResourceByTwoPartKey
+	FindUserByTwoPartKey                   = findUserByTwoPartKey
+)
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_group", name="Group")
+// @IdentityAttribute("organization_id")
+// @IdentityAttribute("group_id")
+// @ImportIDHandler("groupImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/workmail;workmail.DescribeGroupOutput")
+func newGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &groupResource{}
+
+	return r, nil
+}
+
+type groupResource struct {
+	framework.ResourceWithModel[groupResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *groupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrEmail: schema.StringAttribute{
+				Optional: true,
+			},
+			"group_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hidden_from_global_address_list": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EntityState](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *groupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data groupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	var input workmail.CreateGroupInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateGroup(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail Group (%s)", name), err.Error())
+
+		return
+	}
+
+	organizationID, groupID := data.OrganizationID.ValueString(), aws.ToString(output.GroupId)
+
+	if !data.Email.IsNull() {
+		if err := registerEntity(ctx, conn, organizationID, groupID, data.Email.ValueString()); err != nil {
+			response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'group_id' so as to taint the resource.
+			response.State.SetAttribute(ctx, path.Root("group_id"), groupID)
+			response.Diagnostics.AddError(fmt.Sprintf("registering WorkMail Group (%s)", groupID), err.Error())
+
+			return
+		}
+	}
+
+	group, err := findGroupByTwoPartKey(ctx, conn, organizationID, groupID)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'group_id' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root("group_id"), groupID)
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Group (%s)", groupID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.GroupID = fwflex.StringValueToFramework(ctx, groupID)
+	data.State = fwtypes.StringEnumValue(group.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *groupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data groupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, groupID := data.OrganizationID.ValueString(), data.GroupID.ValueString()
+	output, err := findGroupByTwoPartKey(ctx, conn, organizationID, groupID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Group (%s)", groupID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *groupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old groupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, groupID := new.OrganizationID.ValueString(), new.GroupID.ValueString()
+
+	if !new.HiddenFromGlobalAddressList.Equal(old.HiddenFromGlobalAddressList) {
+		input := workmail.UpdateGroupInput{
+			GroupId:                     aws.String(groupID),
+			HiddenFromGlobalAddressList: fwflex.BoolFromFramework(ctx, new.HiddenFromGlobalAddressList),
+			OrganizationId:              aws.String(organizationID),
+		}
+		_, err := conn.UpdateGroup(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail Group (%s)", groupID), err.Error())
+
+			return
+		}
+	}
+
+	if err := updateEntityEmail(ctx, conn, organizationID, groupID, old.Email, new.Email); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail Group (%s) email", groupID), err.Error())
+
+		return
+	}
+
+	group, err := findGroupByTwoPartKey(ctx, conn, organizationID, groupID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Group (%s)", groupID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	new.State = fwtypes.StringEnumValue(group.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *groupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data groupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, groupID := data.OrganizationID.ValueString(), data.GroupID.ValueString()
+	err := deleteEntity(ctx, conn, organizationID, groupID, data.Email, func(ctx context.Context) error {
+		input := workmail.DeleteGroupInput{
+			GroupId:        aws.String(groupID),
+			OrganizationId: aws.String(organizationID),
+		}
+		_, err := conn.DeleteGroup(ctx, &input)
+
+		return err
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkMail Group (%s)", groupID), err.Error())
+
+		return
+	}
+}
+
+func findGroupByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, groupID string) (*workmail.DescribeGroupOutput, error) {
+	input := workmail.DescribeGroupInput{
+		GroupId:        aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	}
+	output, err := conn.DescribeGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.State; state == awstypes.EntityStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message: string(state),
+		}
+	}
+
+	return output, nil
+}
+
+var _ inttypes.ImportIDParser = groupImportID{}
+
+type groupImportID struct{}
+
+func (groupImportID) Parse(id string) (string, map[string]string, error) {
+	return parseOrganizationScopedImportID(id, "group_id")
+}
+
+type groupResourceModel struct {
+	framework.WithRegionModel
+	Email                       types.String                             `tfsdk:"email"`
+	GroupID                     types.String                             `tfsdk:"group_id"`
+	HiddenFromGlobalAddressList types.Bool                               `tfsdk:"hidden_from_global_address_list"`
+	Name                        types.String                             `tfsdk:"name"`
+	OrganizationID              types.String                             `tfsdk:"organization_id"`
+	State                       fwtypes.StringEnum[awstypes.EntityState] `tfsdk:"state"`
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @FrameworkResource("aws_workmail_group_member", name="Group Member")
+// @IdentityAttribute("organization_id")
+// @IdentityAttribute("group_id")
+// @IdentityAttribute("member_id")
+// @ImportIDHandler("groupMemberImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/workmail/types;awstypes;awstypes.Member")
+func newGroupMemberResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &groupMemberResource{}
+
+	return r, nil
+}
+
+type groupMemberResource struct {
+	framework.ResourceWithModel[groupMemberResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByIdentity
+}
+
+func (r *groupMemberResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MemberType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *groupMemberResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data groupMemberResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, groupID, memberID := data.OrganizationID.ValueString(), data.GroupID.ValueString(), data.MemberID.ValueString()
+	var input workmail.AssociateMemberToGroupInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.AssociateMemberToGroup(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail Group (%s) Member (%s)", groupID, memberID), err.Error())
+
+		return
+	}
+
+	member, err := findGroupMemberByThreePartKey(ctx, conn, organizationID, groupID, memberID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Group (%s) Member (%s)", groupID, memberID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.MemberType = fwtypes.StringEnumValue(member.Type)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *groupMemberResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data groupMemberResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, groupID, memberID := data.OrganizationID.ValueString(), data.GroupID.ValueString(), data.MemberID.ValueString()
+	output, err := findGroupMemberByThreePartKey(ctx, conn, organizationID, groupID, memberID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Group (%s) Member (%s)", groupID, memberID), err.Error())
+
+		return
+	}
+
+	data.MemberType = fwtypes.StringEnumValue(output.Type)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *groupMemberResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data groupMemberResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, groupID, memberID := data.OrganizationID.ValueString(), data.GroupID.ValueString(), data.MemberID.ValueString()
+	input := workmail.DisassociateMemberFromGroupInput{
+		GroupId:        aws.String(groupID),
+		MemberId:       aws.String(memberID),
+		OrganizationId: aws.String(organizationID),
+	}
+	_, err := conn.DisassociateMemberFromGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkMail Group (%s) Member (%s)", groupID, memberID), err.Error())
+
+		return
+	}
+}
+
+func findGroupMemberByThreePartKey(ctx context.Context, conn *workmail.Client, organizationID, groupID, memberID string) (*awstypes.Member, error) {
+	input := workmail.ListGroupMembersInput{
+		GroupId:        aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	}
+	output, err := findGroupMembers(ctx, conn, &input, func(v *awstypes.Member) bool {
+		return aws.ToString(v.Id) == memberID && v.State != awstypes.EntityStateDeleted
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findGroupMembers(ctx context.Context, conn *workmail.Client, input *workmail.ListGroupMembersInput, filter tfslices.Predicate[*awstypes.Member]) ([]awstypes.Member, error) {
+	var output []awstypes.Member
+
+	pages := workmail.NewListGroupMembersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, tfslices.Filter(page.Members, tfslices.PredicateValue(filter))...)
+	}
+
+	return output, nil
+}
+
+var _ inttypes.ImportIDParser = groupMemberImportID{}
+
+type groupMemberImportID struct{}
+
+func (groupMemberImportID) Parse(id string) (string, map[string]string, error) {
+	return parseOrganizationScopedImportID(id, "group_id", "member_id")
+}
+
+type groupMemberResourceModel struct {
+	framework.WithRegionModel
+	GroupID        types.String                            `tfsdk:"group_id"`
+	MemberID       types.String                            `tfsdk:"member_id"`
+	MemberType     fwtypes.StringEnum[awstypes.MemberType] `tfsdk:"member_type"`
+	OrganizationID types.String                            `tfsdk:"organization_id"`
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkMailGroupMember_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Member
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_group_member.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMemberDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMemberConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupMemberExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", "aws_workmail_group.test", "group_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "member_id", "aws_workmail_user.test", "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "member_type", "USER"),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", "aws_workmail_organization.test", "organization_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "organization_id", "group_id", "member_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "member_id",
+			},
+		},
+	})
+}
+
+func TestAccWorkMailGroupMember_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Member
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_group_member.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMemberDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMemberConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupMemberExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceGroupMember, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMemberDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_group_member" {
+				continue
+			}
+
+			_, err := tfworkmail.FindGroupMemberByThreePartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["group_id"], rs.Primary.Attributes["member_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Group (%s) Member %s still exists", rs.Primary.Attributes["group_id"], rs.Primary.Attributes["member_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupMemberExists(ctx context.Context, t *testing.T, n string, v *awstypes.Member) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		output, err := tfworkmail.FindGroupMemberByThreePartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["group_id"], rs.Primary.Attributes["member_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGroupMemberConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_group" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  name            = %[1]q
+}
+
+resource "aws_workmail_user" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  name            = %[1]q
+  display_name    = %[1]q
+}
+
+resource "aws_workmail_group_member" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  group_id        = aws_workmail_group.test.group_id
+  member_id       = aws_workmail_user.test.user_id
+}
+`, rName))
+}
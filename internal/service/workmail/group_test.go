@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkMailGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeGroupOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_group.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrEmail),
+					resource.TestCheckResourceAttrSet(resourceName, "group_id"),
+					resource.TestCheckResourceAttr(resourceName, "hidden_from_global_address_list", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", "aws_workmail_organization.test", "organization_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "DISABLED"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "organization_id", "group_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group_id",
+			},
+		},
+	})
+}
+
+func TestAccWorkMailGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeGroupOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_group.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceGroup, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkMailGroup_email(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeGroupOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_group.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_email(rName, "group1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEmail),
+					resource.TestCheckResourceAttr(resourceName, "hidden_from_global_address_list", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ENABLED"),
+				),
+			},
+			{
+				Config: testAccGroupConfig_email(rName, "group2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEmail),
+					resource.TestCheckResourceAttr(resourceName, "hidden_from_global_address_list", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ENABLED"),
+				),
+			},
+			{
+				Config: testAccGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrEmail),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_group" {
+				continue
+			}
+
+			_, err := tfworkmail.FindGroupByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["group_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Group %s still exists", rs.Primary.Attributes["group_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupExists(ctx context.Context, t *testing.T, n string, v *workmail.DescribeGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		output, err := tfworkmail.FindGroupByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["group_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGroupConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_group" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  name            = %[1]q
+}
+`, rName))
+}
+
+func testAccGroupConfig_email(rName, localPart string, hidden bool) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_group" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  name            = %[1]q
+  email           = "%[2]s@${aws_workmail_organization.test.default_mail_domain}"
+
+  hidden_from_global_address_list = %[3]t
+}
+`, rName, localPart, hidden))
+}
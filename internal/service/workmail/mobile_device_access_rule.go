@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_mobile_device_access_rule", name="Mobile Device Access Rule")
+// @IdentityAttribute("organization_id")
+// @IdentityAttribute("mobile_device_access_rule_id")
+// @ImportIDHandler("mobileDeviceAccessRuleImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/workmail/types;awstypes;awstypes.MobileDeviceAccessRule")
+func newMobileDeviceAccessRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &mobileDeviceAccessRuleResource{}
+
+	return r, nil
+}
+
+type mobileDeviceAccessRuleResource struct {
+	framework.ResourceWithModel[mobileDeviceAccessRuleResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *mobileDeviceAccessRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	stringSet := func(conflictsWith string) schema.SetAttribute {
+		return schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeBetween(1, 10),
+				setvalidator.ConflictsWith(path.MatchRoot(conflictsWith)),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"device_models":            stringSet("not_device_models"),
+			"device_operating_systems": stringSet("not_device_operating_systems"),
+			"device_types":             stringSet("not_device_types"),
+			"device_user_agents":       stringSet("not_device_user_agents"),
+			"effect": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MobileDeviceAccessRuleEffect](),
+				Required:   true,
+			},
+			"mobile_device_access_rule_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"not_device_models":            stringSet("device_models"),
+			"not_device_operating_systems": stringSet("device_operating_systems"),
+			"not_device_types":             stringSet("device_types"),
+			"not_device_user_agents":       stringSet("device_user_agents"),
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *mobileDeviceAccessRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data mobileDeviceAccessRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	var input workmail.CreateMobileDeviceAccessRuleInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+
+	output, err := conn.CreateMobileDeviceAccessRule(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail Mobile Device Access Rule (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.MobileDeviceAccessRuleID = fwflex.StringToFramework(ctx, output.MobileDeviceAccessRuleId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *mobileDeviceAccessRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data mobileDeviceAccessRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, ruleID := data.OrganizationID.ValueString(), data.MobileDeviceAccessRuleID.ValueString()
+	output, err := findMobileDeviceAccessRuleByTwoPartKey(ctx, conn, organizationID, ruleID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Mobile Device Access Rule (%s)", ruleID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *mobileDeviceAccessRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new mobileDeviceAccessRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	ruleID := new.MobileDeviceAccessRuleID.ValueString()
+	var input workmail.UpdateMobileDeviceAccessRuleInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdateMobileDeviceAccessRule(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail Mobile Device Access Rule (%s)", ruleID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *mobileDeviceAccessRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data mobileDeviceAccessRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	ruleID := data.MobileDeviceAccessRuleID.ValueString()
+	input := workmail.DeleteMobileDeviceAccessRuleInput{
+		MobileDeviceAccessRuleId: aws.String(ruleID),
+		OrganizationId:           data.OrganizationID.ValueStringPointer(),
+	}
+	_, err := conn.DeleteMobileDeviceAccessRule(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkMail Mobile Device Access Rule (%s)", ruleID), err.Error())
+
+		return
+	}
+}
+
+func findMobileDeviceAccessRuleByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, ruleID string) (*awstypes.MobileDeviceAccessRule, error) {
+	input := workmail.ListMobileDeviceAccessRulesInput{
+		OrganizationId: aws.String(organizationID),
+	}
+	output, err := conn.ListMobileDeviceAccessRules(ctx, &input)
+
+	if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(output.Rules, func(v awstypes.MobileDeviceAccessRule) bool {
+		return aws.ToString(v.MobileDeviceAccessRuleId) == ruleID
+	}))
+}
+
+var _ inttypes.ImportIDParser = mobileDeviceAccessRuleImportID{}
+
+type mobileDeviceAccessRuleImportID struct{}
+
+func (mobileDeviceAccessRuleImportID) Parse(id string) (string, map[string]string, error) {
+	return parseOrganizationScopedImportID(id, "mobile_device_access_rule_id")
+}
+
+type mobileDeviceAccessRuleResourceModel struct {
+	framework.WithRegionModel
+	Description               types.String                                              `tfsdk:"description"`
+	DeviceModels              fwtypes.SetOfString                                       `tfsdk:"device_models"`
+	DeviceOperatingSystems    fwtypes.SetOfString                                       `tfsdk:"device_operating_systems"`
+	DeviceTypes               fwtypes.SetOfString                                       `tfsdk:"device_types"`
+	DeviceUserAgents          fwtypes.SetOfString                                       `tfsdk:"device_user_agents"`
+	Effect                    fwtypes.StringEnum[awstypes.MobileDeviceAccessRuleEffect] `tfsdk:"effect"`
+	MobileDeviceAccessRuleID  types.String                                              `tfsdk:"mobile_device_access_rule_id"`
+	Name                      types.String                                              `tfsdk:"name"`
+	NotDeviceModels           fwtypes.SetOfString                                       `tfsdk:"not_device_models"`
+	NotDeviceOperatingSystems fwtypes.SetOfString                                       `tfsdk:"not_device_operating_systems"`
+	NotDeviceTypes            fwtypes.SetOfString                                       `tfsdk:"not_device_types"`
+	NotDeviceUserAgents       fwtypes.SetOfString                                       `tfsdk:"not_device_user_agents"`
+	OrganizationID            types.String                                              `tfsdk:"organization_id"`
+}
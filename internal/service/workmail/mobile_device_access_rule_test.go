@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkMailMobileDeviceAccessRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.MobileDeviceAccessRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_mobile_device_access_rule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMobileDeviceAccessRuleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMobileDeviceAccessRuleConfig_basic(rName, "ALLOW", "iPhone"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMobileDeviceAccessRuleExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "device_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "device_types.*", "iPhone"),
+					resource.TestCheckResourceAttr(resourceName, "effect", "ALLOW"),
+					resource.TestCheckResourceAttrSet(resourceName, "mobile_device_access_rule_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", "aws_workmail_organization.test", "organization_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "organization_id", "mobile_device_access_rule_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "mobile_device_access_rule_id",
+			},
+		},
+	})
+}
+
+func TestAccWorkMailMobileDeviceAccessRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.MobileDeviceAccessRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_mobile_device_access_rule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMobileDeviceAccessRuleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMobileDeviceAccessRuleConfig_basic(rName, "ALLOW", "iPhone"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMobileDeviceAccessRuleExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceMobileDeviceAccessRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkMailMobileDeviceAccessRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.MobileDeviceAccessRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_mobile_device_access_rule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMobileDeviceAccessRuleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMobileDeviceAccessRuleConfig_basic(rName, "ALLOW", "iPhone"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMobileDeviceAccessRuleExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "effect", "ALLOW"),
+				),
+			},
+			{
+				Config: testAccMobileDeviceAccessRuleConfig_full(rName, "Block Android devices", "Android"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMobileDeviceAccessRuleExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Block Android devices"),
+					resource.TestCheckResourceAttr(resourceName, "device_types.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "effect", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "device_operating_systems.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "device_operating_systems.*", "Android"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMobileDeviceAccessRuleDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_mobile_device_access_rule" {
+				continue
+			}
+
+			_, err := tfworkmail.FindMobileDeviceAccessRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["mobile_device_access_rule_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Mobile Device Access Rule %s still exists", rs.Primary.Attributes["mobile_device_access_rule_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMobileDeviceAccessRuleExists(ctx context.Context, t *testing.T, n string, v *awstypes.MobileDeviceAccessRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		output, err := tfworkmail.FindMobileDeviceAccessRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["mobile_device_access_rule_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMobileDeviceAccessRuleConfig_basic(rName, effect, deviceType string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_mobile_device_access_rule" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  name            = %[1]q
+  effect          = %[2]q
+
+  device_types = [%[3]q]
+}
+`, rName, effect, deviceType))
+}
+
+func testAccMobileDeviceAccessRuleConfig_full(rName, description, operatingSystem string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_mobile_device_access_rule" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  name            = %[1]q
+  description     = %[2]q
+  effect          = "DENY"
+
+  device_operating_systems = [%[3]q]
+}
+`, rName, description, operatingSystem))
+}
@@ -0,0 +1,563 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	organizationStateActive    = "Active"
+	organizationStateCreating  = "Creating"
+	organizationStateDeleted   = "Deleted"
+	organizationStateDeleting  = "Deleting"
+	organizationStateFailed    = "Failed"
+	organizationStateRequested = "Requested"
+)
+
+// @FrameworkResource("aws_workmail_organization", name="Organization")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("organization_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/workmail;workmail.DescribeOrganizationOutput")
+// @Testing(importIgnore="delete_directory;kms_key_arn")
+func newOrganizationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &organizationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type organizationResource struct {
+	framework.ResourceWithModel[organizationResourceModel]
+	framework.WithImportByIdentity
+	framework.WithTimeouts
+}
+
+func (r *organizationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alias": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 62),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"default_mail_domain": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_directory": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"directory_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"directory_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_interoperability": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrDomain: schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[organizationDomainModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDomainName: schema.StringAttribute{
+							Required: true,
+						},
+						"hosted_zone_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *organizationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data organizationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	alias := data.Alias.ValueString()
+	var input workmail.CreateOrganizationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+
+	output, err := conn.CreateOrganization(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail Organization (%s)", alias), err.Error())
+
+		return
+	}
+
+	organizationID := aws.ToString(output.OrganizationId)
+	organization, err := waitOrganizationCreated(ctx, conn, organizationID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkMail Organization (%s) create", organizationID), err.Error())
+
+		return
+	}
+
+	if err := createTags(ctx, conn, aws.ToString(organization.ARN), getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("setting WorkMail Organization (%s) tags", organizationID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, organization.ARN)
+	data.DefaultMailDomain = fwflex.StringToFramework(ctx, organization.DefaultMailDomain)
+	data.DirectoryID = fwflex.StringToFramework(ctx, organization.DirectoryId)
+	data.DirectoryType = fwflex.StringToFramework(ctx, organization.DirectoryType)
+	data.OrganizationID = fwflex.StringValueToFramework(ctx, organizationID)
+	data.State = fwflex.StringToFramework(ctx, organization.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *organizationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data organizationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID := data.OrganizationID.ValueString()
+	output, err := findOrganizationByID(ctx, conn, organizationID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Organization (%s)", organizationID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.EnableInteroperability = fwflex.BoolValueToFramework(ctx, output.InteroperabilityEnabled)
+
+	mailDomains, err := findMailDomainsByOrganizationID(ctx, conn, organizationID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Organization (%s) mail domains", organizationID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenOrganizationDomains(ctx, &data, aws.ToString(output.Alias), mailDomains)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *organizationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old organizationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID := new.OrganizationID.ValueString()
+
+	if !new.Domains.Equal(old.Domains) {
+		newDomains, d := new.Domains.ToSlice(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		oldDomains, d := old.Domains.ToSlice(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for _, v := range oldDomains {
+			if tfslices.Any(newDomains, func(d *organizationDomainModel) bool {
+				return d.DomainName.Equal(v.DomainName)
+			}) {
+				continue
+			}
+
+			domainName := v.DomainName.ValueString()
+			input := workmail.DeregisterMailDomainInput{
+				DomainName:     aws.String(domainName),
+				OrganizationId: aws.String(organizationID),
+			}
+			_, err := conn.DeregisterMailDomain(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("deregistering WorkMail Organization (%s) mail domain (%s)", organizationID, domainName), err.Error())
+
+				return
+			}
+		}
+
+		for _, v := range newDomains {
+			if tfslices.Any(oldDomains, func(d *organizationDomainModel) bool {
+				return d.DomainName.Equal(v.DomainName)
+			}) {
+				continue
+			}
+
+			domainName := v.DomainName.ValueString()
+			input := workmail.RegisterMailDomainInput{
+				ClientToken:    aws.String(sdkid.UniqueId()),
+				DomainName:     aws.String(domainName),
+				OrganizationId: aws.String(organizationID),
+			}
+			_, err := conn.RegisterMailDomain(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("registering WorkMail Organization (%s) mail domain (%s)", organizationID, domainName), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *organizationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data organizationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID := data.OrganizationID.ValueString()
+	input := workmail.DeleteOrganizationInput{
+		ClientToken:     aws.String(sdkid.UniqueId()),
+		DeleteDirectory: data.DeleteDirectory.ValueBool(),
+		OrganizationId:  aws.String(organizationID),
+	}
+	_, err := conn.DeleteOrganization(ctx, &input)
+
+	if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkMail Organization (%s)", organizationID), err.Error())
+
+		return
+	}
+
+	if _, err := waitOrganizationDeleted(ctx, conn, organizationID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkMail Organization (%s) delete", organizationID), err.Error())
+
+		return
+	}
+}
+
+func flattenOrganizationDomains(ctx context.Context, data *organizationResourceModel, alias string, apiObjects []awstypes.MailDomainSummary) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldDomains, d := data.Domains.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var domains []*organizationDomainModel
+	for _, v := range apiObjects {
+		domainName := aws.ToString(v.DomainName)
+
+		// Skip the test domain that WorkMail creates for every organization.
+		if domainName == alias+".awsapps.com" {
+			continue
+		}
+
+		domain := &organizationDomainModel{
+			DomainName:   fwflex.StringValueToFramework(ctx, domainName),
+			HostedZoneID: types.StringNull(),
+		}
+		// The hosted zone is only used when the domain is registered and is not returned by the API.
+		for _, old := range oldDomains {
+			if old.DomainName.ValueString() == domainName {
+				domain.HostedZoneID = old.HostedZoneID
+			}
+		}
+		domains = append(domains, domain)
+	}
+
+	data.Domains, d = fwtypes.NewSetNestedObjectValueOfSlice(ctx, domains, nil)
+	diags.Append(d...)
+
+	return diags
+}
+
+func findOrganizationByID(ctx context.Context, conn *workmail.Client, id string) (*workmail.DescribeOrganizationOutput, error) {
+	input := workmail.DescribeOrganizationInput{
+		OrganizationId: aws.String(id),
+	}
+	output, err := conn.DescribeOrganization(ctx, &input)
+
+	if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.ToString(output.State); state == organizationStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message: state,
+		}
+	}
+
+	return output, nil
+}
+
+func findMailDomainsByOrganizationID(ctx context.Context, conn *workmail.Client, id string) ([]awstypes.MailDomainSummary, error) {
+	input := workmail.ListMailDomainsInput{
+		OrganizationId: aws.String(id),
+	}
+	var output []awstypes.MailDomainSummary
+
+	pages := workmail.NewListMailDomainsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.MailDomains...)
+	}
+
+	return output, nil
+}
+
+func statusOrganization(conn *workmail.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findOrganizationByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.State), nil
+	}
+}
+
+func waitOrganizationCreated(ctx context.Context, conn *workmail.Client, id string, timeout time.Duration) (*workmail.DescribeOrganizationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{organizationStateRequested, organizationStateCreating},
+		Target:  []string{organizationStateActive},
+		Refresh: statusOrganization(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*workmail.DescribeOrganizationOutput); ok {
+		if state := aws.ToString(output.State); state == organizationStateFailed {
+			retry.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOrganizationDeleted(ctx context.Context, conn *workmail.Client, id string, timeout time.Duration) (*workmail.DescribeOrganizationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{organizationStateActive, organizationStateDeleting, organizationStateFailed},
+		Target:  []string{},
+		Refresh: statusOrganization(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*workmail.DescribeOrganizationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type organizationResourceModel struct {
+	framework.WithRegionModel
+	Alias                  types.String                                            `tfsdk:"alias"`
+	ARN                    types.String                                            `tfsdk:"arn"`
+	DefaultMailDomain      types.String                                            `tfsdk:"default_mail_domain"`
+	DeleteDirectory        types.Bool                                              `tfsdk:"delete_directory"`
+	DirectoryID            types.String                                            `tfsdk:"directory_id"`
+	DirectoryType          types.String                                            `tfsdk:"directory_type"`
+	Domains                fwtypes.SetNestedObjectValueOf[organizationDomainModel] `tfsdk:"domain"`
+	EnableInteroperability types.Bool                                              `tfsdk:"enable_interoperability"`
+	KMSKeyARN              fwtypes.ARN                                             `tfsdk:"kms_key_arn"`
+	OrganizationID         types.String                                            `tfsdk:"organization_id"`
+	State                  types.String                                            `tfsdk:"state"`
+	Tags                   tftags.Map                                              `tfsdk:"tags"`
+	TagsAll                tftags.Map                                              `tfsdk:"tags_all"`
+	Timeouts               timeouts.Value                                          `tfsdk:"timeouts"`
+}
+
+type organizationDomainModel struct {
+	DomainName   types.String `tfsdk:"domain_name"`
+	HostedZoneID types.String `tfsdk:"hosted_zone_id"`
+}
+
+// parseOrganizationScopedImportID parses an import ID of the form <organization-id>,<attr-1>[,<attr-2>...].
+func parseOrganizationScopedImportID(id string, attrNames ...string) (string, map[string]string, error) {
+	partCount := len(attrNames) + 1
+	parts, err := intflex.ExpandResourceId(id, partCount, false)
+
+	if err != nil {
+		formats := tfslices.ApplyToAll(attrNames, func(v string) string {
+			return "<" + strings.ReplaceAll(v, "_", "-") + ">"
+		})
+
+		return "", nil, fmt.Errorf("id %q should be in the format <organization-id>%s%s", id, intflex.ResourceIdSeparator, strings.Join(formats, intflex.ResourceIdSeparator))
+	}
+
+	result := map[string]string{
+		"organization_id": parts[0],
+	}
+	for i, attrName := range attrNames {
+		result[attrName] = parts[i+1]
+	}
+
+	return id, result, nil
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkMailOrganization_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeOrganizationOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_organization.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alias", rName),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "workmail", regexache.MustCompile(`organization/m-[0-9a-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "default_mail_domain", rName+".awsapps.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "directory_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "directory_type"),
+					resource.TestCheckResourceAttr(resourceName, "domain.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "enable_interoperability", acctest.CtFalse),
+					resource.TestMatchResourceAttr(resourceName, "organization_id", regexache.MustCompile(`^m-[0-9a-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "Active"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "organization_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "organization_id",
+				ImportStateVerifyIgnore:              []string{"delete_directory"},
+			},
+		},
+	})
+}
+
+func TestAccWorkMailOrganization_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeOrganizationOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_organization.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceOrganization, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkMailOrganization_domain(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeOrganizationOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_workmail_organization.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfig_domain(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domain.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "domain.*", map[string]string{
+						names.AttrDomainName: domain,
+					}),
+				),
+			},
+			{
+				Config: testAccOrganizationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domain.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkMailOrganization_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeOrganizationOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_organization.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccOrganizationConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccOrganizationConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_organization" {
+				continue
+			}
+
+			_, err := tfworkmail.FindOrganizationByID(ctx, conn, rs.Primary.Attributes["organization_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Organization %s still exists", rs.Primary.Attributes["organization_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOrganizationExists(ctx context.Context, t *testing.T, n string, v *workmail.DescribeOrganizationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		output, err := tfworkmail.FindOrganizationByID(ctx, conn, rs.Primary.Attributes["organization_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccOrganizationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workmail_organization" "test" {
+  alias            = %[1]q
+  delete_directory = true
+}
+`, rName)
+}
+
+func testAccOrganizationConfig_domain(rName, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_workmail_organization" "test" {
+  alias            = %[1]q
+  delete_directory = true
+
+  domain {
+    domain_name = %[2]q
+  }
+}
+`, rName, domain)
+}
+
+func testAccOrganizationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workmail_organization" "test" {
+  alias            = %[1]q
+  delete_directory = true
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccOrganizationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workmail_organization" "test" {
+  alias            = %[1]q
+  delete_directory = true
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_resource", name="Resource")
+// @IdentityAttribute("organization_id")
+// @IdentityAttribute("resource_id")
+// @ImportIDHandler("resourceImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/workmail;workmail.DescribeResourceOutput")
+func newResourceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceResource{}
+
+	return r, nil
+}
+
+type resourceResource struct {
+	framework.ResourceWithModel[resourceResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *resourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			names.AttrEmail: schema.StringAttribute{
+				Optional: true,
+			},
+			"hidden_from_global_address_list": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 20),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrResourceID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EntityState](),
+				Computed:   true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ResourceType](),
+				Required:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"booking_options": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[bookingOptionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_accept_requests": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+						"auto_decline_conflicting_requests": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+						"auto_decline_recurring_requests": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	var input workmail.CreateResourceInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateResource(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail Resource (%s)", name), err.Error())
+
+		return
+	}
+
+	organizationID, resourceID := data.OrganizationID.ValueString(), aws.ToString(output.ResourceId)
+	data.ResourceID = fwflex.StringValueToFramework(ctx, resourceID)
+
+	// Booking options can only be set once the resource exists.
+	if !data.BookingOptions.IsNull() {
+		var input workmail.UpdateResourceInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if _, err := conn.UpdateResource(ctx, &input); err != nil {
+			response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'resource_id' so as to taint the resource.
+			response.State.SetAttribute(ctx, path.Root(names.AttrResourceID), resourceID)
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail Resource (%s)", resourceID), err.Error())
+
+			return
+		}
+	}
+
+	if !data.Email.IsNull() {
+		if err := registerEntity(ctx, conn, organizationID, resourceID, data.Email.ValueString()); err != nil {
+			response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'resource_id' so as to taint the resource.
+			response.State.SetAttribute(ctx, path.Root(names.AttrResourceID), resourceID)
+			response.Diagnostics.AddError(fmt.Sprintf("registering WorkMail Resource (%s)", resourceID), err.Error())
+
+			return
+		}
+	}
+
+	entity, err := findResourceByTwoPartKey(ctx, conn, organizationID, resourceID)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'resource_id' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root(names.AttrResourceID), resourceID)
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Resource (%s)", resourceID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.State = fwtypes.StringEnumValue(entity.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *resourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, resourceID := data.OrganizationID.ValueString(), data.ResourceID.ValueString()
+	output, err := findResourceByTwoPartKey(ctx, conn, organizationID, resourceID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Resource (%s)", resourceID), err.Error())
+
+		return
+	}
+
+	// Booking options are only tracked if configured.
+	if data.BookingOptions.IsNull() {
+		output.BookingOptions = nil
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old resourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, resourceID := new.OrganizationID.ValueString(), new.ResourceID.ValueString()
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Email"), fwflex.WithIgnoredField("State"))
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input workmail.UpdateResourceInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateResource(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail Resource (%s)", resourceID), err.Error())
+
+			return
+		}
+	}
+
+	if err := updateEntityEmail(ctx, conn, organizationID, resourceID, old.Email, new.Email); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail Resource (%s) email", resourceID), err.Error())
+
+		return
+	}
+
+	entity, err := findResourceByTwoPartKey(ctx, conn, organizationID, resourceID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Resource (%s)", resourceID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	new.State = fwtypes.StringEnumValue(entity.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, resourceID := data.OrganizationID.ValueString(), data.ResourceID.ValueString()
+	err := deleteEntity(ctx, conn, organizationID, resourceID, data.Email, func(ctx context.Context) error {
+		input := workmail.DeleteResourceInput{
+			OrganizationId: aws.String(organizationID),
+			ResourceId:     aws.String(resourceID),
+		}
+		_, err := conn.DeleteResource(ctx, &input)
+
+		return err
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkMail Resource (%s)", resourceID), err.Error())
+
+		return
+	}
+}
+
+func findResourceByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, resourceID string) (*workmail.DescribeResourceOutput, error) {
+	input := workmail.DescribeResourceInput{
+		OrganizationId: aws.String(organizationID),
+		ResourceId:     aws.String(resourceID),
+	}
+	output, err := conn.DescribeResource(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) || errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.State; state == awstypes.EntityStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message: string(state),
+		}
+	}
+
+	return output, nil
+}
+
+var _ inttypes.ImportIDParser = resourceImportID{}
+
+type resourceImportID struct{}
+
+func (resourceImportID) Parse(id string) (string, map[string]string, error) {
+	return parseOrganizationScopedImportID(id, names.AttrResourceID)
+}
+
+type resourceResourceModel struct {
+	framework.WithRegionModel
+	BookingOptions              fwtypes.ListNestedObjectValueOf[bookingOptionsModel] `tfsdk:"booking_options"`
+	Description                 types.String                                         `tfsdk:"description"`
+	Email                       types.String                                         `tfsdk:"email"`
+	HiddenFromGlobalAddressList types.Bool                                           `tfsdk:"hidden_from_global_address_list"`
+	Name                        types.String                                         `tfsdk:"name"`
+	OrganizationID              types.String                                         `tfsdk:"organization_id"`
+	ResourceID                  types.String                                         `tfsdk:"resource_id"`
+	State                       fwtypes.StringEnum[awstypes.EntityState]             `tfsdk:"state"`
+	Type                        fwtypes.StringEnum[awstypes.ResourceType]            `tfsdk:"type"`
+}
+
+type bookingOptionsModel struct {
+	AutoAcceptRequests             types.Bool `tfsdk:"auto_accept_requests"`
+	AutoDeclineConflictingRequests types.Bool `tfsdk:"auto_decline_conflicting_requests"`
+	AutoDeclineRecurringRequests   types.Bool `tfsdk:"auto_decline_recurring_requests"`
+}
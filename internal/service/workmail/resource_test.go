@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkMailResource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeResourceOutput
+	// Resource names are limited to 20 characters.
+	rName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
+	resourceName := "aws_workmail_resource.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.#", "0"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrEmail),
+					resource.TestCheckResourceAttr(resourceName, "hidden_from_global_address_list", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", "aws_workmail_organization.test", "organization_id"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrResourceID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "ROOM"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "organization_id", names.AttrResourceID),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrResourceID,
+			},
+		},
+	})
+}
+
+func TestAccWorkMailResource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeResourceOutput
+	rName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
+	resourceName := "aws_workmail_resource.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceResource, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkMailResource_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeResourceOutput
+	rName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
+	resourceName := "aws_workmail_resource.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_full(rName, "ROOM", "Conference room", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.0.auto_accept_requests", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.0.auto_decline_conflicting_requests", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.0.auto_decline_recurring_requests", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Conference room"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEmail),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "ROOM"),
+				),
+			},
+			{
+				Config: testAccResourceConfig_full(rName, "EQUIPMENT", "Projector", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.0.auto_decline_recurring_requests", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Projector"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "EQUIPMENT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_resource" {
+				continue
+			}
+
+			_, err := tfworkmail.FindResourceByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes[names.AttrResourceID])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Resource %s still exists", rs.Primary.Attributes[names.AttrResourceID])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceExists(ctx context.Context, t *testing.T, n string, v *workmail.DescribeResourceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		output, err := tfworkmail.FindResourceByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes[names.AttrResourceID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccResourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_resource" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  name            = %[1]q
+  type            = "ROOM"
+}
+`, rName))
+}
+
+func testAccResourceConfig_full(rName, resourceType, description string, declineRecurring bool) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_resource" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  name            = %[1]q
+  type            = %[2]q
+  description     = %[3]q
+  email           = "%[1]s@${aws_workmail_organization.test.default_mail_domain}"
+
+  booking_options {
+    auto_decline_recurring_requests = %[4]t
+  }
+}
+`, rName, resourceType, description, declineRecurring))
+}
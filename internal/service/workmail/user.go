@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_user", name="User")
+// @IdentityAttribute("organization_id")
+// @IdentityAttribute("user_id")
+// @ImportIDHandler("userImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/workmail;workmail.DescribeUserOutput")
+// @Testing(importIgnore="password")
+func newUserResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &userResource{}
+
+	return r, nil
+}
+
+type userResource struct {
+	framework.ResourceWithModel[userResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *userResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	profileAttribute := func(maxLength int) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtMost(maxLength),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"city":       profileAttribute(256),
+			"company":    profileAttribute(256),
+			"country":    profileAttribute(256),
+			"department": profileAttribute(256),
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			names.AttrEmail: schema.StringAttribute{
+				Optional: true,
+			},
+			"first_name": profileAttribute(64),
+			"hidden_from_global_address_list": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"initials":  profileAttribute(16),
+			"job_title": profileAttribute(128),
+			"last_name": profileAttribute(64),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"office": profileAttribute(64),
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			names.AttrRole: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserRole](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EntityState](),
+				Computed:   true,
+			},
+			"street":    profileAttribute(128),
+			"telephone": profileAttribute(64),
+			"user_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zip_code": profileAttribute(32),
+		},
+	}
+}
+
+func (r *userResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data userResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	var input workmail.CreateUserInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateUser(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail User (%s)", name), err.Error())
+
+		return
+	}
+
+	organizationID, userID := data.OrganizationID.ValueString(), aws.ToString(output.UserId)
+	data.UserID = fwflex.StringValueToFramework(ctx, userID)
+
+	// Contact details can only be set once the user exists.
+	var updateInput workmail.UpdateUserInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &updateInput)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := conn.UpdateUser(ctx, &updateInput); err != nil {
+		response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'user_id' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root("user_id"), userID)
+		response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail User (%s)", userID), err.Error())
+
+		return
+	}
+
+	if !data.Email.IsNull() {
+		if err := registerEntity(ctx, conn, organizationID, userID, data.Email.ValueString()); err != nil {
+			response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'user_id' so as to taint the resource.
+			response.State.SetAttribute(ctx, path.Root("user_id"), userID)
+			response.Diagnostics.AddError(fmt.Sprintf("registering WorkMail User (%s)", userID), err.Error())
+
+			return
+		}
+	}
+
+	user, err := findUserByTwoPartKey(ctx, conn, organizationID, userID)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'user_id' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root("user_id"), userID)
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail User (%s)", userID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Role = fwtypes.StringEnumValue(user.UserRole)
+	data.State = fwtypes.StringEnumValue(user.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *userResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data userResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, userID := data.OrganizationID.ValueString(), data.UserID.ValueString()
+	output, err := findUserByTwoPartKey(ctx, conn, organizationID, userID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail User (%s)", userID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Role = fwtypes.StringEnumValue(output.UserRole)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *userResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old userResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, userID := new.OrganizationID.ValueString(), new.UserID.ValueString()
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Email"), fwflex.WithIgnoredField("Password"), fwflex.WithIgnoredField("State"))
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input workmail.UpdateUserInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateUser(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail User (%s)", userID), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Password.Equal(old.Password) && !new.Password.IsNull() {
+		input := workmail.ResetPasswordInput{
+			OrganizationId: aws.String(organizationID),
+			Password:       new.Password.ValueStringPointer(),
+			UserId:         aws.String(userID),
+		}
+		_, err := conn.ResetPassword(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("resetting WorkMail User (%s) password", userID), err.Error())
+
+			return
+		}
+	}
+
+	if err := updateEntityEmail(ctx, conn, organizationID, userID, old.Email, new.Email); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail User (%s) email", userID), err.Error())
+
+		return
+	}
+
+	user, err := findUserByTwoPartKey(ctx, conn, organizationID, userID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail User (%s)", userID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	new.State = fwtypes.StringEnumValue(user.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data userResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, userID := data.OrganizationID.ValueString(), data.UserID.ValueString()
+	err := deleteEntity(ctx, conn, organizationID, userID, data.Email, func(ctx context.Context) error {
+		input := workmail.DeleteUserInput{
+			OrganizationId: aws.String(organizationID),
+			UserId:         aws.String(userID),
+		}
+		_, err := conn.DeleteUser(ctx, &input)
+
+		return err
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkMail User (%s)", userID), err.Error())
+
+		return
+	}
+}
+
+func findUserByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, userID string) (*workmail.DescribeUserOutput, error) {
+	input := workmail.DescribeUserInput{
+		OrganizationId: aws.String(organizationID),
+		UserId:         aws.String(userID),
+	}
+	output, err := conn.DescribeUser(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.State; state == awstypes.EntityStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message: string(state),
+		}
+	}
+
+	return output, nil
+}
+
+var _ inttypes.ImportIDParser = userImportID{}
+
+type userImportID struct{}
+
+func (userImportID) Parse(id string) (string, map[string]string, error) {
+	return parseOrganizationScopedImportID(id, "user_id")
+}
+
+type userResourceModel struct {
+	framework.WithRegionModel
+	City                        types.String                             `tfsdk:"city"`
+	Company                     types.String                             `tfsdk:"company"`
+	Country                     types.String                             `tfsdk:"country"`
+	Department                  types.String                             `tfsdk:"department"`
+	DisplayName                 types.String                             `tfsdk:"display_name"`
+	Email                       types.String                             `tfsdk:"email"`
+	FirstName                   types.String                             `tfsdk:"first_name"`
+	HiddenFromGlobalAddressList types.Bool                               `tfsdk:"hidden_from_global_address_list"`
+	Initials                    types.String                             `tfsdk:"initials"`
+	JobTitle                    types.String                             `tfsdk:"job_title"`
+	LastName                    types.String                             `tfsdk:"last_name"`
+	Name                        types.String                             `tfsdk:"name"`
+	Office                      types.String                             `tfsdk:"office"`
+	OrganizationID              types.String                             `tfsdk:"organization_id"`
+	Password                    types.String                             `tfsdk:"password"`
+	Role                        fwtypes.StringEnum[awstypes.UserRole]    `tfsdk:"role"`
+	State                       fwtypes.StringEnum[awstypes.EntityState] `tfsdk:"state"`
+	Street                      types.String                             `tfsdk:"street"`
+	Telephone                   types.String                             `tfsdk:"telephone"`
+	UserID                      types.String                             `tfsdk:"user_id"`
+	ZipCode                     types.String                             `tfsdk:"zip_code"`
+}
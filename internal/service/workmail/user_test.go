@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkMailUser_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeUserOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_user.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrEmail),
+					resource.TestCheckResourceAttr(resourceName, "hidden_from_global_address_list", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", "aws_workmail_organization.test", "organization_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRole, "USER"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "DISABLED"),
+					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "organization_id", "user_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "user_id",
+				ImportStateVerifyIgnore:              []string{names.AttrPassword},
+			},
+		},
+	})
+}
+
+func TestAccWorkMailUser_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeUserOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_user.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceUser, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkMailUser_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeUserOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_workmail_user.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "DISABLED"),
+				),
+			},
+			{
+				Config: testAccUserConfig_full(rName, "Engineer", "Pa55w0rd!"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEmail),
+					resource.TestCheckResourceAttr(resourceName, "first_name", "Test"),
+					resource.TestCheckResourceAttr(resourceName, "job_title", "Engineer"),
+					resource.TestCheckResourceAttr(resourceName, "last_name", "User"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ENABLED"),
+				),
+			},
+			{
+				Config: testAccUserConfig_full(rName, "Manager", "Pa55w0rd!Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "job_title", "Manager"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_user" {
+				continue
+			}
+
+			_, err := tfworkmail.FindUserByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["user_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail User %s still exists", rs.Primary.Attributes["user_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUserExists(ctx context.Context, t *testing.T, n string, v *workmail.DescribeUserOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WorkMailClient(ctx)
+
+		output, err := tfworkmail.FindUserByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["user_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccUserConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_user" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  name            = %[1]q
+  display_name    = %[1]q
+}
+`, rName))
+}
+
+func testAccUserConfig_full(rName, jobTitle, password string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_user" "test" {
+  organization_id = aws_workmail_organization.test.organization_id
+  name            = %[1]q
+  display_name    = %[1]q
+  email           = "%[1]s@${aws_workmail_organization.test.default_mail_domain}"
+  password        = %[3]q
+
+  first_name = "Test"
+  last_name  = "User"
+  job_title  = %[2]q
+}
+`, rName, jobTitle, password))
+}
@@ -50,7 +50,14 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	Tags     KeyValueTags
+	Computed *ComputedDefaultConfig
+}
+
+// ComputedDefaultConfig contains the keys of tags whose values are computed when a resource is created.
+type ComputedDefaultConfig struct {
+	ProviderVersionKey string // Value is the provider version, e.g. "6.10.0"
+	ResourceTypeKey    string // Value is the Terraform resource type, e.g. "aws_s3_bucket"
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -85,6 +92,25 @@ func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	return dc.Tags.Merge(tags)
 }
 
+// MergeComputedTags returns the given KeyValueTags merged with any tags
+// whose values are computed when a resource of the given type is created or its tags are updated.
+// Tags already present in the KeyValueTags are not overridden.
+func (dc *DefaultConfig) MergeComputedTags(ctx context.Context, resourceType, providerVersion string, tags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Computed == nil {
+		return tags
+	}
+
+	computedTags := make(map[string]string)
+	if k := dc.Computed.ResourceTypeKey; k != "" && resourceType != "" {
+		computedTags[k] = resourceType
+	}
+	if k := dc.Computed.ProviderVersionKey; k != "" && providerVersion != "" {
+		computedTags[k] = providerVersion
+	}
+
+	return New(ctx, computedTags).Merge(tags)
+}
+
 // TagsEqual returns true if the given configuration's Tags
 // are equal to those passed in as an argument;
 // otherwise returns false
@@ -491,6 +517,31 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	return result
 }
 
+// RemoveComputedDefaultConfig returns tags not present in a DefaultConfig object's computed tags.
+// Computed tags are only set when a resource's tags are written and are otherwise treated as if they were
+// not configured, so that they don't cause differences. Tags with keys that are also configured
+// in the DefaultConfig object's Tags or in the resource's configured tags are retained.
+func (tags KeyValueTags) RemoveComputedDefaultConfig(dc *DefaultConfig, configuredTags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Computed == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if k == dc.Computed.ProviderVersionKey || k == dc.Computed.ResourceTypeKey {
+			_, inDefaultTags := dc.Tags[k]
+			_, inConfiguredTags := configuredTags[k]
+			if !inDefaultTags && !inConfiguredTags {
+				continue
+			}
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
 // String returns the default string representation of the KeyValueTags.
 func (tags KeyValueTags) String() string {
 	var builder strings.Builder
@@ -202,6 +202,97 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigMergeComputedTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no computed config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "computed config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Computed: &ComputedDefaultConfig{
+					ProviderVersionKey: "ProviderVersion",
+					ResourceTypeKey:    "ResourceType",
+				},
+			},
+			want: map[string]string{
+				"key1":            "value1",
+				"ProviderVersion": "6.10.0",
+				"ResourceType":    "aws_test",
+			},
+		},
+		{
+			name: "partial computed config",
+			tags: New(ctx, map[string]string{}),
+			defaultConfig: &DefaultConfig{
+				Computed: &ComputedDefaultConfig{
+					ResourceTypeKey: "ResourceType",
+				},
+			},
+			want: map[string]string{
+				"ResourceType": "aws_test",
+			},
+		},
+		{
+			name: "computed config overridden",
+			tags: New(ctx, map[string]string{
+				"ResourceType": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Computed: &ComputedDefaultConfig{
+					ProviderVersionKey: "ProviderVersion",
+					ResourceTypeKey:    "ResourceType",
+				},
+			},
+			want: map[string]string{
+				"ProviderVersion": "6.10.0",
+				"ResourceType":    "value1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.MergeComputedTags(ctx, "aws_test", "6.10.0", testCase.tags)
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	t.Parallel()
 
@@ -2263,6 +2354,139 @@ func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRemoveComputedDefaultConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name           string
+		tags           KeyValueTags
+		defaultConfig  *DefaultConfig
+		configuredTags KeyValueTags
+		want           map[string]string
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{
+				"key1":         "value1",
+				"ResourceType": "aws_test",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1":         "value1",
+				"ResourceType": "aws_test",
+			},
+		},
+		{
+			name: "no computed config",
+			tags: New(ctx, map[string]string{
+				"key1":         "value1",
+				"ResourceType": "aws_test",
+			}),
+			defaultConfig: &DefaultConfig{},
+			want: map[string]string{
+				"key1":         "value1",
+				"ResourceType": "aws_test",
+			},
+		},
+		{
+			name: "computed config",
+			tags: New(ctx, map[string]string{
+				"key1":            "value1",
+				"ProviderVersion": "6.10.0",
+				"ResourceType":    "aws_test",
+			}),
+			defaultConfig: &DefaultConfig{
+				Computed: &ComputedDefaultConfig{
+					ProviderVersionKey: "ProviderVersion",
+					ResourceTypeKey:    "ResourceType",
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "computed key in default tags",
+			tags: New(ctx, map[string]string{
+				"key1":            "value1",
+				"ProviderVersion": "6.10.0",
+				"ResourceType":    "aws_test",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"ResourceType": "aws_test",
+				}),
+				Computed: &ComputedDefaultConfig{
+					ProviderVersionKey: "ProviderVersion",
+					ResourceTypeKey:    "ResourceType",
+				},
+			},
+			want: map[string]string{
+				"key1":         "value1",
+				"ResourceType": "aws_test",
+			},
+		},
+		{
+			name: "computed key in resource tags",
+			tags: New(ctx, map[string]string{
+				"key1":            "value1",
+				"ProviderVersion": "6.10.0",
+				"ResourceType":    "custom",
+			}),
+			defaultConfig: &DefaultConfig{
+				Computed: &ComputedDefaultConfig{
+					ProviderVersionKey: "ProviderVersion",
+					ResourceTypeKey:    "ResourceType",
+				},
+			},
+			configuredTags: New(ctx, map[string]string{
+				"key1":         "value1",
+				"ResourceType": "custom",
+			}),
+			want: map[string]string{
+				"key1":         "value1",
+				"ResourceType": "custom",
+			},
+		},
+		{
+			name: "computed keys in resource and default tags",
+			tags: New(ctx, map[string]string{
+				"key1":            "value1",
+				"ProviderVersion": "pinned",
+				"ResourceType":    "custom",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"ProviderVersion": "pinned",
+				}),
+				Computed: &ComputedDefaultConfig{
+					ProviderVersionKey: "ProviderVersion",
+					ResourceTypeKey:    "ResourceType",
+				},
+			},
+			configuredTags: New(ctx, map[string]string{
+				"ResourceType": "custom",
+			}),
+			want: map[string]string{
+				"key1":            "value1",
+				"ProviderVersion": "pinned",
+				"ResourceType":    "custom",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.RemoveComputedDefaultConfig(testCase.defaultConfig, testCase.configuredTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsURLEncode(t *testing.T) {
 	t.Parallel()
 
@@ -30,6 +30,7 @@ const (
 // If `f` returns an error, return immediately with that error.
 // If `timeout` is exceeded before `f` returns `true`, return an error.
 // Waits between calls to `f` using exponential backoff.
+// A timeout is recorded in any retry.InterruptedWaitRecorder associated with `ctx`.
 func WaitUntil(ctx context.Context, timeout time.Duration, f func(context.Context) (bool, error), opts WaitOpts) error {
 	refresh := func(ctx context.Context) (any, targetState, error) {
 		done, err := f(ctx)
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// HasARNFormat returns whether resources with this identity can be imported using their ARN
+// in addition to their identity attributes.
+func (i Identity) HasARNFormat() bool {
+	return i.ARNFormat != "" && !i.IsARN && !i.IsSingleton
+}
+
+// ParseARN decomposes the resource part of an ARN into identity attribute values using the identity's ARN format.
+func (i Identity) ParseARN(arnARN arn.ARN) (map[string]string, error) {
+	if !i.HasARNFormat() {
+		return nil, errors.New("identity has no ARN format")
+	}
+
+	return parseARNResource(i.ARNFormat, arnARN.Resource)
+}
+
+func parseARNResource(format, resource string) (map[string]string, error) {
+	result := make(map[string]string)
+
+	f, r := format, resource
+	for f != "" {
+		start := strings.IndexByte(f, '{')
+		if start == -1 {
+			if f != r {
+				return nil, fmt.Errorf("ARN resource %q does not match format %q", resource, format)
+			}
+			return result, nil
+		}
+
+		literal := f[:start]
+		if !strings.HasPrefix(r, literal) {
+			return nil, fmt.Errorf("ARN resource %q does not match format %q", resource, format)
+		}
+		r = r[len(literal):]
+		f = f[start:]
+
+		end := strings.IndexByte(f, '}')
+		if end == -1 {
+			return nil, fmt.Errorf("missing closing '}' in ARN format %q", format)
+		}
+		name := f[1:end]
+		f = f[end+1:]
+
+		// A placeholder extends to the next literal in the format, or to the end of the resource.
+		var value string
+		if f == "" {
+			value, r = r, ""
+		} else {
+			next := f
+			if i := strings.IndexByte(next, '{'); i != -1 {
+				next = next[:i]
+			}
+			if next == "" {
+				return nil, fmt.Errorf("adjacent placeholders in ARN format %q", format)
+			}
+			i := strings.Index(r, next)
+			if i == -1 {
+				return nil, fmt.Errorf("ARN resource %q does not match format %q", resource, format)
+			}
+			value, r = r[:i], r[i:]
+		}
+		if value == "" {
+			return nil, fmt.Errorf("ARN resource %q has no value for %q in format %q", resource, name, format)
+		}
+		result[name] = value
+	}
+
+	return result, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIdentityParseARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity      Identity
+		resource      string
+		expected      map[string]string
+		expectedError bool
+	}{
+		"single parameter": {
+			identity: RegionalSingleParameterIdentity("name", WithARNFormat("flow/{name}")),
+			resource: "flow/my-flow",
+			expected: map[string]string{
+				"name": "my-flow",
+			},
+		},
+		"single parameter with slash": {
+			identity: RegionalSingleParameterIdentity("name", WithARNFormat("flow/{name}")),
+			resource: "flow/my/flow",
+			expected: map[string]string{
+				"name": "my/flow",
+			},
+		},
+		"multiple parameters": {
+			identity: RegionalParameterizedIdentity([]IdentityAttribute{
+				StringIdentityAttribute("domain", true),
+				StringIdentityAttribute("repository", true),
+			}, WithARNFormat("repository/{domain}/{repository}")),
+			resource: "repository/my-domain/my-repository",
+			expected: map[string]string{
+				"domain":     "my-domain",
+				"repository": "my-repository",
+			},
+		},
+		"multiple parameters trailing literal": {
+			identity: GlobalParameterizedIdentity([]IdentityAttribute{
+				StringIdentityAttribute("name", true),
+				StringIdentityAttribute("revision", true),
+			}, WithARNFormat("job-definition/{name}:{revision}:latest")),
+			resource: "job-definition/my-job:3:latest",
+			expected: map[string]string{
+				"name":     "my-job",
+				"revision": "3",
+			},
+		},
+		"prefix mismatch": {
+			identity:      RegionalSingleParameterIdentity("name", WithARNFormat("flow/{name}")),
+			resource:      "connectorprofile/my-profile",
+			expectedError: true,
+		},
+		"empty value": {
+			identity:      RegionalSingleParameterIdentity("name", WithARNFormat("flow/{name}")),
+			resource:      "flow/",
+			expectedError: true,
+		},
+		"missing separator": {
+			identity: RegionalParameterizedIdentity([]IdentityAttribute{
+				StringIdentityAttribute("domain", true),
+				StringIdentityAttribute("repository", true),
+			}, WithARNFormat("repository/{domain}/{repository}")),
+			resource:      "repository/my-domain",
+			expectedError: true,
+		},
+		"trailing literal mismatch": {
+			identity: GlobalParameterizedIdentity([]IdentityAttribute{
+				StringIdentityAttribute("name", true),
+				StringIdentityAttribute("revision", true),
+			}, WithARNFormat("job-definition/{name}:{revision}:latest")),
+			resource:      "job-definition/my-job:3:first",
+			expectedError: true,
+		},
+		"no ARN format": {
+			identity:      RegionalSingleParameterIdentity("name"),
+			resource:      "flow/my-flow",
+			expectedError: true,
+		},
+		"ARN identity": {
+			identity:      RegionalARNIdentity(WithARNFormat("flow/{name}")),
+			resource:      "flow/my-flow",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			arnARN := arn.ARN{
+				Partition: "aws",
+				Service:   "appflow",
+				Region:    "us-west-2", //lintignore:AWSAT003
+				AccountID: "123456789012",
+				Resource:  testCase.resource,
+			}
+
+			got, err := testCase.identity.ParseARN(arnARN)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("ParseARN() err %t, want %t: %v", got, want, err)
+			}
+			if err == nil {
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
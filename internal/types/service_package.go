@@ -42,6 +42,19 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceIAMActions represents the IAM actions used by a resource's operations.
+// Actions are in "service:Action" format, e.g. "sqs:CreateQueue".
+type ServicePackageResourceIAMActions struct {
+	Create []string
+	Update []string
+	Delete []string
+}
+
+// IsEmpty returns whether or not no IAM actions are specified.
+func (a ServicePackageResourceIAMActions) IsEmpty() bool {
+	return len(a.Create) == 0 && len(a.Update) == 0 && len(a.Delete) == 0
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {
@@ -64,13 +77,17 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   FrameworkImport
+	Factory    func(context.Context) (resource.ResourceWithConfigure, error)
+	TypeName   string
+	Name       string
+	Tags       unique.Handle[ServicePackageResourceTags]
+	Region     unique.Handle[ServicePackageResourceRegion]
+	Identity   Identity
+	Import     FrameworkImport
+	IAMActions ServicePackageResourceIAMActions
+	// CreationPending indicates that an interrupted or timed-out create is saved to state untainted
+	// and that the resource's Read handler resumes waiting for creation to complete.
+	CreationPending bool
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -86,13 +103,17 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   SDKv2Import
+	Factory    func() *schema.Resource
+	TypeName   string
+	Name       string
+	Tags       unique.Handle[ServicePackageResourceTags]
+	Region     unique.Handle[ServicePackageResourceRegion]
+	Identity   Identity
+	Import     SDKv2Import
+	IAMActions ServicePackageResourceIAMActions
+	// CreationPending indicates that an interrupted or timed-out create is saved to state untainted
+	// and that the resource's Read handler resumes waiting for creation to complete.
+	CreationPending bool
 }
 
 type Identity struct {
@@ -107,6 +128,7 @@ type Identity struct {
 	IsSingleParameter      bool
 	IsMutable              bool
 	IsSetOnUpdate          bool
+	ARNFormat              string // Parameterized
 }
 
 func (i Identity) HasInherentRegion() bool {
@@ -379,6 +401,14 @@ func WithV6_0SDKv2Fix() IdentityOptsFunc {
 	}
 }
 
+// WithARNFormat is for use for resource types with a parameterized identity whose ARN can be decomposed into identity attributes.
+// The format is the resource part of the ARN, with each identity attribute as a "{name}" placeholder, e.g. "flow/{name}".
+func WithARNFormat(format string) IdentityOptsFunc {
+	return func(opts *Identity) {
+		opts.ARNFormat = format
+	}
+}
+
 type ImportIDParser interface {
 	Parse(id string) (string, map[string]string, error)
 }